@@ -2,3 +2,4 @@ package constants
 
 var VideoExtensions = [...]string{".mp4", ".m4v", ".mkv", ".avi", ".wmv", ".flv", ".webm", ".f4v", ".mpg", ".m2ts", ".mov"}
 var ImageExtensions = [...]string{".jpg", ".png", ".webp"}
+var SubtitleExtensions = [...]string{".srt", ".ass", ".vtt"}
@@ -0,0 +1,61 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var from = time.Date(2026, time.October, 18, 9, 15, 30, 0, time.UTC) // a sunday
+
+func Test_Next(t *testing.T) {
+	cases := []struct {
+		expression string
+		expected   time.Time
+	}{
+		{"0 3 * * *", time.Date(2026, time.October, 19, 3, 0, 0, 0, time.UTC)},
+		{"*/20 * * * *", time.Date(2026, time.October, 18, 9, 20, 0, 0, time.UTC)},
+		{"30 9 * * *", time.Date(2026, time.October, 18, 9, 30, 0, 0, time.UTC)},
+		{"0 0 * * mon", time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1-7 * 3", time.Date(2026, time.October, 21, 12, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		s, err := Parse(c.expression)
+		if !assert.NoError(t, err, c.expression) {
+			continue
+		}
+
+		assert.Equal(t, c.expected, s.Next(from), c.expression)
+	}
+}
+
+func Test_Next_NeverMatches(t *testing.T) {
+	s, err := Parse("0 0 30 2 *")
+	assert.NoError(t, err)
+
+	assert.True(t, s.Next(from).IsZero())
+}
+
+func Test_Parse_Invalid(t *testing.T) {
+	for _, expression := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := Parse(expression)
+		assert.Error(t, err, expression)
+	}
+}
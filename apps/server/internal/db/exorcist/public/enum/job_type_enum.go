@@ -20,6 +20,7 @@ var JobTypeEnum = &struct {
 	GenerateChapters        postgres.StringExpression
 	GenerateLibraryChapters postgres.StringExpression
 	Convert                 postgres.StringExpression
+	ExtractSubtitles        postgres.StringExpression
 }{
 	UpdateExistingVideos:    postgres.NewEnumValue("update_existing_videos"),
 	ScanPath:                postgres.NewEnumValue("scan_path"),
@@ -31,4 +32,5 @@ var JobTypeEnum = &struct {
 	GenerateChapters:        postgres.NewEnumValue("generate_chapters"),
 	GenerateLibraryChapters: postgres.NewEnumValue("generate_library_chapters"),
 	Convert:                 postgres.NewEnumValue("convert"),
+	ExtractSubtitles:        postgres.NewEnumValue("extract_subtitles"),
 }
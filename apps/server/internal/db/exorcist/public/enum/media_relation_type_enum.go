@@ -13,8 +13,10 @@ var MediaRelationTypeEnum = &struct {
 	Thumbnail postgres.StringExpression
 	Chapter   postgres.StringExpression
 	Media     postgres.StringExpression
+	Subtitle  postgres.StringExpression
 }{
 	Thumbnail: postgres.NewEnumValue("thumbnail"),
 	Chapter:   postgres.NewEnumValue("chapter"),
 	Media:     postgres.NewEnumValue("media"),
+	Subtitle:  postgres.NewEnumValue("subtitle"),
 }
@@ -22,4 +22,6 @@ type Job struct {
 	Outcome  *string
 	Created  time.Time
 	Modified time.Time
+	Progress float64
+	Step     *string
 }
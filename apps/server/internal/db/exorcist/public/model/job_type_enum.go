@@ -22,6 +22,7 @@ const (
 	JobTypeEnum_GenerateChapters        JobTypeEnum = "generate_chapters"
 	JobTypeEnum_GenerateLibraryChapters JobTypeEnum = "generate_library_chapters"
 	JobTypeEnum_Convert                 JobTypeEnum = "convert"
+	JobTypeEnum_ExtractSubtitles        JobTypeEnum = "extract_subtitles"
 )
 
 var JobTypeEnumAllValues = []JobTypeEnum{
@@ -35,6 +36,7 @@ var JobTypeEnumAllValues = []JobTypeEnum{
 	JobTypeEnum_GenerateChapters,
 	JobTypeEnum_GenerateLibraryChapters,
 	JobTypeEnum_Convert,
+	JobTypeEnum_ExtractSubtitles,
 }
 
 func (e *JobTypeEnum) Scan(value interface{}) error {
@@ -69,6 +71,8 @@ func (e *JobTypeEnum) Scan(value interface{}) error {
 		*e = JobTypeEnum_GenerateLibraryChapters
 	case "convert":
 		*e = JobTypeEnum_Convert
+	case "extract_subtitles":
+		*e = JobTypeEnum_ExtractSubtitles
 	default:
 		return errors.New("jet: Invalid scan value '" + enumValue + "' for JobTypeEnum enum")
 	}
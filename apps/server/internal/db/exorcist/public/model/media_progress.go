@@ -20,4 +20,6 @@ type MediaProgress struct {
 	Created   time.Time
 	Modified  time.Time
 	GhostID   *int32
+	PlayCount int32
+	Watched   *time.Time
 }
@@ -15,12 +15,14 @@ const (
 	MediaRelationTypeEnum_Thumbnail MediaRelationTypeEnum = "thumbnail"
 	MediaRelationTypeEnum_Chapter   MediaRelationTypeEnum = "chapter"
 	MediaRelationTypeEnum_Media     MediaRelationTypeEnum = "media"
+	MediaRelationTypeEnum_Subtitle  MediaRelationTypeEnum = "subtitle"
 )
 
 var MediaRelationTypeEnumAllValues = []MediaRelationTypeEnum{
 	MediaRelationTypeEnum_Thumbnail,
 	MediaRelationTypeEnum_Chapter,
 	MediaRelationTypeEnum_Media,
+	MediaRelationTypeEnum_Subtitle,
 }
 
 func (e *MediaRelationTypeEnum) Scan(value interface{}) error {
@@ -41,6 +43,8 @@ func (e *MediaRelationTypeEnum) Scan(value interface{}) error {
 		*e = MediaRelationTypeEnum_Chapter
 	case "media":
 		*e = MediaRelationTypeEnum_Media
+	case "subtitle":
+		*e = MediaRelationTypeEnum_Subtitle
 	default:
 		return errors.New("jet: Invalid scan value '" + enumValue + "' for MediaRelationTypeEnum enum")
 	}
@@ -19,4 +19,5 @@ type Playlist struct {
 	Created  time.Time
 	Modified time.Time
 	GhostID  *int32
+	Search   *string
 }
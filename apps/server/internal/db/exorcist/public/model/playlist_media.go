@@ -19,4 +19,5 @@ type PlaylistMedia struct {
 	Created    time.Time
 	Modified   time.Time
 	GhostID    *int32
+	Position   int32
 }
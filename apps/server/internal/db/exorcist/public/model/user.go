@@ -13,11 +13,13 @@ import (
 )
 
 type User struct {
-	ID       uuid.UUID `sql:"primary_key"`
-	Username string
-	Password string
-	Active   bool
-	Created  time.Time
-	Modified time.Time
-	GhostID  *int32
+	ID                 uuid.UUID `sql:"primary_key"`
+	Username           string
+	Password           string
+	Active             bool
+	Created            time.Time
+	Modified           time.Time
+	GhostID            *int32
+	Role               UserRoleEnum
+	MustChangePassword bool
 }
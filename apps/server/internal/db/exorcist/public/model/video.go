@@ -12,10 +12,20 @@ import (
 )
 
 type Video struct {
-	ID      uuid.UUID `sql:"primary_key"`
-	MediaID uuid.UUID
-	Height  int32
-	Width   int32
-	Runtime float64
-	GhostID *int32
+	ID              uuid.UUID `sql:"primary_key"`
+	MediaID         uuid.UUID
+	Height          int32
+	Width           int32
+	Runtime         float64
+	GhostID         *int32
+	VideoCodec      *string
+	AudioCodec      *string
+	Container       *string
+	Bitrate         *int64
+	VideoBitrate    *int64
+	FrameRate       *float64
+	PixelFormat     *string
+	AudioStreams    *string
+	SubtitleStreams *string
+	Phash           *int64
 }
@@ -26,6 +26,8 @@ type jobTable struct {
 	Outcome  postgres.ColumnString
 	Created  postgres.ColumnTimestamp
 	Modified postgres.ColumnTimestamp
+	Progress postgres.ColumnFloat
+	Step     postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -75,8 +77,10 @@ func newJobTableImpl(schemaName, tableName, alias string) jobTable {
 		OutcomeColumn  = postgres.StringColumn("outcome")
 		CreatedColumn  = postgres.TimestampColumn("created")
 		ModifiedColumn = postgres.TimestampColumn("modified")
-		allColumns     = postgres.ColumnList{IDColumn, ParentColumn, PriorityColumn, JobTypeColumn, StatusColumn, DataColumn, OutcomeColumn, CreatedColumn, ModifiedColumn}
-		mutableColumns = postgres.ColumnList{ParentColumn, PriorityColumn, JobTypeColumn, StatusColumn, DataColumn, OutcomeColumn, CreatedColumn, ModifiedColumn}
+		ProgressColumn = postgres.FloatColumn("progress")
+		StepColumn     = postgres.StringColumn("step")
+		allColumns     = postgres.ColumnList{IDColumn, ParentColumn, PriorityColumn, JobTypeColumn, StatusColumn, DataColumn, OutcomeColumn, CreatedColumn, ModifiedColumn, ProgressColumn, StepColumn}
+		mutableColumns = postgres.ColumnList{ParentColumn, PriorityColumn, JobTypeColumn, StatusColumn, DataColumn, OutcomeColumn, CreatedColumn, ModifiedColumn, ProgressColumn, StepColumn}
 	)
 
 	return jobTable{
@@ -92,6 +96,8 @@ func newJobTableImpl(schemaName, tableName, alias string) jobTable {
 		Outcome:  OutcomeColumn,
 		Created:  CreatedColumn,
 		Modified: ModifiedColumn,
+		Progress: ProgressColumn,
+		Step:     StepColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -24,6 +24,8 @@ type mediaProgressTable struct {
 	Created   postgres.ColumnTimestamp
 	Modified  postgres.ColumnTimestamp
 	GhostID   postgres.ColumnInteger
+	PlayCount postgres.ColumnInteger
+	Watched   postgres.ColumnTimestamp
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -71,8 +73,10 @@ func newMediaProgressTableImpl(schemaName, tableName, alias string) mediaProgres
 		CreatedColumn   = postgres.TimestampColumn("created")
 		ModifiedColumn  = postgres.TimestampColumn("modified")
 		GhostIDColumn   = postgres.IntegerColumn("ghost_id")
-		allColumns      = postgres.ColumnList{IDColumn, UserIDColumn, MediaIDColumn, TimestampColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
-		mutableColumns  = postgres.ColumnList{UserIDColumn, MediaIDColumn, TimestampColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
+		PlayCountColumn = postgres.IntegerColumn("play_count")
+		WatchedColumn   = postgres.TimestampColumn("watched")
+		allColumns      = postgres.ColumnList{IDColumn, UserIDColumn, MediaIDColumn, TimestampColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, PlayCountColumn, WatchedColumn}
+		mutableColumns  = postgres.ColumnList{UserIDColumn, MediaIDColumn, TimestampColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, PlayCountColumn, WatchedColumn}
 	)
 
 	return mediaProgressTable{
@@ -86,6 +90,8 @@ func newMediaProgressTableImpl(schemaName, tableName, alias string) mediaProgres
 		Created:   CreatedColumn,
 		Modified:  ModifiedColumn,
 		GhostID:   GhostIDColumn,
+		PlayCount: PlayCountColumn,
+		Watched:   WatchedColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
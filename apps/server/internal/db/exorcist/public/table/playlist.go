@@ -23,6 +23,7 @@ type playlistTable struct {
 	Created  postgres.ColumnTimestamp
 	Modified postgres.ColumnTimestamp
 	GhostID  postgres.ColumnInteger
+	Search   postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -69,8 +70,9 @@ func newPlaylistTableImpl(schemaName, tableName, alias string) playlistTable {
 		CreatedColumn  = postgres.TimestampColumn("created")
 		ModifiedColumn = postgres.TimestampColumn("modified")
 		GhostIDColumn  = postgres.IntegerColumn("ghost_id")
-		allColumns     = postgres.ColumnList{IDColumn, NameColumn, UserIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
-		mutableColumns = postgres.ColumnList{NameColumn, UserIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
+		SearchColumn   = postgres.StringColumn("search")
+		allColumns     = postgres.ColumnList{IDColumn, NameColumn, UserIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, SearchColumn}
+		mutableColumns = postgres.ColumnList{NameColumn, UserIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, SearchColumn}
 	)
 
 	return playlistTable{
@@ -83,6 +85,7 @@ func newPlaylistTableImpl(schemaName, tableName, alias string) playlistTable {
 		Created:  CreatedColumn,
 		Modified: ModifiedColumn,
 		GhostID:  GhostIDColumn,
+		Search:   SearchColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -23,6 +23,7 @@ type playlistMediaTable struct {
 	Created    postgres.ColumnTimestamp
 	Modified   postgres.ColumnTimestamp
 	GhostID    postgres.ColumnInteger
+	Position   postgres.ColumnInteger
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -69,8 +70,9 @@ func newPlaylistMediaTableImpl(schemaName, tableName, alias string) playlistMedi
 		CreatedColumn    = postgres.TimestampColumn("created")
 		ModifiedColumn   = postgres.TimestampColumn("modified")
 		GhostIDColumn    = postgres.IntegerColumn("ghost_id")
-		allColumns       = postgres.ColumnList{IDColumn, PlaylistIDColumn, MediaIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
-		mutableColumns   = postgres.ColumnList{PlaylistIDColumn, MediaIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
+		PositionColumn   = postgres.IntegerColumn("position")
+		allColumns       = postgres.ColumnList{IDColumn, PlaylistIDColumn, MediaIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, PositionColumn}
+		mutableColumns   = postgres.ColumnList{PlaylistIDColumn, MediaIDColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, PositionColumn}
 	)
 
 	return playlistMediaTable{
@@ -83,6 +85,7 @@ func newPlaylistMediaTableImpl(schemaName, tableName, alias string) playlistMedi
 		Created:    CreatedColumn,
 		Modified:   ModifiedColumn,
 		GhostID:    GhostIDColumn,
+		Position:   PositionColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
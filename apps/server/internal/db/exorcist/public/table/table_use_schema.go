@@ -10,24 +10,34 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	APIToken = APIToken.FromSchema(schema)
 	FavouriteMedia = FavouriteMedia.FromSchema(schema)
 	FavouritePerson = FavouritePerson.FromSchema(schema)
 	Image = Image.FromSchema(schema)
 	Job = Job.FromSchema(schema)
+	JobSchedule = JobSchedule.FromSchema(schema)
 	Library = Library.FromSchema(schema)
 	LibraryPath = LibraryPath.FromSchema(schema)
+	LibraryUser = LibraryUser.FromSchema(schema)
+	LoginFailure = LoginFailure.FromSchema(schema)
+	LoginThrottle = LoginThrottle.FromSchema(schema)
 	Media = Media.FromSchema(schema)
 	MediaPerson = MediaPerson.FromSchema(schema)
 	MediaProgress = MediaProgress.FromSchema(schema)
+	MediaRating = MediaRating.FromSchema(schema)
 	MediaRelation = MediaRelation.FromSchema(schema)
 	MediaTag = MediaTag.FromSchema(schema)
 	Person = Person.FromSchema(schema)
 	PersonAlias = PersonAlias.FromSchema(schema)
+	PersonRating = PersonRating.FromSchema(schema)
 	Playlist = Playlist.FromSchema(schema)
 	PlaylistMedia = PlaylistMedia.FromSchema(schema)
+	PlaylistUser = PlaylistUser.FromSchema(schema)
 	SchemaMigrations = SchemaMigrations.FromSchema(schema)
 	Tag = Tag.FromSchema(schema)
 	TagAlias = TagAlias.FromSchema(schema)
 	User = User.FromSchema(schema)
+	UserSession = UserSession.FromSchema(schema)
 	Video = Video.FromSchema(schema)
+	WatchHistory = WatchHistory.FromSchema(schema)
 }
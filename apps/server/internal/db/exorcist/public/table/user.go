@@ -17,13 +17,15 @@ type userTable struct {
 	postgres.Table
 
 	// Columns
-	ID       postgres.ColumnString
-	Username postgres.ColumnString
-	Password postgres.ColumnString
-	Active   postgres.ColumnBool
-	Created  postgres.ColumnTimestamp
-	Modified postgres.ColumnTimestamp
-	GhostID  postgres.ColumnInteger
+	ID                 postgres.ColumnString
+	Username           postgres.ColumnString
+	Password           postgres.ColumnString
+	Active             postgres.ColumnBool
+	Created            postgres.ColumnTimestamp
+	Modified           postgres.ColumnTimestamp
+	GhostID            postgres.ColumnInteger
+	Role               postgres.ColumnString
+	MustChangePassword postgres.ColumnBool
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -64,28 +66,32 @@ func newUserTable(schemaName, tableName, alias string) *UserTable {
 
 func newUserTableImpl(schemaName, tableName, alias string) userTable {
 	var (
-		IDColumn       = postgres.StringColumn("id")
-		UsernameColumn = postgres.StringColumn("username")
-		PasswordColumn = postgres.StringColumn("password")
-		ActiveColumn   = postgres.BoolColumn("active")
-		CreatedColumn  = postgres.TimestampColumn("created")
-		ModifiedColumn = postgres.TimestampColumn("modified")
-		GhostIDColumn  = postgres.IntegerColumn("ghost_id")
-		allColumns     = postgres.ColumnList{IDColumn, UsernameColumn, PasswordColumn, ActiveColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
-		mutableColumns = postgres.ColumnList{UsernameColumn, PasswordColumn, ActiveColumn, CreatedColumn, ModifiedColumn, GhostIDColumn}
+		IDColumn                 = postgres.StringColumn("id")
+		UsernameColumn           = postgres.StringColumn("username")
+		PasswordColumn           = postgres.StringColumn("password")
+		ActiveColumn             = postgres.BoolColumn("active")
+		CreatedColumn            = postgres.TimestampColumn("created")
+		ModifiedColumn           = postgres.TimestampColumn("modified")
+		GhostIDColumn            = postgres.IntegerColumn("ghost_id")
+		RoleColumn               = postgres.StringColumn("role")
+		MustChangePasswordColumn = postgres.BoolColumn("must_change_password")
+		allColumns               = postgres.ColumnList{IDColumn, UsernameColumn, PasswordColumn, ActiveColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, RoleColumn, MustChangePasswordColumn}
+		mutableColumns           = postgres.ColumnList{UsernameColumn, PasswordColumn, ActiveColumn, CreatedColumn, ModifiedColumn, GhostIDColumn, RoleColumn, MustChangePasswordColumn}
 	)
 
 	return userTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:       IDColumn,
-		Username: UsernameColumn,
-		Password: PasswordColumn,
-		Active:   ActiveColumn,
-		Created:  CreatedColumn,
-		Modified: ModifiedColumn,
-		GhostID:  GhostIDColumn,
+		ID:                 IDColumn,
+		Username:           UsernameColumn,
+		Password:           PasswordColumn,
+		Active:             ActiveColumn,
+		Created:            CreatedColumn,
+		Modified:           ModifiedColumn,
+		GhostID:            GhostIDColumn,
+		Role:               RoleColumn,
+		MustChangePassword: MustChangePasswordColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -17,12 +17,22 @@ type videoTable struct {
 	postgres.Table
 
 	// Columns
-	ID      postgres.ColumnString
-	MediaID postgres.ColumnString
-	Height  postgres.ColumnInteger
-	Width   postgres.ColumnInteger
-	Runtime postgres.ColumnFloat
-	GhostID postgres.ColumnInteger
+	ID              postgres.ColumnString
+	MediaID         postgres.ColumnString
+	Height          postgres.ColumnInteger
+	Width           postgres.ColumnInteger
+	Runtime         postgres.ColumnFloat
+	GhostID         postgres.ColumnInteger
+	VideoCodec      postgres.ColumnString
+	AudioCodec      postgres.ColumnString
+	Container       postgres.ColumnString
+	Bitrate         postgres.ColumnInteger
+	VideoBitrate    postgres.ColumnInteger
+	FrameRate       postgres.ColumnFloat
+	PixelFormat     postgres.ColumnString
+	AudioStreams    postgres.ColumnString
+	SubtitleStreams postgres.ColumnString
+	Phash           postgres.ColumnInteger
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -63,26 +73,46 @@ func newVideoTable(schemaName, tableName, alias string) *VideoTable {
 
 func newVideoTableImpl(schemaName, tableName, alias string) videoTable {
 	var (
-		IDColumn       = postgres.StringColumn("id")
-		MediaIDColumn  = postgres.StringColumn("media_id")
-		HeightColumn   = postgres.IntegerColumn("height")
-		WidthColumn    = postgres.IntegerColumn("width")
-		RuntimeColumn  = postgres.FloatColumn("runtime")
-		GhostIDColumn  = postgres.IntegerColumn("ghost_id")
-		allColumns     = postgres.ColumnList{IDColumn, MediaIDColumn, HeightColumn, WidthColumn, RuntimeColumn, GhostIDColumn}
-		mutableColumns = postgres.ColumnList{MediaIDColumn, HeightColumn, WidthColumn, RuntimeColumn, GhostIDColumn}
+		IDColumn              = postgres.StringColumn("id")
+		MediaIDColumn         = postgres.StringColumn("media_id")
+		HeightColumn          = postgres.IntegerColumn("height")
+		WidthColumn           = postgres.IntegerColumn("width")
+		RuntimeColumn         = postgres.FloatColumn("runtime")
+		GhostIDColumn         = postgres.IntegerColumn("ghost_id")
+		VideoCodecColumn      = postgres.StringColumn("video_codec")
+		AudioCodecColumn      = postgres.StringColumn("audio_codec")
+		ContainerColumn       = postgres.StringColumn("container")
+		BitrateColumn         = postgres.IntegerColumn("bitrate")
+		VideoBitrateColumn    = postgres.IntegerColumn("video_bitrate")
+		FrameRateColumn       = postgres.FloatColumn("frame_rate")
+		PixelFormatColumn     = postgres.StringColumn("pixel_format")
+		AudioStreamsColumn    = postgres.StringColumn("audio_streams")
+		SubtitleStreamsColumn = postgres.StringColumn("subtitle_streams")
+		PhashColumn           = postgres.IntegerColumn("phash")
+		allColumns            = postgres.ColumnList{IDColumn, MediaIDColumn, HeightColumn, WidthColumn, RuntimeColumn, GhostIDColumn, VideoCodecColumn, AudioCodecColumn, ContainerColumn, BitrateColumn, VideoBitrateColumn, FrameRateColumn, PixelFormatColumn, AudioStreamsColumn, SubtitleStreamsColumn, PhashColumn}
+		mutableColumns        = postgres.ColumnList{MediaIDColumn, HeightColumn, WidthColumn, RuntimeColumn, GhostIDColumn, VideoCodecColumn, AudioCodecColumn, ContainerColumn, BitrateColumn, VideoBitrateColumn, FrameRateColumn, PixelFormatColumn, AudioStreamsColumn, SubtitleStreamsColumn, PhashColumn}
 	)
 
 	return videoTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:      IDColumn,
-		MediaID: MediaIDColumn,
-		Height:  HeightColumn,
-		Width:   WidthColumn,
-		Runtime: RuntimeColumn,
-		GhostID: GhostIDColumn,
+		ID:              IDColumn,
+		MediaID:         MediaIDColumn,
+		Height:          HeightColumn,
+		Width:           WidthColumn,
+		Runtime:         RuntimeColumn,
+		GhostID:         GhostIDColumn,
+		VideoCodec:      VideoCodecColumn,
+		AudioCodec:      AudioCodecColumn,
+		Container:       ContainerColumn,
+		Bitrate:         BitrateColumn,
+		VideoBitrate:    VideoBitrateColumn,
+		FrameRate:       FrameRateColumn,
+		PixelFormat:     PixelFormatColumn,
+		AudioStreams:    AudioStreamsColumn,
+		SubtitleStreams: SubtitleStreamsColumn,
+		Phash:           PhashColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+type AliasDTO struct {
+	ID      uuid.UUID `json:"id"`
+	Alias   string    `json:"alias"`
+	Created time.Time `json:"created"`
+}
+
+func (o *AliasDTO) FromTagAlias(m model.TagAlias) *AliasDTO {
+	o.ID = m.ID
+	o.Alias = m.Alias
+	o.Created = m.Created
+
+	return o
+}
+
+func (o *AliasDTO) FromPersonAlias(m model.PersonAlias) *AliasDTO {
+	o.ID = m.ID
+	o.Alias = m.Alias
+	o.Created = m.Created
+
+	return o
+}
+
+type CreateAliasDTO struct {
+	Alias string `json:"alias" binding:"required"`
+}
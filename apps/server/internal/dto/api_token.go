@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+type CreateApiTokenDTO struct {
+	Name    string              `json:"name" binding:"required"`
+	Scope   *model.UserRoleEnum `json:"scope" binding:"omitempty,oneof=admin editor viewer" tstype:"model.UserRoleEnum"`
+	Expires *time.Time          `json:"expires"`
+}
+
+type ApiTokenDTO struct {
+	Id       uuid.UUID           `json:"id"`
+	Name     string              `json:"name"`
+	Scope    *model.UserRoleEnum `json:"scope,omitempty" tstype:"model.UserRoleEnum"`
+	Expires  *time.Time          `json:"expires,omitempty"`
+	LastUsed *time.Time          `json:"lastUsed,omitempty"`
+	Created  time.Time           `json:"created"`
+}
+
+func (a *ApiTokenDTO) FromModel(m model.APIToken) *ApiTokenDTO {
+	a.Id = m.ID
+	a.Name = m.Name
+	a.Scope = m.Scope
+	a.Expires = m.Expires
+	a.LastUsed = m.LastUsed
+	a.Created = m.Created
+
+	return a
+}
+
+// CreatedApiTokenDTO is only returned when a token is created. The token itself can not be retrieved again.
+type CreatedApiTokenDTO struct {
+	ApiTokenDTO
+	Token string `json:"token"`
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+)
+
+type DuplicateKind string
+
+const (
+	DuplicateKind_Checksum       DuplicateKind = "checksum"
+	DuplicateKind_PerceptualHash DuplicateKind = "perceptual_hash"
+)
+
+var DuplicateKindAllValues = []DuplicateKind{
+	DuplicateKind_Checksum,
+	DuplicateKind_PerceptualHash,
+}
+
+// DefaultPerceptualHashThreshold is the amount of bits that perceptual hashes may differ by to be considered duplicates
+const DefaultPerceptualHashThreshold int = 6
+
+type DuplicateSearchDTO struct {
+	Kinds     []DuplicateKind `form:"kind" json:"kinds"`
+	Threshold *int            `form:"threshold" binding:"omitempty,min=0,max=64" json:"threshold"`
+}
+
+type DuplicateMediaDTO struct {
+	Id       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Path     string    `json:"path"`
+	Size     int64     `json:"size"`
+	Added    time.Time `json:"added"`
+	Checksum *string   `json:"checksum,omitempty"`
+	Height   int32     `json:"height,omitempty"`
+	Width    int32     `json:"width,omitempty"`
+	Runtime  float64   `json:"runtime,omitempty"`
+}
+
+func (d *DuplicateMediaDTO) FromModel(m models.DuplicateMedia) *DuplicateMediaDTO {
+	d.Id = m.Media.ID
+	d.Title = m.Title
+	d.Path = m.Path
+	d.Size = m.Size
+	d.Added = m.Added
+	d.Checksum = m.Checksum
+
+	if m.Video != nil {
+		d.Height = m.Height
+		d.Width = m.Width
+		d.Runtime = m.Runtime
+	}
+
+	return d
+}
+
+type DuplicateGroupDTO struct {
+	Kind  DuplicateKind       `json:"kind"`
+	Media []DuplicateMediaDTO `json:"media"`
+}
+
+func (d *DuplicateGroupDTO) FromModels(kind DuplicateKind, group []models.DuplicateMedia) *DuplicateGroupDTO {
+	d.Kind = kind
+	d.Media = make([]DuplicateMediaDTO, len(group))
+	for i, m := range group {
+		d.Media[i] = *(&DuplicateMediaDTO{}).FromModel(m)
+	}
+
+	return d
+}
+
+type MergeDuplicatesDTO struct {
+	// The media that is kept
+	KeepId uuid.UUID `json:"keepId" binding:"required"`
+	// The media that are merged into the kept media and soft deleted
+	MergeIds []uuid.UUID `json:"mergeIds" binding:"required,min=1"`
+}
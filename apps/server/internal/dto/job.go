@@ -11,7 +11,7 @@ import (
 
 type CreateJobDTO struct {
 	Type     model.JobTypeEnum      `json:"type" binding:"required" tstype:"model.JobTypeEnum"`
-	Data     map[string]interface{} `json:"data" tstype:"ScanPathData | GenerateThumbnailData | GenerateChaptersData | ConvertData | RefreshMetadata | RefreshLibraryMetadata"`
+	Data     map[string]interface{} `json:"data" tstype:"ScanPathData | ScanLibraryData | GenerateThumbnailData | GenerateChaptersData | GenerateLibraryChaptersData | ConvertData | RefreshMetadata | RefreshLibraryMetadata"`
 	Priority *JobPriority           `json:"priority"`
 }
 
@@ -76,6 +76,8 @@ type JobDTO struct {
 	Outcome  *string             `json:"outcome,omitempty"`
 	Created  time.Time           `json:"created,omitempty"`
 	Modified time.Time           `json:"modified,omitempty"`
+	Progress float64             `json:"progress"`
+	Step     *string             `json:"step,omitempty"`
 }
 
 func (j *JobDTO) FromModel(m model.Job) *JobDTO {
@@ -88,6 +90,80 @@ func (j *JobDTO) FromModel(m model.Job) *JobDTO {
 	j.Outcome = m.Outcome
 	j.Created = m.Created
 	j.Modified = m.Modified
+	j.Progress = m.Progress
+	j.Step = m.Step
 
 	return j
 }
+
+type JobProgressDTO struct {
+	Id       uuid.UUID `json:"id"`
+	Progress float64   `json:"progress"`
+	Step     *string   `json:"step,omitempty"`
+}
+
+func (j *JobProgressDTO) FromModel(m model.Job) *JobProgressDTO {
+	j.Id = m.ID
+	j.Progress = m.Progress
+	j.Step = m.Step
+
+	return j
+}
+
+type JobTreeDTO struct {
+	JobDTO
+	// Status of the job taking all of its descendants into account
+	DerivedStatus model.JobStatusEnum         `json:"derivedStatus" tstype:"model.JobStatusEnum"`
+	Counts        map[model.JobStatusEnum]int `json:"counts" tstype:"Record<model.JobStatusEnum, number>"`
+	Children      []JobTreeDTO                `json:"children"`
+}
+
+// FromModels builds the tree of job from its descendants.
+func (j *JobTreeDTO) FromModels(job model.Job, descendants []model.Job) *JobTreeDTO {
+	children := map[uuid.UUID][]model.Job{}
+	for _, d := range descendants {
+		if d.Parent != nil {
+			children[*d.Parent] = append(children[*d.Parent], d)
+		}
+	}
+
+	return j.fromModel(job, children)
+}
+
+func (j *JobTreeDTO) fromModel(job model.Job, children map[uuid.UUID][]model.Job) *JobTreeDTO {
+	j.JobDTO.FromModel(job)
+	j.Counts = map[model.JobStatusEnum]int{}
+	j.Children = make([]JobTreeDTO, len(children[job.ID]))
+
+	for i, c := range children[job.ID] {
+		child := j.Children[i].fromModel(c, children)
+
+		j.Counts[child.Status]++
+		for status, count := range child.Counts {
+			j.Counts[status] += count
+		}
+	}
+
+	j.DerivedStatus = DeriveJobStatus(job.Status, j.Counts)
+
+	return j
+}
+
+// DeriveJobStatus determines the overall status of a job from its own status and the statuses of its descendants.
+// A job has failed when it or any of its descendants failed and is only complete once all of its descendants are complete.
+func DeriveJobStatus(status model.JobStatusEnum, descendants map[model.JobStatusEnum]int) model.JobStatusEnum {
+	switch {
+	case status == model.JobStatusEnum_Failed || descendants[model.JobStatusEnum_Failed] > 0:
+		return model.JobStatusEnum_Failed
+	case status == model.JobStatusEnum_Cancelled, status == model.JobStatusEnum_NotStarted:
+		return status
+	case status == model.JobStatusEnum_InProgress ||
+		descendants[model.JobStatusEnum_InProgress] > 0 ||
+		descendants[model.JobStatusEnum_NotStarted] > 0:
+		return model.JobStatusEnum_InProgress
+	case descendants[model.JobStatusEnum_Cancelled] > 0:
+		return model.JobStatusEnum_Cancelled
+	default:
+		return model.JobStatusEnum_Completed
+	}
+}
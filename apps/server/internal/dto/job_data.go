@@ -10,6 +10,10 @@ type ScanPathData struct {
 	LibraryPathId uuid.UUID `json:"libraryPathId"`
 }
 
+type ScanLibraryData struct {
+	LibraryId uuid.UUID `json:"libraryId"`
+}
+
 type GenerateThumbnailData struct {
 	MediaId uuid.UUID `json:"mediaId"`
 	Path    string    `json:"path" tstype:"-"`
@@ -22,8 +26,10 @@ type GenerateThumbnailData struct {
 }
 
 type RefreshFields struct {
-	Size     bool `json:"size"`
-	Checksum bool `json:"checksum"`
+	Size           bool `json:"size"`
+	Checksum       bool `json:"checksum"`
+	VideoMetadata  bool `json:"videoMetadata"`
+	PerceptualHash bool `json:"perceptualHash"`
 }
 
 type RefreshMetadata struct {
@@ -46,6 +52,16 @@ type GenerateChaptersData struct {
 	Overwrite    bool      `json:"overwrite"`
 }
 
+type GenerateLibraryChaptersData struct {
+	LibraryId    uuid.UUID `json:"libraryId"`
+	BatchSize    int       `json:"batchSize"`
+	Interval     float64   `json:"interval"`
+	Height       *int      `json:"height"`
+	Width        *int      `json:"width"`
+	MaxDimension int       `json:"maxDimension"`
+	Overwrite    bool      `json:"overwrite"`
+}
+
 type ConvertData struct {
 	MediaId            uuid.UUID `json:"mediaId" binding:"required"`
 	Dimension          Dimension `json:"dimension"`
@@ -73,3 +89,7 @@ func (d *ConvertData) ToFfmpegDto() *ffmpeg.ConvertDto {
 
 	return v
 }
+
+type ExtractSubtitlesData struct {
+	MediaId uuid.UUID `json:"mediaId" binding:"required"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+type CreateJobScheduleDTO struct {
+	Name     string                 `json:"name" binding:"required"`
+	Cron     string                 `json:"cron" binding:"required"`
+	Type     model.JobTypeEnum      `json:"type" binding:"required" tstype:"model.JobTypeEnum"`
+	Data     map[string]interface{} `json:"data" tstype:"ScanPathData | ScanLibraryData | GenerateThumbnailData | GenerateChaptersData | GenerateLibraryChaptersData | ConvertData | RefreshMetadata | RefreshLibraryMetadata"`
+	Priority *JobPriority           `json:"priority"`
+	Enabled  *bool                  `json:"enabled"`
+}
+
+type JobScheduleDTO struct {
+	Id       uuid.UUID         `json:"id"`
+	Name     string            `json:"name"`
+	Cron     string            `json:"cron"`
+	JobType  model.JobTypeEnum `json:"jobType" tstype:"model.JobTypeEnum"`
+	Data     *string           `json:"data,omitempty"`
+	Priority int16             `json:"priority"`
+	Enabled  bool              `json:"enabled"`
+	LastRun  *time.Time        `json:"lastRun,omitempty"`
+	NextRun  *time.Time        `json:"nextRun,omitempty"`
+	Created  time.Time         `json:"created"`
+	Modified time.Time         `json:"modified"`
+}
+
+func (j *JobScheduleDTO) FromModel(m model.JobSchedule) *JobScheduleDTO {
+	j.Id = m.ID
+	j.Name = m.Name
+	j.Cron = m.Cron
+	j.JobType = m.JobType
+	j.Data = m.Data
+	j.Priority = m.Priority
+	j.Enabled = m.Enabled
+	j.LastRun = m.LastRun
+	j.NextRun = m.NextRun
+	j.Created = m.Created
+	j.Modified = m.Modified
+
+	return j
+}
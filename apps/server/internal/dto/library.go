@@ -30,3 +30,17 @@ func (l *LibraryDTO) FromModel(m model.Library) *LibraryDTO {
 type LibraryUpdateDTO struct {
 	Name string `json:"name"`
 }
+
+type LibraryUserDTO struct {
+	LibraryId uuid.UUID `json:"libraryId"`
+	UserId    uuid.UUID `json:"userId"`
+	Created   time.Time `json:"created"`
+}
+
+func (l *LibraryUserDTO) FromModel(m model.LibraryUser) *LibraryUserDTO {
+	l.LibraryId = m.LibraryID
+	l.UserId = m.UserID
+	l.Created = m.Created
+
+	return l
+}
@@ -1,12 +1,14 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
 )
 
@@ -20,6 +22,14 @@ const (
 	MediaOrdinal_Size     MediaOrdinal = "size"
 	MediaOrdinal_Added    MediaOrdinal = "added"
 	MediaOrdinal_Runtime  MediaOrdinal = "runtime"
+	// MediaOrdinal_Relevance ranks results by how well they match the search and falls back to added without one
+	MediaOrdinal_Relevance MediaOrdinal = "relevance"
+	// MediaOrdinal_Position is the order of the media in a playlist and falls back to added outside of one
+	MediaOrdinal_Position MediaOrdinal = "position"
+	// MediaOrdinal_LastWatched is when the user last made progress on the media
+	MediaOrdinal_LastWatched MediaOrdinal = "lastWatched"
+	// MediaOrdinal_Rating is the rating that the user gave the media. Unrated media comes last in both directions.
+	MediaOrdinal_Rating MediaOrdinal = "rating"
 )
 
 var MediaOrdinalAllValues = []MediaOrdinal{
@@ -29,6 +39,10 @@ var MediaOrdinalAllValues = []MediaOrdinal{
 	MediaOrdinal_Path,
 	MediaOrdinal_Size,
 	MediaOrdinal_Title,
+	MediaOrdinal_Relevance,
+	MediaOrdinal_Position,
+	MediaOrdinal_LastWatched,
+	MediaOrdinal_Rating,
 }
 
 func (o MediaOrdinal) ToColumn() postgres.Column {
@@ -46,6 +60,14 @@ func (o MediaOrdinal) ToColumn() postgres.Column {
 		return media.Size
 	case MediaOrdinal_Runtime:
 		return table.Video.Runtime
+	case MediaOrdinal_Relevance:
+		return postgres.FloatColumn(string(MediaOrdinal_Relevance))
+	case MediaOrdinal_Position:
+		return table.PlaylistMedia.Position
+	case MediaOrdinal_LastWatched:
+		return table.MediaProgress.Modified
+	case MediaOrdinal_Rating:
+		return table.MediaRating.Rating
 	default:
 		return media.Added
 	}
@@ -75,14 +97,16 @@ func (w WatchStatus) String() string {
 
 type MediaSearchDTO struct {
 	PageRequestDTO
-	OrderBy       MediaOrdinal  `form:"orderBy" json:"orderBy"`
-	Search        string        `form:"search" json:"search"`
-	Tags          []string      `form:"tags" json:"tags"`
-	People        []string      `form:"people" json:"people"`
-	WatchStatuses []WatchStatus `form:"watchStatuses" json:"watchStatus"`
-	Favourites    bool          `form:"favourites" json:"favourites"`
-	Deleted       *bool         `form:"deleted" json:"deleted"`
-	Exists        *bool         `form:"exists" json:"exists"`
+	OrderBy         MediaOrdinal  `form:"orderBy" json:"orderBy"`
+	Search          string        `form:"search" json:"search"`
+	Tags            []string      `form:"tags" json:"tags"`
+	People          []string      `form:"people" json:"people"`
+	WatchStatuses   []WatchStatus `form:"watchStatuses" json:"watchStatus"`
+	Favourites      bool          `form:"favourites" json:"favourites"`
+	MinRating       *int16        `form:"minRating" json:"minRating" binding:"omitempty,min=1,max=5"`
+	MinPersonRating *int16        `form:"minPersonRating" json:"minPersonRating" binding:"omitempty,min=1,max=5"`
+	Deleted         *bool         `form:"deleted" json:"deleted"`
+	Exists          *bool         `form:"exists" json:"exists"`
 }
 
 // Will check if some fields are nil or in their zero state and apply
@@ -109,6 +133,9 @@ type MediaOverviewDTO struct {
 	Deleted   bool      `json:"deleted"`
 	Runtime   float64   `json:"runtime"`
 	Favourite bool      `json:"favourite"`
+	Rating    *int16    `json:"rating"`
+	Watched   bool      `json:"watched"`
+	PlayCount int32     `json:"playCount"`
 }
 
 func (v *MediaOverviewDTO) FromModel(m models.MediaOverviewModel) *MediaOverviewDTO {
@@ -116,9 +143,15 @@ func (v *MediaOverviewDTO) FromModel(m models.MediaOverviewModel) *MediaOverview
 	v.Title = m.Title
 	v.Deleted = m.Deleted
 	v.Progress = m.MediaProgress.Timestamp
+	v.Watched = m.MediaProgress.Watched != nil
+	v.PlayCount = m.MediaProgress.PlayCount
 
 	v.Favourite = m.FavouriteMedia != nil
 
+	if m.MediaRating != nil {
+		v.Rating = &m.MediaRating.Rating
+	}
+
 	if m.Video != nil {
 		v.Runtime = m.Video.Runtime
 	}
@@ -140,9 +173,12 @@ type MediaDTO struct {
 	Image         *ImageDTO          `json:"image,omitempty"`
 	Video         *VideoDTO          `json:"video,omitempty"`
 	Progress      float64            `json:"progress"`
+	Watched       *time.Time         `json:"watched"`
+	PlayCount     int32              `json:"playCount"`
 	People        []PersonDTO        `json:"people"`
 	Tags          []TagDTO           `json:"tags"`
 	Favourite     bool               `json:"favourite"`
+	Rating        *int16             `json:"rating"`
 	Relations     []MediaRelationDto `json:"relations"`
 }
 
@@ -177,10 +213,16 @@ func (d *MediaDTO) FromModel(m models.Media) *MediaDTO {
 
 	if m.MediaProgress != nil {
 		d.Progress = m.MediaProgress.Timestamp
+		d.Watched = m.MediaProgress.Watched
+		d.PlayCount = m.MediaProgress.PlayCount
 	}
 
 	d.Favourite = m.FavouriteMedia != nil
 
+	if m.MediaRating != nil {
+		d.Rating = &m.MediaRating.Rating
+	}
+
 	d.Image = new(ImageDTO).FromModel(m.Image)
 	d.Video = new(VideoDTO).FromModel(m.Video)
 
@@ -229,11 +271,20 @@ func (d *ImageDTO) FromModel(m *model.Image) *ImageDTO {
 }
 
 type VideoDTO struct {
-	ID      uuid.UUID `json:"id"`
-	MediaID uuid.UUID `json:"mediaId"`
-	Height  int32     `json:"height"`
-	Width   int32     `json:"width"`
-	Runtime float64   `json:"runtime"`
+	ID              uuid.UUID               `json:"id"`
+	MediaID         uuid.UUID               `json:"mediaId"`
+	Height          int32                   `json:"height"`
+	Width           int32                   `json:"width"`
+	Runtime         float64                 `json:"runtime"`
+	VideoCodec      *string                 `json:"videoCodec"`
+	AudioCodec      *string                 `json:"audioCodec"`
+	Container       *string                 `json:"container"`
+	Bitrate         *int64                  `json:"bitrate"`
+	VideoBitrate    *int64                  `json:"videoBitrate"`
+	FrameRate       *float64                `json:"frameRate"`
+	PixelFormat     *string                 `json:"pixelFormat"`
+	AudioStreams    []ffmpeg.AudioStream    `json:"audioStreams"`
+	SubtitleStreams []ffmpeg.SubtitleStream `json:"subtitleStreams"`
 }
 
 func (d *VideoDTO) FromModel(m *model.Video) *VideoDTO {
@@ -246,6 +297,23 @@ func (d *VideoDTO) FromModel(m *model.Video) *VideoDTO {
 	d.Height = m.Height
 	d.Width = m.Width
 	d.Runtime = m.Runtime
+	d.VideoCodec = m.VideoCodec
+	d.AudioCodec = m.AudioCodec
+	d.Container = m.Container
+	d.Bitrate = m.Bitrate
+	d.VideoBitrate = m.VideoBitrate
+	d.FrameRate = m.FrameRate
+	d.PixelFormat = m.PixelFormat
+
+	d.AudioStreams = []ffmpeg.AudioStream{}
+	if m.AudioStreams != nil {
+		_ = json.Unmarshal([]byte(*m.AudioStreams), &d.AudioStreams)
+	}
+
+	d.SubtitleStreams = []ffmpeg.SubtitleStream{}
+	if m.SubtitleStreams != nil {
+		_ = json.Unmarshal([]byte(*m.SubtitleStreams), &d.SubtitleStreams)
+	}
 
 	return d
 }
@@ -1,13 +1,21 @@
 package dto
 
-import "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+import (
+	"time"
+
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
 
 type ProgressDTO struct {
-	Progress float64 `json:"progress"`
+	Progress  float64    `json:"progress"`
+	Watched   *time.Time `json:"watched"`
+	PlayCount int32      `json:"playCount"`
 }
 
 func (d *ProgressDTO) FromModel(m model.MediaProgress) *ProgressDTO {
 	d.Progress = m.Timestamp
+	d.Watched = m.Watched
+	d.PlayCount = m.PlayCount
 	return d
 }
 
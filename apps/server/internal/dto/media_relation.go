@@ -20,7 +20,7 @@ type MediaRelationDto struct {
 	// to the correct type if needed.
 	// This is only used to give the client a full json object without them needing
 	// to parse the json string
-	Metadata any `json:"metadata" tstype:"ThumbnailMetadataDTO | ChapterMetadadataDTO | null"`
+	Metadata any `json:"metadata" tstype:"ThumbnailMetadataDTO | ChapterMetadadataDTO | SubtitleMetadataDTO | null"`
 }
 
 func (d *MediaRelationDto) FromModel(m models.MediaRelation) MediaRelationDto {
@@ -49,6 +49,14 @@ func (d *MediaRelationDto) FromModel(m models.MediaRelation) MediaRelationDto {
 			} else {
 				d.Metadata = thumbnailMetadata
 			}
+		case model.MediaRelationTypeEnum_Subtitle:
+			var subtitleMetadata SubtitleMetadataDTO
+			if e := json.Unmarshal([]byte(*m.Metadata), &subtitleMetadata); e != nil {
+				slog.Error("failed to unmarshall subtitle metadata", "error", e.Error())
+				d.Metadata = nil
+			} else {
+				d.Metadata = subtitleMetadata
+			}
 		}
 	}
 
@@ -7,3 +7,14 @@ type ThumbnailMetadataDTO struct {
 type ChapterMetadadataDTO struct {
 	Timestamp float64 `json:"timestamp"`
 }
+
+type SubtitleMetadataDTO struct {
+	Language string `json:"language,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Default  bool   `json:"default"`
+	Forced   bool   `json:"forced"`
+	// Set when the track was converted from a sidecar file next to the video
+	Sidecar string `json:"sidecar,omitempty"`
+	// Set when the track was extracted from a stream embedded in the video
+	StreamIndex *int `json:"streamIndex,omitempty"`
+}
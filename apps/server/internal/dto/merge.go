@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/google/uuid"
+
+type MergeDTO struct {
+	// The tags or people that are merged into the one in the route and deleted afterwards
+	MergeIds []uuid.UUID `json:"mergeIds" binding:"required,min=1"`
+}
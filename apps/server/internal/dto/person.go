@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
 )
 
 type PersonOrdinal string
@@ -55,10 +56,12 @@ func (o PersonOrdinal) String() string {
 }
 
 type PersonDTO struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name"`
-	Created  time.Time `json:"created"`
-	Modified time.Time `json:"modified"`
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Created   time.Time `json:"created"`
+	Modified  time.Time `json:"modified"`
+	Rating    *int16    `json:"rating,omitempty"`
+	Favourite bool      `json:"favourite,omitempty"`
 }
 
 func (o *PersonDTO) FromModel(m *model.Person) *PersonDTO {
@@ -70,10 +73,24 @@ func (o *PersonDTO) FromModel(m *model.Person) *PersonDTO {
 	return o
 }
 
+// FromUserModel also sets the rating and favourite of the user that the person was fetched for
+func (o *PersonDTO) FromUserModel(m models.Person) *PersonDTO {
+	o.FromModel(&m.Person)
+
+	if m.PersonRating != nil {
+		o.Rating = &m.PersonRating.Rating
+	}
+	o.Favourite = m.FavouritePerson != nil
+
+	return o
+}
+
 type PersonSearchDTO struct {
-	Search  string        `form:"search" json:"search"`
-	OrderBy PersonOrdinal `form:"orderBy" json:"orderBy"`
-	Asc     bool          `form:"asc" json:"asc"`
+	Search     string        `form:"search" json:"search"`
+	OrderBy    PersonOrdinal `form:"orderBy" json:"orderBy"`
+	Asc        bool          `form:"asc" json:"asc"`
+	Favourites bool          `form:"favourites" json:"favourites"`
+	MinRating  *int16        `form:"minRating" json:"minRating" binding:"omitempty,min=1,max=5"`
 }
 
 type PersonUpdateDTO struct {
@@ -1,29 +1,43 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
 )
 
 type CreatePlaylistDTO struct {
 	Name string `json:"name"`
+	// Search makes a smart playlist of the media that matches it. Paging is left out as it comes from the request for the media.
+	Search *MediaSearchDTO `json:"search"`
 }
 
 type PlaylistDTO struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name"`
-	Created  time.Time `json:"created"`
-	Modified time.Time `json:"modified"`
+	ID       uuid.UUID       `json:"id"`
+	Name     string          `json:"name"`
+	UserID   uuid.UUID       `json:"userId"`
+	Search   *MediaSearchDTO `json:"search,omitempty"`
+	Created  time.Time       `json:"created"`
+	Modified time.Time       `json:"modified"`
 }
 
 func (d *PlaylistDTO) FromModel(m model.Playlist) *PlaylistDTO {
 	d.ID = m.ID
 	d.Name = m.Name
+	d.UserID = m.UserID
 	d.Created = m.Created
 	d.Modified = m.Modified
 
+	if m.Search != nil {
+		var search MediaSearchDTO
+		if err := json.Unmarshal([]byte(*m.Search), &search); err == nil {
+			d.Search = &search
+		}
+	}
+
 	return d
 }
 
@@ -34,3 +48,44 @@ type CreatePlaylistMediaDTO struct {
 type PlaylistUpdateDTO struct {
 	Name string `json:"name"`
 }
+
+type MovePlaylistMediaDTO struct {
+	// Position is where the media goes in the playlist starting from 0. Positions past the end move it to the end.
+	Position *int `json:"position" binding:"required,min=0"`
+}
+
+// SharedPlaylistDTO is a playlist that another user shared along with the access that was granted
+type SharedPlaylistDTO struct {
+	PlaylistDTO
+	Access model.PlaylistAccessEnum `json:"access"`
+}
+
+func (d *SharedPlaylistDTO) FromModel(m models.SharedPlaylist) *SharedPlaylistDTO {
+	d.PlaylistDTO.FromModel(m.Playlist)
+	d.Access = m.PlaylistUser.Access
+
+	return d
+}
+
+type PlaylistUserDTO struct {
+	PlaylistId uuid.UUID                `json:"playlistId"`
+	UserId     uuid.UUID                `json:"userId"`
+	Access     model.PlaylistAccessEnum `json:"access"`
+	Created    time.Time                `json:"created"`
+	Modified   time.Time                `json:"modified"`
+}
+
+func (d *PlaylistUserDTO) FromModel(m model.PlaylistUser) *PlaylistUserDTO {
+	d.PlaylistId = m.PlaylistID
+	d.UserId = m.UserID
+	d.Access = m.Access
+	d.Created = m.Created
+	d.Modified = m.Modified
+
+	return d
+}
+
+type GrantPlaylistAccessDTO struct {
+	// Access is read to only view the playlist or collaborate to also change its media
+	Access model.PlaylistAccessEnum `json:"access" binding:"required,oneof=read collaborate"`
+}
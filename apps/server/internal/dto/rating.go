@@ -0,0 +1,6 @@
+package dto
+
+// RatingDTO is how many stars out of five a user gives media or a person
+type RatingDTO struct {
+	Rating int16 `json:"rating" binding:"required,min=1,max=5"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+type SessionDTO struct {
+	Id        uuid.UUID `json:"id"`
+	UserAgent *string   `json:"userAgent,omitempty"`
+	IpAddress *string   `json:"ipAddress,omitempty"`
+	LastSeen  time.Time `json:"lastSeen"`
+	Created   time.Time `json:"created"`
+	Expires   time.Time `json:"expires"`
+	// Current is true for the session that made the request
+	Current bool `json:"current"`
+}
+
+func (s *SessionDTO) FromModel(m model.UserSession, current *uuid.UUID) *SessionDTO {
+	s.Id = m.ID
+	s.UserAgent = m.UserAgent
+	s.IpAddress = m.IPAddress
+	s.LastSeen = m.LastSeen
+	s.Created = m.Created
+	s.Expires = m.Expires
+	s.Current = current != nil && *current == m.ID
+
+	return s
+}
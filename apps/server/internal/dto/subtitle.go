@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+)
+
+type SubtitleTrackDTO struct {
+	// ID of the webvtt asset, used to fetch the track
+	ID       uuid.UUID `json:"id"`
+	Language string    `json:"language,omitempty"`
+	Title    string    `json:"title,omitempty"`
+	Default  bool      `json:"default"`
+	Forced   bool      `json:"forced"`
+	Embedded bool      `json:"embedded"`
+}
+
+func (d *SubtitleTrackDTO) FromModel(m models.MediaRelation) *SubtitleTrackDTO {
+	d.ID = m.RelatedTo
+
+	if m.Metadata != nil {
+		var metadata SubtitleMetadataDTO
+		if err := json.Unmarshal([]byte(*m.Metadata), &metadata); err == nil {
+			d.Language = metadata.Language
+			d.Title = metadata.Title
+			d.Default = metadata.Default
+			d.Forced = metadata.Forced
+			d.Embedded = metadata.StreamIndex != nil
+		}
+	}
+
+	return d
+}
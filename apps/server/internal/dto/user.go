@@ -0,0 +1,108 @@
+package dto
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+type Permission string
+
+const (
+	Permission_EditMedia             Permission = "edit_media"
+	Permission_RunJobs               Permission = "run_jobs"
+	Permission_ManageJobs            Permission = "manage_jobs"
+	Permission_ManageLibraries       Permission = "manage_libraries"
+	Permission_ManageUsers           Permission = "manage_users"
+	Permission_DeleteMediaPhysically Permission = "delete_media_physically"
+)
+
+var PermissionAllValues = []Permission{
+	Permission_EditMedia,
+	Permission_RunJobs,
+	Permission_ManageJobs,
+	Permission_ManageLibraries,
+	Permission_ManageUsers,
+	Permission_DeleteMediaPhysically,
+}
+
+// RolePermissions maps a role to the permissions it grants. Viewers can only read and manage their own data.
+var RolePermissions = map[model.UserRoleEnum][]Permission{
+	model.UserRoleEnum_Admin:  PermissionAllValues,
+	model.UserRoleEnum_Editor: {Permission_EditMedia, Permission_RunJobs},
+	model.UserRoleEnum_Viewer: {},
+}
+
+// HasPermission reports whether the role grants the permission
+func HasPermission(role model.UserRoleEnum, permission Permission) bool {
+	return slices.Contains(RolePermissions[role], permission)
+}
+
+var roleRank = map[model.UserRoleEnum]int{
+	model.UserRoleEnum_Viewer: 0,
+	model.UserRoleEnum_Editor: 1,
+	model.UserRoleEnum_Admin:  2,
+}
+
+// LeastPrivileged returns the role that grants the least permissions
+func LeastPrivileged(role, other model.UserRoleEnum) model.UserRoleEnum {
+	if roleRank[other] < roleRank[role] {
+		return other
+	}
+
+	return role
+}
+
+type UserDTO struct {
+	Id          uuid.UUID          `json:"id"`
+	Username    string             `json:"username"`
+	Role        model.UserRoleEnum `json:"role" tstype:"model.UserRoleEnum"`
+	Permissions []Permission       `json:"permissions"`
+	// MustChangePassword is set until the user replaces a default password
+	MustChangePassword bool `json:"mustChangePassword"`
+}
+
+func (u *UserDTO) FromModel(m model.User) *UserDTO {
+	u.Id = m.ID
+	u.Username = m.Username
+	u.Role = m.Role
+	u.MustChangePassword = m.MustChangePassword
+	u.Permissions = RolePermissions[m.Role]
+	if u.Permissions == nil {
+		u.Permissions = []Permission{}
+	}
+
+	return u
+}
+
+// ManagedUserDTO is how users are shown to the users that manage them
+type ManagedUserDTO struct {
+	UserDTO
+	Active   bool      `json:"active"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+}
+
+func (u *ManagedUserDTO) FromModel(m model.User) *ManagedUserDTO {
+	u.UserDTO.FromModel(m)
+	u.Active = m.Active
+	u.Created = m.Created
+	u.Modified = m.Modified
+
+	return u
+}
+
+// UpdateUserDTO only changes the fields that are provided
+type UpdateUserDTO struct {
+	Username *string             `json:"username" binding:"omitempty,min=1"`
+	Role     *model.UserRoleEnum `json:"role" binding:"omitempty,oneof=admin editor viewer" tstype:"model.UserRoleEnum"`
+	Active   *bool               `json:"active"`
+}
+
+// SetPasswordDTO is used by users that manage other users to set their password without knowing the old one
+type SetPasswordDTO struct {
+	Password       string `json:"password" binding:"required"`
+	RepeatPassword string `json:"repeatPassword" binding:"required,eqfield=Password"`
+}
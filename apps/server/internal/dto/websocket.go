@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"github.com/google/uuid"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
 )
@@ -10,6 +11,8 @@ type WSTopic string
 const (
 	WSTopic_JobUpdate           WSTopic = "job_update"
 	WSTopic_JobCreate           WSTopic = "job_create"
+	WSTopic_JobDelete           WSTopic = "job_delete"
+	WSTopic_JobProgress         WSTopic = "job_progress"
 	WSTopic_MediaUpdate         WSTopic = "media_update"
 	WSTopic_MediaOverviewUpdate WSTopic = "media_overview_update"
 	WSTopic_MediaCreate         WSTopic = "media_create"
@@ -19,6 +22,8 @@ const (
 var WSTopicAllValues = []WSTopic{
 	WSTopic_JobUpdate,
 	WSTopic_JobCreate,
+	WSTopic_JobDelete,
+	WSTopic_JobProgress,
 	WSTopic_MediaUpdate,
 	WSTopic_MediaOverviewUpdate,
 	WSTopic_MediaCreate,
@@ -35,7 +40,16 @@ type WSMessage[T any] struct {
 }
 
 func (msg *WSMessage[T]) SendToAll(wss models.WebSocketMap) error {
-	for _, ws := range wss {
+	return msg.SendTo(wss, func(uuid.UUID) bool { return true })
+}
+
+// SendTo sends the message to the websockets of the users that the filter accepts
+func (msg *WSMessage[T]) SendTo(wss models.WebSocketMap, filter func(userId uuid.UUID) bool) error {
+	for userId, ws := range wss {
+		if !filter(userId) {
+			continue
+		}
+
 		for _, s := range ws {
 			s.Mu.Lock()
 			if err := s.Conn.WriteJSON(msg); err != nil {
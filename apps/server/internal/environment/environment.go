@@ -37,12 +37,16 @@ type EnvironmentVariables struct {
 	Web                        *string
 	JobRunner                  bool
 	DisableJobs                []model.JobTypeEnum
+	JobConcurrency             map[model.JobTypeEnum]int
 	CorsOrigins                []string
 	WebsocketHeartbeatInterval int
 	MigrationPath              string
 	CookieSecure               bool
 	CookieMaxAge               int
 	CookieHttpOnly             bool
+	HlsCacheMaxAge             int
+	HlsCacheMaxSize            int
+	WatchedThreshold           int
 }
 
 type OsEnv = string
@@ -64,12 +68,16 @@ const (
 	WEB                          OsEnv = "WEB"
 	JOB_RUNNER                   OsEnv = "JOB_RUNNER"
 	DISABLE_JOBS                 OsEnv = "DISABLE_JOBS"
+	JOB_CONCURRENCY              OsEnv = "JOB_CONCURRENCY"
 	CORS_ORIGINS                 OsEnv = "CORS_ORIGINS"
 	WEBSOCKET_HEARTBEAT_INTERVAL OsEnv = "WEBSOCKET_HEARTBEAT_INTERVAL"
 	MIGRATIONS_PATH              OsEnv = "MIGRATIONS_PATH"
 	COOKIE_SECURE                OsEnv = "COOKIE_SECURE"
 	COOKIE_MAX_AGE               OsEnv = "COOKIE_MAX_AGE"
 	COOKIE_HTTP_ONLY             OsEnv = "COOKIE_HTTP_ONLY"
+	HLS_CACHE_MAX_AGE            OsEnv = "HLS_CACHE_MAX_AGE"
+	HLS_CACHE_MAX_SIZE           OsEnv = "HLS_CACHE_MAX_SIZE"
+	WATCHED_THRESHOLD            OsEnv = "WATCHED_THRESHOLD"
 )
 
 var env *EnvironmentVariables
@@ -101,12 +109,16 @@ func RefreshEnvironmentVariables() {
 		Web:                        getValueOrNil(WEB),
 		JobRunner:                  getBoolValue(JOB_RUNNER, true),
 		DisableJobs:                toJobTypes(strings.Split(os.Getenv(DISABLE_JOBS), ";")),
+		JobConcurrency:             toJobConcurrency(strings.Split(os.Getenv(JOB_CONCURRENCY), ";")),
 		CorsOrigins:                strings.Split(os.Getenv(CORS_ORIGINS), ";"),
 		WebsocketHeartbeatInterval: getIntValue(WEBSOCKET_HEARTBEAT_INTERVAL),
 		MigrationPath:              getValueOrDefault(MIGRATIONS_PATH, "./apps/server/migrations"),
 		CookieSecure:               getBoolValue(COOKIE_SECURE, true),
 		CookieMaxAge:               getIntValueOrDefault(COOKIE_MAX_AGE, 0),
 		CookieHttpOnly:             getBoolValue(COOKIE_HTTP_ONLY, false),
+		HlsCacheMaxAge:             getIntValueOrDefault(HLS_CACHE_MAX_AGE, 60),
+		HlsCacheMaxSize:            getIntValueOrDefault(HLS_CACHE_MAX_SIZE, 2048),
+		WatchedThreshold:           watchedThreshold(),
 	}
 }
 
@@ -121,6 +133,56 @@ func toJobTypes(strs []string) []model.JobTypeEnum {
 	return types
 }
 
+const defaultWatchedThreshold int = 90
+
+// watchedThreshold reads the percentage of a video that has to be watched for it to count as watched
+func watchedThreshold() int {
+	e := os.Getenv(WATCHED_THRESHOLD)
+	if e == "" {
+		return defaultWatchedThreshold
+	}
+
+	value, err := strconv.Atoi(strings.TrimSpace(e))
+	if err != nil || value < 1 || value > 100 {
+		log.Printf("Invalid watched threshold %v. It should be a percentage between 1 and 100. Using %v instead", e, defaultWatchedThreshold)
+		return defaultWatchedThreshold
+	}
+
+	return value
+}
+
+// toJobConcurrency parses values like generate_thumbnail:4 into the amount of jobs of a type that may run at once
+func toJobConcurrency(strs []string) map[model.JobTypeEnum]int {
+	concurrency := map[model.JobTypeEnum]int{}
+	for _, str := range strs {
+		if str == "" {
+			continue
+		}
+
+		jobTypeStr, countStr, found := strings.Cut(str, ":")
+		if !found {
+			log.Printf("Could not read job concurrency from %v. Expected <job_type>:<count>", str)
+			continue
+		}
+
+		var jobType model.JobTypeEnum
+		if err := jobType.Scan(strings.TrimSpace(jobTypeStr)); err != nil {
+			log.Printf("Could not convert %v to job type enum", jobTypeStr)
+			continue
+		}
+
+		count, err := strconv.Atoi(strings.TrimSpace(countStr))
+		if err != nil || count < 1 {
+			log.Printf("Invalid concurrency %v for job type %v. It should be a number above 0", countStr, jobTypeStr)
+			continue
+		}
+
+		concurrency[jobType] = count
+	}
+
+	return concurrency
+}
+
 func getValueOrNil(key OsEnv) *string {
 	val := os.Getenv(key)
 	if val == "" {
@@ -0,0 +1,29 @@
+package environment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WatchedThreshold(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected int
+	}{
+		{"", 90},
+		{"75", 75},
+		{"1", 1},
+		{"100", 100},
+		{"0", 90},
+		{"101", 90},
+		{"-5", 90},
+		{"most", 90},
+	}
+
+	for _, c := range cases {
+		t.Setenv(WATCHED_THRESHOLD, c.value)
+
+		assert.Equal(t, c.expected, watchedThreshold(), c.value)
+	}
+}
@@ -1,6 +1,7 @@
 package ffmpeg
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -15,9 +16,10 @@ type ConvertDto struct {
 	ConstantRateFactor *int
 	VariableBitrate    *int
 	ForcePixelFormat   *string
+	OnProgress         ProgressFunc
 }
 
-func Convert(c ConvertDto) error {
+func Convert(ctx context.Context, c ConvertDto) error {
 	if *c.Dimension.Height <= 0 {
 		return fmt.Errorf(ErrNegativeHeight, *c.Dimension.Height)
 	}
@@ -35,9 +37,17 @@ func Convert(c ConvertDto) error {
 		ouptutArgs["pix_fmt"] = *c.ForcePixelFormat
 	}
 
-	err := ffmpeg_go.Input(c.InputFilePath).Output(c.OutputFilePath,
-		ouptutArgs).
-		Run()
+	stream := ffmpeg_go.Input(c.InputFilePath).Output(c.OutputFilePath, ouptutArgs)
+	if c.OnProgress != nil {
+		stream = stream.GlobalArgs("-progress", "pipe:1", "-nostats")
+	}
+
+	stream.Context = ctx // global args create a new stream so the context is set last
+	if c.OnProgress != nil {
+		stream = stream.WithOutput(newProgressWriter(c.OnProgress))
+	}
+
+	err := stream.Run()
 	if err != nil {
 		str := err.Error()
 		_ = str
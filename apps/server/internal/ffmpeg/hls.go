@@ -0,0 +1,90 @@
+package ffmpeg
+
+import (
+	"fmt"
+	"os"
+
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	ffmpeg_go "github.com/u2takey/ffmpeg-go"
+)
+
+type Rendition struct {
+	Name         string
+	Height       int
+	VideoBitrate int // kbps
+	AudioBitrate int // kbps
+}
+
+// Bandwidth is the peak bandwidth of the rendition in bits per second
+func (r Rendition) Bandwidth() int {
+	return (r.VideoBitrate + r.AudioBitrate) * 1000
+}
+
+var Renditions = []Rendition{
+	{Name: "1080p", Height: 1080, VideoBitrate: 5000, AudioBitrate: 192},
+	{Name: "720p", Height: 720, VideoBitrate: 2800, AudioBitrate: 128},
+	{Name: "480p", Height: 480, VideoBitrate: 1400, AudioBitrate: 128},
+	{Name: "360p", Height: 360, VideoBitrate: 800, AudioBitrate: 96},
+}
+
+// RenditionsFor returns the renditions that do not upscale the source.
+// The smallest rendition is always returned so that there is something to play.
+func RenditionsFor(sourceHeight int) []Rendition {
+	renditions := []Rendition{}
+	for _, r := range Renditions {
+		if r.Height <= sourceHeight {
+			renditions = append(renditions, r)
+		}
+	}
+
+	if len(renditions) == 0 {
+		renditions = append(renditions, Renditions[len(Renditions)-1])
+	}
+
+	return renditions
+}
+
+type SegmentDto struct {
+	InputFilePath  string
+	OutputFilePath string
+	Start          float64
+	Duration       float64
+	Rendition      Rendition
+}
+
+func TranscodeSegment(s SegmentDto) error {
+	if s.Rendition.Height <= 0 {
+		return fmt.Errorf(ErrNegativeHeight, s.Rendition.Height)
+	}
+
+	outputArgs := ffmpeg_go.KwArgs{
+		"t":                fmt.Sprintf("%.6f", s.Duration),
+		"map":              []string{"0:v:0", "0:a:0?"},
+		"vf":               fmt.Sprintf("scale=-2:%v", s.Rendition.Height),
+		"c:v":              "libx264",
+		"preset":           "veryfast",
+		"profile:v":        "high",
+		"pix_fmt":          "yuv420p",
+		"b:v":              fmt.Sprintf("%vk", s.Rendition.VideoBitrate),
+		"maxrate":          fmt.Sprintf("%vk", s.Rendition.VideoBitrate),
+		"bufsize":          fmt.Sprintf("%vk", s.Rendition.VideoBitrate*2),
+		"c:a":              "aac",
+		"b:a":              fmt.Sprintf("%vk", s.Rendition.AudioBitrate),
+		"ac":               2,
+		"sn":               "",
+		"muxdelay":         0,
+		"output_ts_offset": fmt.Sprintf("%.6f", s.Start),
+		"f":                "mpegts",
+	}
+
+	err := ffmpeg_go.Input(s.InputFilePath, ffmpeg_go.KwArgs{"ss": fmt.Sprintf("%.6f", s.Start)}).
+		Output(s.OutputFilePath, outputArgs).
+		OverWriteOutput().
+		Run()
+	if err != nil {
+		_ = os.Remove(s.OutputFilePath)
+		return errs.BuildError(err, "error transcoding segment of %v at %v to %v", s.InputFilePath, s.Start, s.OutputFilePath)
+	}
+
+	return nil
+}
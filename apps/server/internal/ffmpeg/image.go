@@ -1,6 +1,7 @@
 package ffmpeg
 
 import (
+	"context"
 	"fmt"
 
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
@@ -11,6 +12,7 @@ const (
 	ErrExtractingImage string = "error extracting image (%v) from video (%v) at (%v) with resolution %vx%v"
 	ErrNegativeWidth   string = "width cannot be negative or zero: %v"
 	ErrNegativeHeight  string = "height cannot be negative or zero: %v"
+	ErrScalingImage    string = "error scaling image (%v) to (%v) with resolution %vx%v"
 )
 
 func ScaleWidthByHeight(currentHeight, currentWidth, wantedHeight int) int {
@@ -71,7 +73,7 @@ func ScaleByMaxDimension(maxDimension int, currentDimension Dimension) *Dimensio
 	return &d
 }
 
-func ImageAt(vid string, time float64, img string, width, height int) error {
+func ImageAt(ctx context.Context, vid string, time float64, img string, width, height int) error {
 	if width <= 0 {
 		return fmt.Errorf(ErrNegativeWidth, width)
 	}
@@ -79,8 +81,8 @@ func ImageAt(vid string, time float64, img string, width, height int) error {
 		return fmt.Errorf(ErrNegativeHeight, height)
 	}
 
-	err := ffmpeg_go.Input(vid, ffmpeg_go.KwArgs{"ss": time}).
-		Output(img, ffmpeg_go.KwArgs{"vframes": 1, "s": fmt.Sprintf("%vx%v", width, height)}).
+	input := ffmpeg_go.Input(vid, ffmpeg_go.KwArgs{"ss": time})
+	err := ffmpeg_go.OutputContext(ctx, []*ffmpeg_go.Stream{input}, img, ffmpeg_go.KwArgs{"vframes": 1, "s": fmt.Sprintf("%vx%v", width, height)}).
 		Run()
 
 	if err != nil {
@@ -89,3 +91,21 @@ func ImageAt(vid string, time float64, img string, width, height int) error {
 
 	return nil
 }
+
+func ScaleImage(ctx context.Context, src, dst string, width, height int) error {
+	if width <= 0 {
+		return fmt.Errorf(ErrNegativeWidth, width)
+	}
+	if height <= 0 {
+		return fmt.Errorf(ErrNegativeHeight, height)
+	}
+
+	err := ffmpeg_go.OutputContext(ctx, []*ffmpeg_go.Stream{ffmpeg_go.Input(src)}, dst, ffmpeg_go.KwArgs{"vframes": 1, "s": fmt.Sprintf("%vx%v", width, height)}).
+		Run()
+
+	if err != nil {
+		return errs.BuildError(err, ErrScalingImage, src, dst, width, height)
+	}
+
+	return nil
+}
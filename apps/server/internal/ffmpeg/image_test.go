@@ -1,6 +1,7 @@
 package ffmpeg
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -11,7 +12,7 @@ import (
 func Test_ImageAt_NegativeWidth(t *testing.T) {
 	width := -1
 
-	err := ImageAt("", 0, "", width, 1)
+	err := ImageAt(context.Background(), "", 0, "", width, 1)
 
 	assert.ErrorContains(t, err, fmt.Sprintf(ErrNegativeWidth, width))
 }
@@ -19,7 +20,7 @@ func Test_ImageAt_NegativeWidth(t *testing.T) {
 func Test_ImageAt_NegativeHeight(t *testing.T) {
 	height := -1
 
-	err := ImageAt("", 0, "", 1, height)
+	err := ImageAt(context.Background(), "", 0, "", 1, height)
 
 	assert.ErrorContains(t, err, fmt.Sprintf(ErrNegativeHeight, height))
 }
@@ -28,7 +29,7 @@ func Test_ImageAt_Success(t *testing.T) {
 	width, height := 20, 60
 	time := float64(3)
 
-	err := ImageAt(testVideoPath, time, testImagePath, width, height)
+	err := ImageAt(context.Background(), testVideoPath, time, testImagePath, width, height)
 	assert.Nil(t, err)
 	assert.FileExists(t, testImagePath)
 
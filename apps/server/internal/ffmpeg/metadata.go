@@ -0,0 +1,151 @@
+package ffmpeg
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	codecTypeVideo    string = "video"
+	codecTypeAudio    string = "audio"
+	codecTypeSubtitle string = "subtitle"
+)
+
+type AudioStream struct {
+	Index         int    `json:"index"`
+	Codec         string `json:"codec"`
+	Language      string `json:"language,omitempty"`
+	Title         string `json:"title,omitempty"`
+	Channels      int    `json:"channels"`
+	ChannelLayout string `json:"channelLayout,omitempty"`
+	SampleRate    int    `json:"sampleRate,omitempty"`
+	Bitrate       int64  `json:"bitrate,omitempty"`
+	Default       bool   `json:"default"`
+}
+
+type SubtitleStream struct {
+	Index    int    `json:"index"`
+	Codec    string `json:"codec"`
+	Language string `json:"language,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Default  bool   `json:"default"`
+	Forced   bool   `json:"forced"`
+}
+
+type Metadata struct {
+	VideoCodec      *string
+	AudioCodec      *string
+	Container       *string
+	Bitrate         *int64
+	VideoBitrate    *int64
+	FrameRate       *float64
+	PixelFormat     *string
+	AudioStreams    []AudioStream
+	SubtitleStreams []SubtitleStream
+}
+
+func (p ProbeDetails) Metadata() Metadata {
+	m := Metadata{
+		AudioStreams:    []AudioStream{},
+		SubtitleStreams: []SubtitleStream{},
+	}
+
+	if p.Format != nil {
+		m.Container = nonEmpty(p.Format.FormatName)
+		m.Bitrate = parseBitrate(p.Format.BitRate)
+	}
+
+	videoFound := false
+	for _, s := range p.Streams {
+		switch s.CodecType {
+		case codecTypeVideo:
+			if videoFound || s.Disposition.AttachedPic == 1 { // cover art is reported as a video stream
+				continue
+			}
+			videoFound = true
+
+			m.VideoCodec = nonEmpty(s.CodecName)
+			m.VideoBitrate = parseBitrate(s.BitRate)
+			m.PixelFormat = nonEmpty(s.PixFmt)
+			m.FrameRate = parseFrameRate(s.AvgFrameRate)
+			if m.FrameRate == nil {
+				m.FrameRate = parseFrameRate(s.RFrameRate)
+			}
+		case codecTypeAudio:
+			sampleRate, _ := strconv.Atoi(s.SampleRate)
+			audio := AudioStream{
+				Index:         s.Index,
+				Codec:         s.CodecName,
+				Language:      s.Tags.Language,
+				Title:         s.Tags.Title,
+				Channels:      s.Channels,
+				ChannelLayout: s.ChannelLayout,
+				SampleRate:    sampleRate,
+				Default:       s.Disposition.Default == 1,
+			}
+			if bitrate := parseBitrate(s.BitRate); bitrate != nil {
+				audio.Bitrate = *bitrate
+			}
+
+			m.AudioStreams = append(m.AudioStreams, audio)
+		case codecTypeSubtitle:
+			m.SubtitleStreams = append(m.SubtitleStreams, SubtitleStream{
+				Index:    s.Index,
+				Codec:    s.CodecName,
+				Language: s.Tags.Language,
+				Title:    s.Tags.Title,
+				Default:  s.Disposition.Default == 1,
+				Forced:   s.Disposition.Forced == 1,
+			})
+		}
+	}
+
+	for _, a := range m.AudioStreams {
+		if a.Default {
+			m.AudioCodec = nonEmpty(a.Codec)
+			break
+		}
+	}
+	if m.AudioCodec == nil && len(m.AudioStreams) > 0 {
+		m.AudioCodec = nonEmpty(m.AudioStreams[0].Codec)
+	}
+
+	return m
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func parseBitrate(s string) *int64 {
+	bitrate, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &bitrate
+}
+
+// parseFrameRate parses ffprobe's rational frame rates like 30000/1001
+func parseFrameRate(s string) *float64 {
+	num, den, found := strings.Cut(s, "/")
+
+	numerator, err := strconv.ParseFloat(num, 64)
+	if err != nil || numerator == 0 {
+		return nil
+	}
+
+	if !found {
+		return &numerator
+	}
+
+	denominator, err := strconv.ParseFloat(den, 64)
+	if err != nil || denominator == 0 {
+		return nil
+	}
+
+	rate := numerator / denominator
+	return &rate
+}
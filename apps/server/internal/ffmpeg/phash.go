@@ -0,0 +1,87 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/bits"
+
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	ffmpeg_go "github.com/u2takey/ffmpeg-go"
+)
+
+const (
+	phashSamples = 10
+	phashWidth   = 9
+	phashHeight  = 8
+)
+
+const ErrSamplingFrame string = "error sampling frame from video (%v) at (%v)"
+
+// PerceptualHash calculates a 64 bit difference hash of a video.
+// Frames are sampled across the runtime of the video, shrunk to 9x8 grey scale images and averaged
+// so that re-encoded or resized copies of a video end up with hashes that only differ by a few bits.
+func PerceptualHash(ctx context.Context, vid string, runtime float64) (uint64, error) {
+	if runtime <= 0 {
+		return 0, fmt.Errorf("runtime has to be positive to sample frames: %v", runtime)
+	}
+
+	pixels := make([]float64, phashWidth*phashHeight)
+	for i := 1; i <= phashSamples; i++ {
+		timestamp := runtime * float64(i) / float64(phashSamples+1)
+
+		frame, err := sampleFrame(ctx, vid, timestamp)
+		if err != nil {
+			return 0, err
+		}
+
+		for p, v := range frame {
+			pixels[p] += float64(v)
+		}
+	}
+
+	return differenceHash(pixels), nil
+}
+
+func sampleFrame(ctx context.Context, vid string, timestamp float64) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+
+	input := ffmpeg_go.Input(vid, ffmpeg_go.KwArgs{"ss": timestamp})
+	err := ffmpeg_go.OutputContext(ctx, []*ffmpeg_go.Stream{input}, "pipe:", ffmpeg_go.KwArgs{
+		"vframes": 1,
+		"s":       fmt.Sprintf("%vx%v", phashWidth, phashHeight),
+		"pix_fmt": "gray",
+		"f":       "rawvideo",
+	}).
+		WithOutput(buf).
+		Run()
+	if err != nil {
+		return nil, errs.BuildError(err, ErrSamplingFrame, vid, timestamp)
+	}
+
+	if buf.Len() != phashWidth*phashHeight {
+		return nil, fmt.Errorf("sampled frame from %v at %v had %v pixels instead of %v", vid, timestamp, buf.Len(), phashWidth*phashHeight)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// differenceHash sets a bit for every pixel that is brighter than its right neighbour
+func differenceHash(pixels []float64) uint64 {
+	var hash uint64
+	for y := 0; y < phashHeight; y++ {
+		for x := 0; x < phashWidth-1; x++ {
+			hash <<= 1
+			if pixels[y*phashWidth+x] > pixels[y*phashWidth+x+1] {
+				hash |= 1
+			}
+		}
+	}
+
+	return hash
+}
+
+// HammingDistance is the number of bits that differ between two perceptual hashes
+func HammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
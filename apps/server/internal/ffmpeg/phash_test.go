@@ -0,0 +1,32 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DifferenceHash_SetsBitWhenBrighterThanRightNeighbour(t *testing.T) {
+	pixels := make([]float64, phashWidth*phashHeight)
+	// only the first pixel of the last row is brighter than its neighbour
+	pixels[(phashHeight-1)*phashWidth] = 255
+
+	assert.Equal(t, uint64(1)<<7, differenceHash(pixels))
+}
+
+func Test_DifferenceHash_IgnoresBrightness(t *testing.T) {
+	pixels := make([]float64, phashWidth*phashHeight)
+	brighter := make([]float64, phashWidth*phashHeight)
+	for i := range pixels {
+		pixels[i] = float64(i % 5)
+		brighter[i] = pixels[i]*2 + 40
+	}
+
+	assert.Equal(t, differenceHash(pixels), differenceHash(brighter))
+}
+
+func Test_HammingDistance(t *testing.T) {
+	assert.Equal(t, 0, HammingDistance(0xff, 0xff))
+	assert.Equal(t, 2, HammingDistance(0b1010, 0b0110))
+	assert.Equal(t, 64, HammingDistance(0, ^uint64(0)))
+}
@@ -22,13 +22,13 @@ type Probe struct {
 	Streams []Stream `json:"streams"`
 }
 
+// UnmarshalProbeData reads the probe through its details so that cover art never ends up in the streams
 func UnmarshalProbeData(probeData string) (*Probe, error) {
-	var data *Probe
-	err := json.Unmarshal([]byte(probeData), &data)
-	if err != nil {
+	details, err := UnmarshalProbeDetailsData(probeData)
+	if err != nil || details == nil {
 		return nil, err
 	}
-	return data, nil
+	return details.Probe(), nil
 }
 
 func UnmarshalledProbe(path string) (*Probe, error) {
@@ -53,3 +53,83 @@ func GetDimensions(streams []Stream) (*Dimension, error) {
 
 	return nil, errors.New("could not extract the height and width from the probe data streams")
 }
+
+type StreamTags struct {
+	Language string `json:"language"`
+	Title    string `json:"title"`
+}
+
+type StreamDisposition struct {
+	Default     int `json:"default"`
+	Forced      int `json:"forced"`
+	AttachedPic int `json:"attached_pic"`
+}
+
+type StreamDetails struct {
+	Stream
+	Index         int               `json:"index"`
+	CodecName     string            `json:"codec_name"`
+	BitRate       string            `json:"bit_rate"`
+	AvgFrameRate  string            `json:"avg_frame_rate"`
+	RFrameRate    string            `json:"r_frame_rate"`
+	PixFmt        string            `json:"pix_fmt"`
+	Channels      int               `json:"channels"`
+	ChannelLayout string            `json:"channel_layout"`
+	SampleRate    string            `json:"sample_rate"`
+	Tags          StreamTags        `json:"tags"`
+	Disposition   StreamDisposition `json:"disposition"`
+}
+
+type FormatDetails struct {
+	Format
+	FormatName string `json:"format_name"`
+	BitRate    string `json:"bit_rate"`
+}
+
+// ProbeDetails holds everything we read from ffprobe where Probe only holds what is needed to create media
+type ProbeDetails struct {
+	Format  *FormatDetails  `json:"format"`
+	Streams []StreamDetails `json:"streams"`
+}
+
+// Probe leaves out cover art as it is reported as a video stream with the dimensions of the picture
+func (p ProbeDetails) Probe() *Probe {
+	probe := &Probe{
+		Streams: []Stream{},
+	}
+
+	if p.Format != nil {
+		probe.Format = &p.Format.Format
+	}
+
+	for _, s := range p.Streams {
+		if s.Disposition.AttachedPic == 1 {
+			continue
+		}
+		probe.Streams = append(probe.Streams, s.Stream)
+	}
+
+	return probe
+}
+
+func UnmarshalProbeDetailsData(probeData string) (*ProbeDetails, error) {
+	var data *ProbeDetails
+	err := json.Unmarshal([]byte(probeData), &data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func UnmarshalledProbeDetails(path string) (*ProbeDetails, error) {
+	probeData, err := ffmpegGo.Probe(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := UnmarshalProbeDetailsData(probeData)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -147,3 +147,110 @@ func Test_UnmarshallProbeData_WithValidJson_ShouldParseJson(t *testing.T) {
 		t.Errorf("Expected data differed from actual data")
 	}
 }
+
+func Test_Metadata_WithDetailedProbeData_ShouldExtractCodecsAndStreams(t *testing.T) {
+	jsonData := `{
+		"format": {
+			"duration": "66.6",
+			"format_name": "matroska,webm",
+			"bit_rate": "4000000"
+		},
+		"streams": [
+			{
+				"index": 0,
+				"codec_type": "video",
+				"codec_name": "mjpeg",
+				"disposition": { "attached_pic": 1 }
+			},
+			{
+				"index": 1,
+				"codec_type": "video",
+				"codec_name": "hevc",
+				"height": 1080,
+				"width": 1920,
+				"pix_fmt": "yuv420p10le",
+				"avg_frame_rate": "24000/1001",
+				"bit_rate": "3500000"
+			},
+			{
+				"index": 2,
+				"codec_type": "audio",
+				"codec_name": "ac3",
+				"channels": 6,
+				"channel_layout": "5.1(side)",
+				"sample_rate": "48000",
+				"bit_rate": "384000",
+				"tags": { "language": "jpn" }
+			},
+			{
+				"index": 3,
+				"codec_type": "audio",
+				"codec_name": "aac",
+				"channels": 2,
+				"tags": { "language": "eng", "title": "Commentary" },
+				"disposition": { "default": 1 }
+			},
+			{
+				"index": 4,
+				"codec_type": "subtitle",
+				"codec_name": "subrip",
+				"tags": { "language": "eng" },
+				"disposition": { "forced": 1 }
+			}
+		]
+	}`
+
+	details, err := UnmarshalProbeDetailsData(jsonData)
+	if err != nil {
+		t.Errorf("Error was thrown %v", err)
+		return
+	}
+
+	actual := details.Metadata()
+
+	if actual.Container == nil || *actual.Container != "matroska,webm" {
+		t.Errorf("Expected container to be matroska,webm but was %v", actual.Container)
+	}
+	if actual.Bitrate == nil || *actual.Bitrate != 4000000 {
+		t.Errorf("Expected bitrate to be 4000000 but was %v", actual.Bitrate)
+	}
+	if actual.VideoCodec == nil || *actual.VideoCodec != "hevc" {
+		t.Errorf("Expected video codec to skip cover art and be hevc but was %v", actual.VideoCodec)
+	}
+	if actual.VideoBitrate == nil || *actual.VideoBitrate != 3500000 {
+		t.Errorf("Expected video bitrate to be 3500000 but was %v", actual.VideoBitrate)
+	}
+	if actual.PixelFormat == nil || *actual.PixelFormat != "yuv420p10le" {
+		t.Errorf("Expected pixel format to be yuv420p10le but was %v", actual.PixelFormat)
+	}
+	if actual.FrameRate == nil || *actual.FrameRate < 23.97 || *actual.FrameRate > 23.98 {
+		t.Errorf("Expected frame rate to be 23.976 but was %v", actual.FrameRate)
+	}
+	if actual.AudioCodec == nil || *actual.AudioCodec != "aac" {
+		t.Errorf("Expected audio codec to be that of the default audio stream but was %v", actual.AudioCodec)
+	}
+
+	expectedAudio := []AudioStream{
+		{Index: 2, Codec: "ac3", Language: "jpn", Channels: 6, ChannelLayout: "5.1(side)", SampleRate: 48000, Bitrate: 384000},
+		{Index: 3, Codec: "aac", Language: "eng", Title: "Commentary", Channels: 2, Default: true},
+	}
+	if !reflect.DeepEqual(actual.AudioStreams, expectedAudio) {
+		t.Errorf("Actual audio streams %v do not match expected %v", actual.AudioStreams, expectedAudio)
+	}
+
+	expectedSubtitles := []SubtitleStream{
+		{Index: 4, Codec: "subrip", Language: "eng", Forced: true},
+	}
+	if !reflect.DeepEqual(actual.SubtitleStreams, expectedSubtitles) {
+		t.Errorf("Actual subtitle streams %v do not match expected %v", actual.SubtitleStreams, expectedSubtitles)
+	}
+
+	probe := details.Probe()
+	if probe.Format.Duration != "66.6" {
+		t.Errorf("Expected duration to be carried over to the probe but was %v", probe.Format.Duration)
+	}
+	dimension, err := GetDimensions(probe.Streams)
+	if err != nil || *dimension.Height != 1080 {
+		t.Errorf("Expected dimensions to skip cover art and be carried over to the probe: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package ffmpeg
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// ProgressFunc is called with the amount of seconds of the output that has been processed
+type ProgressFunc func(seconds float64)
+
+// progressWriter parses the key=value lines that ffmpeg writes when it is run with -progress
+type progressWriter struct {
+	buf        []byte
+	onProgress ProgressFunc
+}
+
+func newProgressWriter(onProgress ProgressFunc) *progressWriter {
+	return &progressWriter{onProgress: onProgress}
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.parseLine(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+func (w *progressWriter) parseLine(line string) {
+	key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+	if !ok {
+		return
+	}
+
+	// out_time_ms is reported in microseconds as well and is kept for older versions of ffmpeg
+	if key != "out_time_us" && key != "out_time_ms" {
+		return
+	}
+
+	microseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || microseconds < 0 {
+		return
+	}
+
+	w.onProgress(float64(microseconds) / 1_000_000)
+}
@@ -0,0 +1,20 @@
+package ffmpeg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProgressWriter_ReportsOutTimeAcrossWrites(t *testing.T) {
+	reported := []float64{}
+	w := newProgressWriter(func(seconds float64) {
+		reported = append(reported, seconds)
+	})
+
+	w.Write([]byte("frame=120\nfps=30.00\nout_time_us=4500"))
+	w.Write([]byte("000\nout_time=00:00:04.500000\nprogress=continue\n"))
+	w.Write([]byte("out_time_us=N/A\nout_time_ms=6000000\nprogress=end\n"))
+
+	assert.Equal(t, []float64{4.5, 6}, reported)
+}
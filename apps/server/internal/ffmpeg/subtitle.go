@@ -0,0 +1,49 @@
+package ffmpeg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	ffmpeg_go "github.com/u2takey/ffmpeg-go"
+)
+
+// Image based subtitles (pgs, dvd, dvb) can not be converted to webvtt without ocr
+var textSubtitleCodecs = []string{"subrip", "srt", "ass", "ssa", "webvtt", "mov_text", "text"}
+
+func IsTextSubtitle(codec string) bool {
+	return slices.Contains(textSubtitleCodecs, codec)
+}
+
+type SubtitleDto struct {
+	InputFilePath  string
+	OutputFilePath string
+	// StreamIndex is the index of the embedded stream to extract, nil when the input is a subtitle file
+	StreamIndex *int
+}
+
+// ConvertSubtitle writes the subtitle of the input to the output as webvtt
+func ConvertSubtitle(ctx context.Context, s SubtitleDto) error {
+	outputArgs := ffmpeg_go.KwArgs{
+		"c:s": "webvtt",
+		"f":   "webvtt",
+	}
+
+	if s.StreamIndex != nil {
+		outputArgs["map"] = fmt.Sprintf("0:%v", *s.StreamIndex)
+	}
+
+	stream := ffmpeg_go.Input(s.InputFilePath).
+		Output(s.OutputFilePath, outputArgs).
+		OverWriteOutput()
+	stream.Context = ctx
+
+	if err := stream.Run(); err != nil {
+		_ = os.Remove(s.OutputFilePath)
+		return errs.BuildError(err, "error converting subtitle of %v to %v", s.InputFilePath, s.OutputFilePath)
+	}
+
+	return nil
+}
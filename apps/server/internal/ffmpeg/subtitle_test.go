@@ -0,0 +1,19 @@
+package ffmpeg
+
+import "testing"
+
+func Test_IsTextSubtitle_WithTextCodec_ShouldBeTrue(t *testing.T) {
+	for _, codec := range []string{"subrip", "ass", "webvtt", "mov_text"} {
+		if !IsTextSubtitle(codec) {
+			t.Errorf("Expected %v to be a text subtitle", codec)
+		}
+	}
+}
+
+func Test_IsTextSubtitle_WithImageCodec_ShouldBeFalse(t *testing.T) {
+	for _, codec := range []string{"hdmv_pgs_subtitle", "dvd_subtitle", "dvb_subtitle"} {
+		if IsTextSubtitle(codec) {
+			t.Errorf("Expected %v not to be a text subtitle", codec)
+		}
+	}
+}
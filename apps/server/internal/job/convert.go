@@ -1,6 +1,7 @@
 package job
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -18,10 +19,11 @@ import (
 )
 
 const (
-	CONVERT_FOLDER_NAME string = "conversions"
+	CONVERT_FOLDER_NAME string  = "conversions"
+	convertingProgress  float64 = 95
 )
 
-func (jr *jobRunner) convert(job *model.Job) error {
+func (jr *jobRunner) convert(ctx context.Context, job *model.Job) error {
 	var jobData dto.ConvertData
 	if err := json.Unmarshal([]byte(*job.Data), &jobData); err != nil {
 		return errs.BuildError(err, "error parssing job data for convert: %v", job.Data)
@@ -51,11 +53,19 @@ func (jr *jobRunner) convert(job *model.Job) error {
 	convertData := jobData.ToFfmpegDto()
 	convertData.InputFilePath = mediaModel.Path
 	convertData.OutputFilePath = tempFilePath
+	if mediaModel.Video != nil && mediaModel.Video.Runtime > 0 {
+		convertData.OnProgress = func(seconds float64) {
+			jr.reportProgress(job, scaledProgress(0, convertingProgress, seconds, mediaModel.Video.Runtime), "converting")
+		}
+	}
 
-	if err = ffmpeg.Convert(*convertData); err != nil {
+	jr.reportProgress(job, 0, "converting")
+	if err = ffmpeg.Convert(ctx, *convertData); err != nil {
 		return errs.BuildError(err, "conversion failed")
 	}
 
+	jr.reportProgress(job, convertingProgress, "copying converted media")
+
 	createdMedia, createdVideo, err := jr.addStubMedia(*mediaModel, jobData.Path, tempFilePath)
 	if err != nil {
 		return errs.BuildError(err, "error creating media")
@@ -114,15 +124,85 @@ func createNewMediaJobs(jobId *uuid.UUID, newMedia model.Media, newVideo model.V
 		jobs = append(jobs, *checksumJob)
 	}
 
-	relationType := model.MediaRelationTypeEnum_Thumbnail
+	scaledDimension := scaledThumbnailDimension(int(newVideo.Height), int(newVideo.Width))
+
+	thumbnailJob := createMediaThumbnailJob(jobId, newMedia, *scaledDimension, assetPath)
+	if thumbnailJob != nil {
+		jobs = append(jobs, *thumbnailJob)
+	}
+
+	phashJob, err := CreateRefreshMetadataJob(newMedia, jobId, &dto.RefreshFields{PerceptualHash: true})
+	if err != nil {
+		slog.Warn("could not create perceptual hash job", "jobId", jobId.String())
+	}
+	if phashJob != nil {
+		jobs = append(jobs, *phashJob)
+	}
 
-	height := int(newVideo.Height)
-	width := int(newVideo.Width)
+	chaptersJob, err := CreateGenerateChaptersJob(newMedia.ID, jobId,
+		nil, *scaledDimension.Height, *scaledDimension.Width, maxDimension, false)
+	if err != nil {
+		slog.Warn("could not create generate chapters job", "jobId", jobId.String())
+	}
+	if chaptersJob != nil {
+		jobs = append(jobs, *chaptersJob)
+	}
+
+	tracks, err := subtitleTracks(newMedia, newVideo)
+	if err != nil {
+		slog.Warn("could not determine subtitle tracks", "jobId", jobId.String(), "error", err.Error())
+	}
+	if len(tracks) > 0 {
+		subtitlesJob, err := CreateExtractSubtitlesJob(newMedia.ID, jobId)
+		if err != nil {
+			slog.Warn("could not create extract subtitles job", "jobId", jobId.String())
+		}
+		if subtitlesJob != nil {
+			jobs = append(jobs, *subtitlesJob)
+		}
+	}
+
+	return jobs
+}
+
+func createNewImageMediaJobs(jobId *uuid.UUID, newMedia model.Media, newImage model.Image, assetPath string) []model.Job {
+	jobs := []model.Job{}
+
+	checksumJob, err := CreateGenerateChecksumJob(newMedia.ID, jobId)
+	if err != nil {
+		slog.Warn("could not create checksum job", "jobId", jobId.String())
+	}
+	if checksumJob != nil {
+		jobs = append(jobs, *checksumJob)
+	}
+
+	// Images that could not be probed have no dimensions to scale a thumbnail to
+	if newImage.Width <= 0 || newImage.Height <= 0 {
+		slog.Warn("skipping thumbnail job for image without dimensions", "mediaId", newMedia.ID.String())
+		return jobs
+	}
+
+	scaledDimension := scaledThumbnailDimension(int(newImage.Height), int(newImage.Width))
+
+	thumbnailJob := createMediaThumbnailJob(jobId, newMedia, *scaledDimension, assetPath)
+	if thumbnailJob != nil {
+		jobs = append(jobs, *thumbnailJob)
+	}
+
+	return jobs
+}
+
+func scaledThumbnailDimension(height, width int) *ffmpeg.Dimension {
 	dimension := ffmpeg.Dimension{
 		Height: &height,
 		Width:  &width,
 	}
-	scaledDimension := ffmpeg.ScaleByMaxDimension(maxDimension, dimension)
+
+	return ffmpeg.ScaleByMaxDimension(maxDimension, dimension)
+}
+
+func createMediaThumbnailJob(jobId *uuid.UUID, newMedia model.Media, scaledDimension ffmpeg.Dimension, assetPath string) *model.Job {
+	relationType := model.MediaRelationTypeEnum_Thumbnail
 
 	thumbnailPath := filepath.Join(
 		assetPath,
@@ -140,20 +220,8 @@ func createNewMediaJobs(jobId *uuid.UUID, newMedia model.Media, newVideo model.V
 	if err != nil {
 		slog.Warn("could not create generate thumbnail job", "jobId", jobId.String())
 	}
-	if thumbnailJob != nil {
-		jobs = append(jobs, *thumbnailJob)
-	}
 
-	chaptersJob, err := CreateGenerateChaptersJob(newMedia.ID, jobId,
-		nil, *scaledDimension.Height, *scaledDimension.Width, maxDimension, false)
-	if err != nil {
-		slog.Warn("could not create generate chapters job", "jobId", jobId.String())
-	}
-	if chaptersJob != nil {
-		jobs = append(jobs, *chaptersJob)
-	}
-
-	return jobs
+	return thumbnailJob
 }
 
 func (jr *jobRunner) addStubMedia(existing models.Media, filePath, tempPath string) (*model.Media, *model.Video, error) {
@@ -195,10 +263,11 @@ func (jr *jobRunner) addStubMedia(existing models.Media, filePath, tempPath stri
 }
 
 func (jr *jobRunner) addVideo(path string, mediaId uuid.UUID) (*model.Video, error) {
-	ffmpegData, err := ffmpeg.UnmarshalledProbe(path)
+	details, err := ffmpeg.UnmarshalledProbeDetails(path)
 	if err != nil {
 		return nil, errs.BuildError(err, "could not get probe for %v", path)
 	}
+	ffmpegData := details.Probe()
 
 	dimension, err := ffmpeg.GetDimensions(ffmpegData.Streams)
 	if err != nil {
@@ -222,6 +291,10 @@ func (jr *jobRunner) addVideo(path string, mediaId uuid.UUID) (*model.Video, err
 		Runtime: runtime,
 	}
 
+	if err := applyVideoMetadata(&newVideoModel, details.Metadata()); err != nil {
+		jr.logger.Warningf("could not apply probe metadata for %v: %v", path, err.Error())
+	}
+
 	createdVideos, err := jr.repo.Video().Insert([]model.Video{newVideoModel})
 	if err != nil {
 		return nil, errs.BuildError(err, "could not create video")
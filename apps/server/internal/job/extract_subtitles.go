@@ -0,0 +1,254 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
+	"github.com/slugger7/exorcist/apps/server/internal/media"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+)
+
+// subtitleTrack is a subtitle of a video that can be converted to a webvtt asset
+type subtitleTrack struct {
+	input       string
+	streamIndex *int
+	name        string
+	metadata    dto.SubtitleMetadataDTO
+}
+
+func CreateExtractSubtitlesJob(mediaId uuid.UUID, jobId *uuid.UUID) (*model.Job, error) {
+	d := dto.ExtractSubtitlesData{
+		MediaId: mediaId,
+	}
+
+	js, err := json.Marshal(d)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not marshal extract subtitles data for: %v", mediaId)
+	}
+
+	data := string(js)
+	job := &model.Job{
+		JobType:  model.JobTypeEnum_ExtractSubtitles,
+		Status:   model.JobStatusEnum_NotStarted,
+		Data:     &data,
+		Parent:   jobId,
+		Priority: dto.JobPriority_MediumLow,
+	}
+
+	return job, nil
+}
+
+// subtitleTracks finds the embedded text subtitles of the video and the sidecar files next to it.
+// Image based subtitles are skipped as they can not be converted to webvtt
+func subtitleTracks(m model.Media, v model.Video) ([]subtitleTrack, error) {
+	tracks := []subtitleTrack{}
+
+	if v.SubtitleStreams != nil {
+		var streams []ffmpeg.SubtitleStream
+		if err := json.Unmarshal([]byte(*v.SubtitleStreams), &streams); err != nil {
+			return nil, errs.BuildError(err, "could not parse subtitle streams of %v", m.ID.String())
+		}
+
+		for _, s := range streams {
+			if !ffmpeg.IsTextSubtitle(s.Codec) {
+				continue
+			}
+
+			index := s.Index
+			tracks = append(tracks, subtitleTrack{
+				input:       m.Path,
+				streamIndex: &index,
+				name:        strconv.Itoa(index),
+				metadata: dto.SubtitleMetadataDTO{
+					Language:    s.Language,
+					Title:       s.Title,
+					Default:     s.Default,
+					Forced:      s.Forced,
+					StreamIndex: &index,
+				},
+			})
+		}
+	}
+
+	sidecars, err := media.FindSidecars(m.Path)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not find sidecar subtitles of %v", m.ID.String())
+	}
+
+	for _, s := range sidecars {
+		tracks = append(tracks, subtitleTrack{
+			input: s.Path,
+			name:  s.FileName,
+			metadata: dto.SubtitleMetadataDTO{
+				Language: s.Language,
+				Default:  s.Default,
+				Forced:   s.Forced,
+				Sidecar:  s.Path,
+			},
+		})
+	}
+
+	return tracks, nil
+}
+
+// hasUnconvertedSidecars checks if any of the sidecars is not related to the video as a subtitle yet
+func hasUnconvertedSidecars(sidecars []media.Sidecar, relations []models.MediaRelation) bool {
+	converted := []string{}
+	for _, r := range relations {
+		if r.RelationType != model.MediaRelationTypeEnum_Subtitle || r.Metadata == nil {
+			continue
+		}
+
+		var metadata dto.SubtitleMetadataDTO
+		if err := json.Unmarshal([]byte(*r.Metadata), &metadata); err == nil && metadata.Sidecar != "" {
+			converted = append(converted, metadata.Sidecar)
+		}
+	}
+
+	return slices.ContainsFunc(sidecars, func(s media.Sidecar) bool {
+		return !slices.Contains(converted, s.Path)
+	})
+}
+
+func (jr *jobRunner) extractSubtitles(ctx context.Context, job *model.Job) error {
+	var jobData dto.ExtractSubtitlesData
+	if err := json.Unmarshal([]byte(*job.Data), &jobData); err != nil {
+		return errs.BuildError(err, "error parsing job data for extract subtitles: %v", job.Data)
+	}
+
+	m, err := jr.repo.Media().GetById(jobData.MediaId)
+	if err != nil {
+		return errs.BuildError(err, "could not find media by id for extract subtitles job %v", jobData.MediaId.String())
+	}
+
+	if m == nil {
+		return fmt.Errorf("media was nil for extract subtitles job: %v", jobData.MediaId.String())
+	}
+
+	if m.Video == nil {
+		return fmt.Errorf("media was not of type video: %v", jobData.MediaId.String())
+	}
+
+	relations := []models.MediaRelation{}
+	for _, relation := range m.MediaRelations {
+		if relation.RelationType == model.MediaRelationTypeEnum_Subtitle {
+			relations = append(relations, relation)
+		}
+	}
+
+	if len(relations) > 0 {
+		if err := jr.removeRelations(m.Media.ID, relations); err != nil {
+			jr.logger.Warningf("some issues removing previous subtitles: %v", err.Error())
+		}
+	}
+
+	tracks, err := subtitleTracks(m.Media, *m.Video)
+	if err != nil {
+		return errs.BuildError(err, "could not determine subtitle tracks")
+	}
+
+	relationType := model.MediaRelationTypeEnum_Subtitle
+
+	var accErr error
+	for i, t := range tracks {
+		if ctx.Err() != nil {
+			return errs.BuildError(ctx.Err(), "extracting subtitles stopped")
+		}
+
+		jr.reportProgress(job, scaledProgress(0, 100, float64(i), float64(len(tracks))), "extracting subtitles")
+
+		assetPath := filepath.Join(
+			jr.env.Assets,
+			m.Media.ID.String(),
+			fmt.Sprintf(
+				"%v.%v.%v.vtt",
+				filepath.Base(m.Media.Path),
+				relationType.String(),
+				t.name,
+			))
+
+		if err := createAssetDirectory(assetPath); err != nil {
+			accErr = errors.Join(accErr, errs.BuildError(err, "could not create asset directory for %v", assetPath))
+			continue
+		}
+
+		if err := ffmpeg.ConvertSubtitle(ctx, ffmpeg.SubtitleDto{
+			InputFilePath:  t.input,
+			OutputFilePath: assetPath,
+			StreamIndex:    t.streamIndex,
+		}); err != nil {
+			accErr = errors.Join(accErr, err)
+			continue
+		}
+
+		if err := jr.createSubtitleAsset(m.Media, assetPath, t.metadata); err != nil {
+			accErr = errors.Join(accErr, err)
+		}
+	}
+
+	updated, err := jr.repo.Media().GetById(m.Media.ID)
+	if err != nil {
+		accErr = errors.Join(accErr, err)
+	} else if updated != nil {
+		mediaDto := new(dto.MediaDTO).FromModel(*updated)
+		jr.ws.MediaUpdate(dto.MediaDTO{
+			ID:        mediaDto.ID,
+			Relations: mediaDto.Relations,
+		})
+	}
+
+	if accErr != nil {
+		return errs.BuildError(accErr, "could not extract all subtitles of %v", m.Media.ID.String())
+	}
+
+	return nil
+}
+
+func (jr *jobRunner) createSubtitleAsset(source model.Media, path string, metadata dto.SubtitleMetadataDTO) error {
+	fileSize, err := media.GetFileSize(path)
+	if err != nil {
+		return errs.BuildError(err, "could not get file size for: %v", path)
+	}
+
+	newModels, err := jr.repo.Media().Create([]model.Media{{
+		LibraryPathID: source.LibraryPathID,
+		Path:          path,
+		Title:         fmt.Sprintf("%v-%v", source.ID, model.MediaRelationTypeEnum_Subtitle.String()),
+		MediaType:     model.MediaTypeEnum_Asset,
+		Size:          fileSize,
+	}})
+	if err != nil {
+		return errs.BuildError(err, "could not create subtitle media")
+	}
+	if len(newModels) != 1 {
+		return fmt.Errorf("length of models was not 1 but %v", len(newModels))
+	}
+
+	bytes, err := json.Marshal(metadata)
+	if err != nil {
+		return errs.BuildError(err, "could not marshall metadata")
+	}
+
+	data := string(bytes)
+
+	if _, err := jr.repo.Media().Relate([]model.MediaRelation{{
+		MediaID:      source.ID,
+		RelatedTo:    newModels[0].ID,
+		RelationType: model.MediaRelationTypeEnum_Subtitle,
+		Metadata:     &data,
+	}}); err != nil {
+		return errs.BuildError(err, "could not create media subtitle relation")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package job
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/slugger7/exorcist/apps/server/internal/media"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CreateExtractSubtitlesJob(t *testing.T) {
+	jobId, _ := uuid.NewRandom()
+	id, _ := uuid.NewRandom()
+
+	actual, err := CreateExtractSubtitlesJob(id, &jobId)
+	assert.Nil(t, err)
+
+	actualData := *actual.Data
+	actual.Data = nil
+
+	expectedData := fmt.Sprintf(`{"mediaId":"%v"}`, id)
+	expected := model.Job{
+		JobType:  model.JobTypeEnum_ExtractSubtitles,
+		Status:   model.JobStatusEnum_NotStarted,
+		Data:     nil,
+		Priority: dto.JobPriority_MediumLow,
+		Parent:   &jobId,
+	}
+
+	assert.Equal(t, expected, *actual)
+	assert.Equal(t, expectedData, actualData)
+}
+
+func Test_SubtitleTracks_SkipsImageSubtitlesAndFindsSidecars(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "movie.mkv")
+	sidecarPath := filepath.Join(dir, "movie.en.srt")
+	assert.Nil(t, os.WriteFile(videoPath, []byte{}, 0644))
+	assert.Nil(t, os.WriteFile(sidecarPath, []byte{}, 0644))
+
+	streams := `[{"index":2,"codec":"subrip","language":"fre","default":true,"forced":false},{"index":3,"codec":"hdmv_pgs_subtitle","default":false,"forced":false}]`
+
+	tracks, err := subtitleTracks(model.Media{Path: videoPath}, model.Video{SubtitleStreams: &streams})
+	assert.Nil(t, err)
+	assert.Len(t, tracks, 2)
+
+	assert.Equal(t, videoPath, tracks[0].input)
+	assert.Equal(t, 2, *tracks[0].streamIndex)
+	assert.Equal(t, "fre", tracks[0].metadata.Language)
+	assert.True(t, tracks[0].metadata.Default)
+
+	assert.Equal(t, sidecarPath, tracks[1].input)
+	assert.Nil(t, tracks[1].streamIndex)
+	assert.Equal(t, "en", tracks[1].metadata.Language)
+	assert.Equal(t, sidecarPath, tracks[1].metadata.Sidecar)
+}
+
+func Test_HasUnconvertedSidecars(t *testing.T) {
+	converted := `{"default":false,"forced":false,"sidecar":"/videos/movie.en.srt"}`
+	relations := []models.MediaRelation{{MediaRelation: model.MediaRelation{
+		RelationType: model.MediaRelationTypeEnum_Subtitle,
+		Metadata:     &converted,
+	}}}
+
+	known := []media.Sidecar{{File: media.File{Path: "/videos/movie.en.srt"}}}
+	assert.False(t, hasUnconvertedSidecars(known, relations))
+
+	added := append(known, media.Sidecar{File: media.File{Path: "/videos/movie.fr.srt"}})
+	assert.True(t, hasUnconvertedSidecars(added, relations))
+}
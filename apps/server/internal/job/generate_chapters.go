@@ -28,6 +28,8 @@ func CreateGenerateChaptersJob(mediaId uuid.UUID, jobId *uuid.UUID, interval *fl
 
 	if interval == nil {
 		d.Interval = 60
+	} else {
+		d.Interval = *interval
 	}
 
 	js, err := json.Marshal(d)
@@ -47,9 +49,9 @@ func CreateGenerateChaptersJob(mediaId uuid.UUID, jobId *uuid.UUID, interval *fl
 	return job, nil
 }
 
-func (jr *jobRunner) removeChapters(id uuid.UUID, chapters []models.MediaRelation) error {
+func (jr *jobRunner) removeRelations(id uuid.UUID, relations []models.MediaRelation) error {
 	var accErr error
-	for _, i := range chapters {
+	for _, i := range relations {
 		if err := jr.service.Media().Delete(i.RelatedTo, true); err != nil {
 			accErr = errors.Join(accErr, err)
 		}
@@ -101,7 +103,7 @@ func (jr *jobRunner) generateChapters(job *model.Job) error {
 
 	if len(relations) > 0 {
 		if jobData.Overwrite {
-			if err := jr.removeChapters(media.Media.ID, relations); err != nil {
+			if err := jr.removeRelations(media.Media.ID, relations); err != nil {
 				jr.logger.Warningf("some issues removing previous chapters: %v", err.Error())
 			}
 		} else {
@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+func (jr *jobRunner) generateLibraryChapters(ctx context.Context, job *model.Job) error {
+	var jobData dto.GenerateLibraryChaptersData
+	if err := json.Unmarshal([]byte(*job.Data), &jobData); err != nil {
+		return errs.BuildError(err, "error parsing job data for generate library chapters: %v", job.Data)
+	}
+
+	height, width := 0, 0
+	if jobData.Height != nil {
+		height = *jobData.Height
+	}
+	if jobData.Width != nil {
+		width = *jobData.Width
+	}
+
+	skip := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batchNr := 1
+		var pageRequest *dto.PageRequestDTO
+		if jobData.BatchSize != 0 {
+			batchNr = skip/jobData.BatchSize + 1
+			pageRequest = &dto.PageRequestDTO{
+				Skip:  skip,
+				Limit: jobData.BatchSize,
+			}
+		}
+
+		jr.logger.Infof("Batch: %v", batchNr)
+
+		videoPage, err := jr.repo.Video().GetByLibraryId(jobData.LibraryId, pageRequest)
+		if err != nil {
+			return errs.BuildError(err, "fetching batch of video entities from repo")
+		}
+
+		if len(videoPage.Data) == 0 {
+			break
+		}
+
+		jr.reportProgress(job, scaledProgress(0, 100, float64(skip), float64(videoPage.Total)), "creating generate chapters jobs")
+
+		var accErr error
+		chapterJobs := []model.Job{}
+		for _, o := range videoPage.Data {
+			j, err := CreateGenerateChaptersJob(o.MediaID, &job.ID, &jobData.Interval, height, width, jobData.MaxDimension, jobData.Overwrite)
+			if err != nil {
+				accErr = errors.Join(accErr, err)
+				continue
+			}
+			chapterJobs = append(chapterJobs, *j)
+		}
+
+		if accErr != nil {
+			jr.logger.Errorf("encountered errors while processing batch %v: %v", batchNr, accErr.Error())
+		}
+
+		jobs, err := jr.repo.Job().CreateAll(chapterJobs)
+		if err != nil {
+			return errs.BuildError(err, "creating generate chapters jobs for %v", jobData.LibraryId)
+		}
+
+		if len(jobs) != len(chapterJobs) {
+			return fmt.Errorf("jobs created (%v) and jobs saved to database (%v) differed in batch %v", len(chapterJobs), len(jobs), batchNr)
+		}
+
+		skip = skip + jobData.BatchSize
+
+		if jobData.BatchSize == 0 {
+			break
+		}
+	}
+
+	return nil
+}
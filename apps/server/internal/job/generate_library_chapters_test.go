@@ -0,0 +1,161 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func generateLibraryChaptersJob(libraryId uuid.UUID, batchSize int) *model.Job {
+	data := fmt.Sprintf(`{"libraryId":"%v","batchSize":%v,"interval":30,"height":null,"width":null,"maxDimension":0,"overwrite":false}`, libraryId, batchSize)
+	id, _ := uuid.NewRandom()
+	return &model.Job{ID: id, JobType: model.JobTypeEnum_GenerateLibraryChapters, Data: &data}
+}
+
+func videos(n int) []model.Video {
+	v := make([]model.Video, n)
+	for i := range v {
+		v[i].MediaID = uuid.New()
+	}
+	return v
+}
+
+func Test_GenerateLibraryChapters(t *testing.T) {
+	libraryId, _ := uuid.NewRandom()
+	repoErr := errors.New("repo error")
+	saveAll := func(jobs []model.Job) ([]model.Job, error) { return jobs, nil }
+
+	cases := []struct {
+		name         string
+		batchSize    int
+		pages        [][]model.Video
+		pageErr      error
+		created      func(jobs []model.Job) ([]model.Job, error)
+		pageRequests []*dto.PageRequestDTO
+		createdJobs  []int
+		expectErr    bool
+	}{
+		{
+			name:         "creates a generate chapters job for every video without batching",
+			pages:        [][]model.Video{videos(3)},
+			created:      saveAll,
+			pageRequests: []*dto.PageRequestDTO{nil},
+			createdJobs:  []int{3},
+		},
+		{
+			name:      "creates generate chapters jobs in batches",
+			batchSize: 2,
+			pages:     [][]model.Video{videos(2), videos(1), {}},
+			created:   saveAll,
+			pageRequests: []*dto.PageRequestDTO{
+				{Skip: 0, Limit: 2},
+				{Skip: 2, Limit: 2},
+				{Skip: 4, Limit: 2},
+			},
+			createdJobs: []int{2, 1},
+		},
+		{
+			name:         "does not create jobs for a library without videos",
+			pages:        [][]model.Video{{}},
+			pageRequests: []*dto.PageRequestDTO{nil},
+		},
+		{
+			name:         "fails when videos can not be fetched",
+			pages:        [][]model.Video{nil},
+			pageErr:      repoErr,
+			pageRequests: []*dto.PageRequestDTO{nil},
+			expectErr:    true,
+		},
+		{
+			name:         "fails when jobs can not be created",
+			pages:        [][]model.Video{videos(2)},
+			created:      func([]model.Job) ([]model.Job, error) { return nil, repoErr },
+			pageRequests: []*dto.PageRequestDTO{nil},
+			createdJobs:  []int{2},
+			expectErr:    true,
+		},
+		{
+			name:         "fails when not every job was saved",
+			pages:        [][]model.Video{videos(2)},
+			created:      func(jobs []model.Job) ([]model.Job, error) { return jobs[:1], nil },
+			pageRequests: []*dto.PageRequestDTO{nil},
+			createdJobs:  []int{2},
+			expectErr:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			jr, repos := testJobRunnerWithRepo(t)
+			job := generateLibraryChaptersJob(libraryId, c.batchSize)
+
+			repos.job.EXPECT().UpdateProgress(gomock.Any()).Return(nil).AnyTimes()
+
+			pageRequests := []*dto.PageRequestDTO{}
+			repos.video.EXPECT().
+				GetByLibraryId(libraryId, gomock.Any()).
+				DoAndReturn(func(_ uuid.UUID, pageRequest *dto.PageRequestDTO) (*dto.PageDTO[model.Video], error) {
+					page := c.pages[len(pageRequests)]
+					pageRequests = append(pageRequests, pageRequest)
+					if c.pageErr != nil {
+						return nil, c.pageErr
+					}
+					return &dto.PageDTO[model.Video]{Data: page, Total: 3}, nil
+				}).
+				Times(len(c.pageRequests))
+
+			createdJobs := []int{}
+			repos.job.EXPECT().
+				CreateAll(gomock.Any()).
+				DoAndReturn(func(jobs []model.Job) ([]model.Job, error) {
+					page := c.pages[len(createdJobs)]
+					createdJobs = append(createdJobs, len(jobs))
+					for i, j := range jobs {
+						assert.Equal(t, model.JobTypeEnum_GenerateChapters, j.JobType)
+						assert.Equal(t, &job.ID, j.Parent)
+						assert.Contains(t, *j.Data, fmt.Sprintf(`"mediaId":"%v","interval":30`, page[i].MediaID))
+					}
+					return c.created(jobs)
+				}).
+				Times(len(c.createdJobs))
+
+			err := jr.generateLibraryChapters(context.Background(), job)
+
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, c.pageRequests, pageRequests)
+			if len(c.createdJobs) != 0 {
+				assert.Equal(t, c.createdJobs, createdJobs)
+			}
+		})
+	}
+}
+
+func Test_GenerateLibraryChapters_WithCancelledContext_ShouldStop(t *testing.T) {
+	jr, _ := testJobRunnerWithRepo(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := jr.generateLibraryChapters(ctx, generateLibraryChaptersJob(uuid.New(), 2))
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func Test_GenerateLibraryChapters_WithInvalidData_ShouldReturnError(t *testing.T) {
+	jr, _ := testJobRunnerWithRepo(t)
+	data := "not json"
+
+	err := jr.generateLibraryChapters(context.Background(), &model.Job{Data: &data})
+
+	assert.NotNil(t, err)
+}
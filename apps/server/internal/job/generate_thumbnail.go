@@ -1,6 +1,7 @@
 package job
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -12,6 +13,7 @@ import (
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
 	"github.com/slugger7/exorcist/apps/server/internal/media"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
 )
 
 func CreateGenerateThumbnailJob(
@@ -61,7 +63,7 @@ func createAssetDirectory(path string) error {
 	return os.MkdirAll(dir, os.ModePerm)
 }
 
-func (jr *jobRunner) GenerateThumbnail(job *model.Job) error {
+func (jr *jobRunner) GenerateThumbnail(ctx context.Context, job *model.Job) error {
 	var jobData dto.GenerateThumbnailData
 	if err := json.Unmarshal([]byte(*job.Data), &jobData); err != nil {
 		return errs.BuildError(err, "error parsing job data: %v", job.Data)
@@ -71,9 +73,21 @@ func (jr *jobRunner) GenerateThumbnail(job *model.Job) error {
 		return fmt.Errorf("cant create an image at a blank path")
 	}
 
-	video, err := jr.repo.Video().GetByMediaId(jobData.MediaId)
+	mediaModel, err := jr.repo.Media().GetById(jobData.MediaId)
 	if err != nil {
-		return errs.BuildError(err, "error fetching video with media id: %v", jobData.MediaId)
+		return errs.BuildError(err, "error fetching media with id: %v", jobData.MediaId)
+	}
+	if mediaModel == nil {
+		return fmt.Errorf("no media found with id: %v", jobData.MediaId)
+	}
+
+	if mediaModel.Image != nil {
+		return jr.generateImageThumbnail(ctx, jobData, *mediaModel)
+	}
+
+	video := mediaModel.Video
+	if video == nil {
+		return fmt.Errorf("media %v is neither a video nor an image", jobData.MediaId)
 	}
 
 	if *jobData.Height == 0 {
@@ -91,19 +105,43 @@ func (jr *jobRunner) GenerateThumbnail(job *model.Job) error {
 		return errs.BuildError(err, "could not create path for asset")
 	}
 
-	if err := ffmpeg.ImageAt(video.Path, jobData.Timestamp, jobData.Path, *jobData.Width, *jobData.Height); err != nil {
+	if err := ffmpeg.ImageAt(ctx, mediaModel.Path, jobData.Timestamp, jobData.Path, *jobData.Width, *jobData.Height); err != nil {
 		return errs.BuildError(err, "could not create image at timestamp: %v, video: %v", jobData.Timestamp, video.Runtime)
 	}
 
+	return jr.createThumbnailAsset(mediaModel.Media, jobData)
+}
+
+func (jr *jobRunner) generateImageThumbnail(ctx context.Context, jobData dto.GenerateThumbnailData, source models.Media) error {
+	if *jobData.Height == 0 {
+		*jobData.Height = int(source.Image.Height)
+	}
+	if *jobData.Width == 0 {
+		*jobData.Width = int(source.Image.Width)
+	}
+
+	if err := createAssetDirectory(jobData.Path); err != nil {
+		return errs.BuildError(err, "could not create path for asset")
+	}
+
+	if err := ffmpeg.ScaleImage(ctx, source.Media.Path, jobData.Path, *jobData.Width, *jobData.Height); err != nil {
+		return errs.BuildError(err, "could not scale image: %v", source.Media.Path)
+	}
+
+	return jr.createThumbnailAsset(source.Media, jobData)
+}
+
+// createThumbnailAsset stores the generated thumbnail as an asset and relates it to the source media
+func (jr *jobRunner) createThumbnailAsset(source model.Media, jobData dto.GenerateThumbnailData) error {
 	fileSize, err := media.GetFileSize(jobData.Path)
 	if err != nil {
 		return errs.BuildError(err, "could not get file size for: %v", jobData.Path)
 	}
 
 	imageMedia := &model.Media{
-		LibraryPathID: video.LibraryPathID,
+		LibraryPathID: source.LibraryPathID,
 		Path:          jobData.Path,
-		Title:         fmt.Sprintf("%v-%v", video.MediaID, jobData.RelationType.String()),
+		Title:         fmt.Sprintf("%v-%v", source.ID, jobData.RelationType.String()),
 		MediaType:     model.MediaTypeEnum_Asset,
 		Size:          fileSize,
 	}
@@ -134,20 +172,20 @@ func (jr *jobRunner) GenerateThumbnail(job *model.Job) error {
 
 	metadata := string(bytes)
 
-	videoImage := &model.MediaRelation{
-		MediaID:      video.Media.ID,
+	mediaImage := &model.MediaRelation{
+		MediaID:      source.ID,
 		RelatedTo:    image.MediaID,
 		RelationType: *jobData.RelationType,
 		Metadata:     &metadata,
 	}
 
-	_, err = jr.repo.Media().Relate([]model.MediaRelation{*videoImage})
+	_, err = jr.repo.Media().Relate([]model.MediaRelation{*mediaImage})
 	if err != nil {
-		return errs.BuildError(err, "could not create video image relation")
+		return errs.BuildError(err, "could not create media image relation")
 	}
 
 	mediaOverviewUpdate := dto.MediaOverviewDTO{
-		Id: video.Media.ID,
+		Id: source.ID,
 	}
 	jr.ws.MediaOverviewUpdate(mediaOverviewUpdate)
 
@@ -7,9 +7,10 @@ import (
 	"slices"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
-	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
 	"github.com/slugger7/exorcist/apps/server/internal/repository"
@@ -26,8 +27,17 @@ type jobRunner struct {
 	shutdownCtx context.Context
 	wg          *sync.WaitGroup
 	ws          websockets.Websockets
+	running     map[model.JobTypeEnum]int
+	done        chan *model.Job
+	cancelCh    chan uuid.UUID
+	cancels     map[uuid.UUID]context.CancelFunc
 }
 
+const (
+	defaultConcurrency     int    = 1
+	waitingForChildrenStep string = "waiting for child jobs"
+)
+
 var jobRunnerInstance *jobRunner
 
 func New(
@@ -37,20 +47,25 @@ func New(
 	shutdownCtx context.Context,
 	wg *sync.WaitGroup,
 	ws websockets.Websockets,
-) chan bool {
+) (chan bool, chan uuid.UUID) {
 	ch := make(chan bool)
+	cancelCh := make(chan uuid.UUID)
 
 	if jobRunnerInstance == nil {
 		repo := repository.New(env, context.Background())
 		jobRunnerInstance = &jobRunner{
 			env:         env,
-			service:     service.New(repo, env, ch, shutdownCtx),
+			service:     service.New(repo, env, ch, cancelCh, shutdownCtx),
 			repo:        repo,
 			logger:      logger,
 			ch:          ch,
 			wg:          wg,
 			shutdownCtx: shutdownCtx,
 			ws:          ws,
+			running:     map[model.JobTypeEnum]int{},
+			done:        make(chan *model.Job),
+			cancelCh:    cancelCh,
+			cancels:     map[uuid.UUID]context.CancelFunc{},
 		}
 
 		logger.Debug("Job runner instance created")
@@ -58,7 +73,7 @@ func New(
 		go jobRunnerInstance.loop()
 	}
 
-	return ch
+	return ch, cancelCh
 }
 
 func (jr *jobRunner) loop() {
@@ -78,121 +93,299 @@ func (jr *jobRunner) loop() {
 			}
 
 			jr.logger.Info("Processing jobs")
-			if err := jr.processJobs(); err != nil {
-				jr.logger.Errorf("Error received while processing jobs. Stopping job runner: %v", err.Error())
-				return
+			jr.dispatchJobs()
+		case id := <-jr.cancelCh:
+			cancel, ok := jr.cancels[id]
+			if !ok {
+				jr.cancelWaitingParent(id)
+				continue
+			}
+
+			jr.logger.Infof("Cancelling job %v", id.String())
+			cancel()
+		case job := <-jr.done:
+			jr.running[job.JobType]--
+			if cancel, ok := jr.cancels[job.ID]; ok {
+				cancel()
+				delete(jr.cancels, job.ID)
 			}
+
+			// children may have finished while the job was still running
+			if job.Status == model.JobStatusEnum_InProgress {
+				jr.resolveParent(job.ID)
+			}
+			if job.Parent != nil {
+				jr.resolveParent(*job.Parent)
+			}
+
+			jr.dispatchJobs()
 		}
 	}
 }
 
-func (jr *jobRunner) disableJobChecker(job *model.Job) error {
-	if slices.Contains(jr.env.DisableJobs, job.JobType) {
-		return fmt.Errorf("job of type %v is disabled", job.JobType.String())
+func (jr *jobRunner) concurrency(jobType model.JobTypeEnum) int {
+	if c, ok := jr.env.JobConcurrency[jobType]; ok {
+		return c
 	}
 
-	return nil
+	return defaultConcurrency
 }
 
-func (jr *jobRunner) processJobs() error {
+// availableJobTypes returns the job types that have a free worker
+func (jr *jobRunner) availableJobTypes() []model.JobTypeEnum {
+	available := []model.JobTypeEnum{}
+	for _, t := range model.JobTypeEnumAllValues {
+		if jr.running[t] < jr.concurrency(t) {
+			available = append(available, t)
+		}
+	}
+
+	return available
+}
+
+// dispatchJobs claims jobs and starts them until there are no more free workers or no more jobs to run.
+// It is only called from the loop so that the running counts and cancel functions do not need to be guarded.
+func (jr *jobRunner) dispatchJobs() {
 	for {
 		select {
 		case <-jr.shutdownCtx.Done():
-			return fmt.Errorf("shutdown signal received. Stopping job loop")
+			return
 		default:
-			job, err := jr.repo.Job().GetNextJob()
+			available := jr.availableJobTypes()
+			if len(available) == 0 {
+				jr.logger.Debug("All workers are busy. Waiting for a job to finish")
+				return
+			}
+
+			job, err := jr.repo.Job().GetNextJob(available)
 			if err != nil {
-				return errs.BuildError(err, "Failed to fetch next job")
+				jr.logger.Errorf("Failed to fetch next job: %v", err.Error())
+				return
 			}
 			if job == nil {
 				jr.logger.Info("No jobs to run. Waiting for next signal")
-				return nil
+				return
 			}
 
-			if err := jr.disableJobChecker(job); err != nil {
-				job.Status = model.JobStatusEnum_Cancelled
-				errorMessage := jr.marshallJobError(err.Error())
-				job.Outcome = &errorMessage
-				if err := jr.repo.Job().UpdateJobStatus(job); err != nil {
-					return errs.BuildError(err, "Could not update not implemented job %v. Killing to prevent infinite loop", job.JobType)
-				}
-				jr.ws.JobUpdate(*job)
-				continue
-			}
+			ctx, cancel := context.WithCancel(jr.shutdownCtx)
+			jr.cancels[job.ID] = cancel
+			jr.running[job.JobType]++
+			jr.wg.Add(1)
+			go jr.runJob(ctx, job)
+		}
+	}
+}
 
-			job.Status = model.JobStatusEnum_InProgress
-			if err := jr.repo.Job().UpdateJobStatus(job); err != nil {
-				return errs.BuildError(err, "Failed to update job status")
-				// this should probably stop the job runner
-			}
+func (jr *jobRunner) disableJobChecker(job *model.Job) error {
+	if slices.Contains(jr.env.DisableJobs, job.JobType) {
+		return fmt.Errorf("job of type %v is disabled", job.JobType.String())
+	}
 
-			jr.ws.JobUpdate(*job)
+	return nil
+}
 
-			jobFunc, err := jr.jobFuncResolver(job.JobType)
-			if err != nil {
-				job.Status = model.JobStatusEnum_Cancelled
-				errorMessage := jr.marshallJobError(err.Error())
-				job.Outcome = &errorMessage
-				if err := jr.repo.Job().UpdateJobStatus(job); err != nil {
-					return errs.BuildError(err, "Could not update not implemented job %v. Killing to prevent infinite loop", job.JobType)
-				}
-				jr.ws.JobUpdate(*job)
-				continue
-			}
+// runJob runs a job that has already been claimed and marked as in progress.
+// The context is cancelled when the job is cancelled or on shutdown.
+func (jr *jobRunner) runJob(ctx context.Context, job *model.Job) {
+	defer jr.wg.Done()
+	defer jr.workerDone(job)
 
-			if err := jobFunc(job); err != nil {
-				jr.logger.Errorf("Job finished with errors: %v", err.Error())
-				job.Status = model.JobStatusEnum_Failed
-				errText := jr.marshallJobError(err.Error())
-				job.Outcome = &errText
-				if erro := jr.repo.Job().UpdateJobStatus(job); erro != nil {
-					return errs.BuildError(erro, "Could not update job status after error. Killing to prevent infinite loop")
-				}
-				jr.ws.JobUpdate(*job)
-				continue
-			}
+	if err := jr.disableJobChecker(job); err != nil {
+		jr.finishJob(job, model.JobStatusEnum_Cancelled, err)
+		return
+	}
 
-			job.Status = model.JobStatusEnum_Completed
-			if err := jr.repo.Job().UpdateJobStatus(job); err != nil {
-				return errs.BuildError(err, "Could not update job status after success. Killing to prevent infinite loop")
-			}
-			jr.ws.JobUpdate(*job)
+	jr.ws.JobUpdate(*job)
+
+	jobFunc, err := jr.jobFuncResolver(job.JobType)
+	if err != nil {
+		jr.finishJob(job, model.JobStatusEnum_Cancelled, err)
+		return
+	}
+
+	if err := jobFunc(ctx, job); err != nil {
+		if ctx.Err() != nil {
+			jr.logger.Infof("Job %v was cancelled: %v", job.ID.String(), err.Error())
+			jr.finishJob(job, model.JobStatusEnum_Cancelled, err)
+			return
 		}
+
+		jr.logger.Errorf("Job finished with errors: %v", err.Error())
+		jr.finishJob(job, model.JobStatusEnum_Failed, err)
+		return
 	}
+
+	jr.completeJob(job)
 }
 
-type JobFunc func(*model.Job) error
+func (jr *jobRunner) workerDone(job *model.Job) {
+	select {
+	case jr.done <- job:
+	case <-jr.shutdownCtx.Done():
+	}
+}
+
+func (jr *jobRunner) finishJob(job *model.Job, status model.JobStatusEnum, outcome error) {
+	job.Status = status
+	if status == model.JobStatusEnum_Completed {
+		job.Progress = 100
+	}
+	if outcome != nil {
+		errorMessage := jr.marshallJobError(outcome.Error())
+		job.Outcome = &errorMessage
+	}
+
+	if err := jr.repo.Job().UpdateJobStatus(job); err != nil {
+		jr.logger.Errorf("Could not update job %v status to %v: %v", job.ID.String(), status, err.Error())
+	}
+
+	jr.ws.JobUpdate(*job)
+}
+
+// completeJob completes a job that ran successfully.
+// A job with children that have not finished yet stays in progress until they have finished.
+func (jr *jobRunner) completeJob(job *model.Job) {
+	counts, err := jr.repo.Job().CountChildrenByStatus(job.ID)
+	if err != nil {
+		jr.logger.Errorf("Could not count children of job %v: %v", job.ID.String(), err.Error())
+		jr.finishJob(job, model.JobStatusEnum_Completed, nil)
+		return
+	}
+
+	if counts[model.JobStatusEnum_NotStarted]+counts[model.JobStatusEnum_InProgress] > 0 {
+		step := waitingForChildrenStep
+		job.Step = &step
+		jr.finishJob(job, model.JobStatusEnum_InProgress, nil)
+		return
+	}
+
+	jr.finishJob(job, dto.DeriveJobStatus(model.JobStatusEnum_Completed, counts), childrenOutcome(counts))
+}
+
+// resolveParent finishes a job that is waiting for its children once all of them have finished.
+// The parent of the job is resolved in turn. It is only called from the loop.
+func (jr *jobRunner) resolveParent(id uuid.UUID) {
+	if _, ok := jr.cancels[id]; ok {
+		// still running, the job is resolved once it is done
+		return
+	}
+
+	parent, err := jr.repo.Job().GetById(id)
+	if err != nil {
+		jr.logger.Errorf("Could not get parent job %v: %v", id.String(), err.Error())
+		return
+	}
+
+	if parent == nil || parent.Status != model.JobStatusEnum_InProgress {
+		return
+	}
+
+	counts, err := jr.repo.Job().CountChildrenByStatus(id)
+	if err != nil {
+		jr.logger.Errorf("Could not count children of job %v: %v", id.String(), err.Error())
+		return
+	}
+
+	if counts[model.JobStatusEnum_NotStarted]+counts[model.JobStatusEnum_InProgress] > 0 {
+		return
+	}
+
+	parent.Step = nil
+	jr.finishJob(parent, dto.DeriveJobStatus(model.JobStatusEnum_Completed, counts), childrenOutcome(counts))
+
+	if parent.Parent != nil {
+		jr.resolveParent(*parent.Parent)
+	}
+}
+
+// cancelWaitingParent cancels a job that is waiting for its children along with the children that have not started yet.
+// Children that are already running are left to finish. It is only called from the loop.
+func (jr *jobRunner) cancelWaitingParent(id uuid.UUID) {
+	job, err := jr.repo.Job().GetById(id)
+	if err != nil {
+		jr.logger.Errorf("Could not get job %v to cancel: %v", id.String(), err.Error())
+		return
+	}
+
+	if job == nil || job.Status != model.JobStatusEnum_InProgress || job.Step == nil || *job.Step != waitingForChildrenStep {
+		jr.logger.Warningf("Job %v is not running in this job runner. Not cancelling", id.String())
+		return
+	}
+
+	children, err := jr.repo.Job().CancelNotStartedChildren(id)
+	if err != nil {
+		jr.logger.Errorf("Could not cancel children of job %v: %v", id.String(), err.Error())
+		return
+	}
+
+	for _, c := range children {
+		jr.ws.JobUpdate(c)
+	}
+
+	jr.logger.Infof("Cancelling job %v and %v of its children", id.String(), len(children))
+	job.Step = nil
+	jr.finishJob(job, model.JobStatusEnum_Cancelled, fmt.Errorf("cancelled while waiting for child jobs"))
+
+	if job.Parent != nil {
+		jr.resolveParent(*job.Parent)
+	}
+}
+
+func childrenOutcome(counts map[model.JobStatusEnum]int) error {
+	switch {
+	case counts[model.JobStatusEnum_Failed] > 0:
+		return fmt.Errorf("%v child jobs failed", counts[model.JobStatusEnum_Failed])
+	case counts[model.JobStatusEnum_Cancelled] > 0:
+		return fmt.Errorf("%v child jobs were cancelled", counts[model.JobStatusEnum_Cancelled])
+	default:
+		return nil
+	}
+}
+
+type JobFunc func(context.Context, *model.Job) error
 
 func (jr *jobRunner) jobFuncResolver(jobType model.JobTypeEnum) (JobFunc, error) {
 	var f JobFunc
 	switch jobType {
 	case model.JobTypeEnum_ScanPath:
-		f = func(j *model.Job) error {
-			return jr.ScanPath(j)
+		f = func(ctx context.Context, j *model.Job) error {
+			return jr.ScanPath(ctx, j)
 		}
 	case model.JobTypeEnum_GenerateChecksum:
-		f = func(j *model.Job) error {
+		f = func(_ context.Context, j *model.Job) error {
 			return jr.GenerateChecksum(j)
 		}
 	case model.JobTypeEnum_GenerateThumbnail:
-		f = func(j *model.Job) error {
-			return jr.GenerateThumbnail(j)
+		f = func(ctx context.Context, j *model.Job) error {
+			return jr.GenerateThumbnail(ctx, j)
 		}
 	case model.JobTypeEnum_RefreshMetadata:
-		f = func(j *model.Job) error {
-			return jr.RefreshMetadata(j)
+		f = func(ctx context.Context, j *model.Job) error {
+			return jr.RefreshMetadata(ctx, j)
 		}
 	case model.JobTypeEnum_RefreshLibraryMetadata:
-		f = func(j *model.Job) error {
+		f = func(_ context.Context, j *model.Job) error {
 			return jr.refreshLibraryMetadata(j)
 		}
 	case model.JobTypeEnum_GenerateChapters:
-		f = func(j *model.Job) error {
+		f = func(_ context.Context, j *model.Job) error {
 			return jr.generateChapters(j)
 		}
+	case model.JobTypeEnum_ScanLibrary:
+		f = func(_ context.Context, j *model.Job) error {
+			return jr.scanLibrary(j)
+		}
+	case model.JobTypeEnum_GenerateLibraryChapters:
+		f = func(ctx context.Context, j *model.Job) error {
+			return jr.generateLibraryChapters(ctx, j)
+		}
 	case model.JobTypeEnum_Convert:
-		f = func(j *model.Job) error {
-			return jr.convert(j)
+		f = func(ctx context.Context, j *model.Job) error {
+			return jr.convert(ctx, j)
+		}
+	case model.JobTypeEnum_ExtractSubtitles:
+		f = func(ctx context.Context, j *model.Job) error {
+			return jr.extractSubtitles(ctx, j)
 		}
 	default:
 		return nil, fmt.Errorf("no implementation to run job type %v", jobType)
@@ -0,0 +1,71 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	mock_websockets "github.com/slugger7/exorcist/apps/server/internal/mock/websockets"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CancelWaitingParent_ShouldCancelParentAndChildrenThatHaveNotStarted(t *testing.T) {
+	jr, repos := testJobRunnerWithRepo(t)
+	ws := mock_websockets.NewMockWebsockets(gomock.NewController(t))
+	jr.ws = ws
+
+	step := waitingForChildrenStep
+	parent := &model.Job{ID: uuid.New(), Status: model.JobStatusEnum_InProgress, Step: &step}
+	children := []model.Job{
+		{ID: uuid.New(), Parent: &parent.ID, Status: model.JobStatusEnum_Cancelled},
+		{ID: uuid.New(), Parent: &parent.ID, Status: model.JobStatusEnum_Cancelled},
+	}
+
+	repos.job.EXPECT().GetById(parent.ID).Return(parent, nil).Times(1)
+	repos.job.EXPECT().CancelNotStartedChildren(parent.ID).Return(children, nil).Times(1)
+	repos.job.EXPECT().
+		UpdateJobStatus(parent).
+		DoAndReturn(func(j *model.Job) error {
+			assert.Equal(t, model.JobStatusEnum_Cancelled, j.Status)
+			assert.Nil(t, j.Step)
+			return nil
+		}).
+		Times(1)
+
+	ws.EXPECT().JobUpdate(children[0]).Times(1)
+	ws.EXPECT().JobUpdate(children[1]).Times(1)
+	ws.EXPECT().
+		JobUpdate(gomock.Any()).
+		Do(func(j model.Job) {
+			assert.Equal(t, parent.ID, j.ID)
+			assert.Equal(t, model.JobStatusEnum_Cancelled, j.Status)
+		}).
+		Times(1)
+
+	jr.cancelWaitingParent(parent.ID)
+}
+
+func Test_CancelWaitingParent_WithJobThatIsNotWaiting_ShouldNotCancel(t *testing.T) {
+	jr, repos := testJobRunnerWithRepo(t)
+	jr.ws = mock_websockets.NewMockWebsockets(gomock.NewController(t))
+
+	step := "generating thumbnail"
+	cases := []*model.Job{
+		nil,
+		{ID: uuid.New(), Status: model.JobStatusEnum_Completed},
+		{ID: uuid.New(), Status: model.JobStatusEnum_InProgress},
+		{ID: uuid.New(), Status: model.JobStatusEnum_InProgress, Step: &step},
+	}
+
+	for _, c := range cases {
+		id := uuid.New()
+		if c != nil {
+			id = c.ID
+		}
+
+		repos.job.EXPECT().GetById(id).Return(c, nil).Times(1)
+
+		jr.cancelWaitingParent(id)
+	}
+}
@@ -0,0 +1,39 @@
+package job
+
+import (
+	"math"
+
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+// minProgressIncrement keeps jobs from writing to the database and websockets on every ffmpeg progress line
+const minProgressIncrement float64 = 1
+
+// reportProgress stores the percentage and the current step of a running job and broadcasts it.
+// Updates within the same step that are smaller than minProgressIncrement are skipped.
+func (jr *jobRunner) reportProgress(job *model.Job, progress float64, step string) {
+	progress = math.Max(0, math.Min(100, progress))
+
+	sameStep := job.Step != nil && *job.Step == step
+	if sameStep && progress < 100 && progress-job.Progress < minProgressIncrement {
+		return
+	}
+
+	job.Progress = progress
+	job.Step = &step
+
+	if err := jr.repo.Job().UpdateProgress(job); err != nil {
+		jr.logger.Warningf("Could not update progress of job %v: %v", job.ID.String(), err.Error())
+	}
+
+	jr.ws.JobProgress(*job)
+}
+
+// scaledProgress maps done out of total onto the range between start and end
+func scaledProgress(start, end, done, total float64) float64 {
+	if total <= 0 {
+		return end
+	}
+
+	return start + (end-start)*done/total
+}
@@ -39,6 +39,8 @@ func (jr *jobRunner) refreshLibraryMetadata(job *model.Job) error {
 			break
 		}
 
+		jr.reportProgress(job, scaledProgress(0, 100, float64(skip), float64(mediaPage.Total)), "creating refresh metadata jobs")
+
 		var accErr error
 		refreshJobs := []model.Job{}
 		for _, o := range mediaPage.Data {
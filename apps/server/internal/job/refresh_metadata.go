@@ -1,8 +1,10 @@
 package job
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
@@ -10,6 +12,7 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
 	"github.com/slugger7/exorcist/apps/server/internal/media"
 )
 
@@ -17,8 +20,9 @@ func CreateRefreshMetadataJob(media model.Media, jobId *uuid.UUID, refreshFields
 	var localRefreshFields dto.RefreshFields
 	if refreshFields == nil {
 		localRefreshFields = dto.RefreshFields{
-			Size:     true,
-			Checksum: false,
+			Size:          true,
+			Checksum:      false,
+			VideoMetadata: true,
 		}
 	} else {
 		localRefreshFields = *refreshFields
@@ -46,7 +50,7 @@ func CreateRefreshMetadataJob(media model.Media, jobId *uuid.UUID, refreshFields
 	return job, nil
 }
 
-func (jr *jobRunner) RefreshMetadata(job *model.Job) error {
+func (jr *jobRunner) RefreshMetadata(ctx context.Context, job *model.Job) error {
 	var jobData dto.RefreshMetadata
 	if err := json.Unmarshal([]byte(*job.Data), &jobData); err != nil {
 		return errs.BuildError(err, "error parsing job data for refresh metadata: %v", job.Data)
@@ -87,6 +91,18 @@ func (jr *jobRunner) RefreshMetadata(job *model.Job) error {
 		}
 	}
 
+	if jobData.RefreshFields.VideoMetadata && mediaEntity.Video != nil {
+		if err := jr.refreshVideoMetadata(mediaEntity.Path, *mediaEntity.Video); err != nil {
+			return errs.BuildError(err, "refreshing video metadata for %v", mediaEntity.Path)
+		}
+	}
+
+	if jobData.RefreshFields.PerceptualHash && mediaEntity.Video != nil {
+		if err := jr.refreshPerceptualHash(ctx, mediaEntity.Path, *mediaEntity.Video); err != nil {
+			return errs.BuildError(err, "refreshing perceptual hash for %v", mediaEntity.Path)
+		}
+	}
+
 	if len(updateColumns) == 0 {
 		return nil
 	}
@@ -97,3 +113,65 @@ func (jr *jobRunner) RefreshMetadata(job *model.Job) error {
 
 	return nil
 }
+
+func (jr *jobRunner) refreshVideoMetadata(path string, video model.Video) error {
+	details, err := ffmpeg.UnmarshalledProbeDetails(path)
+	if err != nil {
+		return errs.BuildError(err, "could not get probe for %v", path)
+	}
+
+	if dimension, err := ffmpeg.GetDimensions(details.Probe().Streams); err == nil {
+		if dimension.Height != nil {
+			video.Height = int32(*dimension.Height)
+		}
+		if dimension.Width != nil {
+			video.Width = int32(*dimension.Width)
+		}
+	}
+
+	if details.Format != nil {
+		if runtime, err := strconv.ParseFloat(details.Format.Duration, 64); err == nil {
+			video.Runtime = runtime
+		}
+	}
+
+	if err := applyVideoMetadata(&video, details.Metadata()); err != nil {
+		return err
+	}
+
+	v := table.Video
+	if _, err := jr.repo.Video().Update(video, postgres.ColumnList{
+		v.Height,
+		v.Width,
+		v.Runtime,
+		v.VideoCodec,
+		v.AudioCodec,
+		v.Container,
+		v.Bitrate,
+		v.VideoBitrate,
+		v.FrameRate,
+		v.PixelFormat,
+		v.AudioStreams,
+		v.SubtitleStreams,
+	}); err != nil {
+		return errs.BuildError(err, "saving probed metadata to video %v", video.ID.String())
+	}
+
+	return nil
+}
+
+func (jr *jobRunner) refreshPerceptualHash(ctx context.Context, path string, video model.Video) error {
+	hash, err := ffmpeg.PerceptualHash(ctx, path, video.Runtime)
+	if err != nil {
+		return errs.BuildError(err, "could not calculate perceptual hash for %v", path)
+	}
+
+	phash := int64(hash)
+	video.Phash = &phash
+
+	if _, err := jr.repo.Video().Update(video, postgres.ColumnList{table.Video.Phash}); err != nil {
+		return errs.BuildError(err, "saving perceptual hash to video %v", video.ID.String())
+	}
+
+	return nil
+}
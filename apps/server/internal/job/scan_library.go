@@ -0,0 +1,77 @@
+package job
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+func CreateScanPathJob(libraryPathId uuid.UUID, jobId *uuid.UUID) (*model.Job, error) {
+	d := dto.ScanPathData{
+		LibraryPathId: libraryPathId,
+	}
+
+	js, err := json.Marshal(d)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not marshal scan path data")
+	}
+
+	data := string(js)
+	job := &model.Job{
+		JobType:  model.JobTypeEnum_ScanPath,
+		Status:   model.JobStatusEnum_NotStarted,
+		Data:     &data,
+		Parent:   jobId,
+		Priority: dto.JobPriority_Medium,
+	}
+
+	return job, nil
+}
+
+func (jr *jobRunner) scanLibrary(job *model.Job) error {
+	var jobData dto.ScanLibraryData
+	if err := json.Unmarshal([]byte(*job.Data), &jobData); err != nil {
+		return errs.BuildError(err, "error parsing job data for scan library: %v", job.Data)
+	}
+
+	libraryPaths, err := jr.repo.LibraryPath().GetByLibraryId(jobData.LibraryId)
+	if err != nil {
+		return errs.BuildError(err, "could not get library paths for library: %v", jobData.LibraryId.String())
+	}
+
+	var accErr error
+	scanJobs := []model.Job{}
+	for _, libraryPath := range libraryPaths {
+		j, err := CreateScanPathJob(libraryPath.ID, &job.ID)
+		if err != nil {
+			accErr = errors.Join(accErr, err)
+			continue
+		}
+		scanJobs = append(scanJobs, *j)
+	}
+
+	if accErr != nil {
+		jr.logger.Errorf("encountered errors while creating scan path jobs: %v", accErr.Error())
+	}
+
+	if len(scanJobs) == 0 {
+		jr.logger.Infof("No library paths to scan for library %v", jobData.LibraryId.String())
+		return nil
+	}
+
+	jobs, err := jr.repo.Job().CreateAll(scanJobs)
+	if err != nil {
+		return errs.BuildError(err, "creating scan path jobs for %v", jobData.LibraryId.String())
+	}
+
+	if len(jobs) != len(scanJobs) {
+		return fmt.Errorf("jobs created (%v) and jobs saved to database (%v) differed", len(scanJobs), len(jobs))
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_jobRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/job"
+	mock_libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/library_path"
+	mock_videoRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/video"
+	jobRepository "github.com/slugger7/exorcist/apps/server/internal/repository/job"
+	libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library_path"
+	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
+	"github.com/slugger7/exorcist/apps/server/internal/websockets"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type testRepos struct {
+	job         *mock_jobRepository.MockJobRepository
+	libraryPath *mock_libraryPathRepository.MockLibraryPathRepository
+	video       *mock_videoRepository.MockVideoRepository
+}
+
+func testJobRunnerWithRepo(t *testing.T) (*jobRunner, *testRepos) {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock_repository.NewMockRepository(ctrl)
+	repos := &testRepos{
+		job:         mock_jobRepository.NewMockJobRepository(ctrl),
+		libraryPath: mock_libraryPathRepository.NewMockLibraryPathRepository(ctrl),
+		video:       mock_videoRepository.NewMockVideoRepository(ctrl),
+	}
+
+	mockRepo.EXPECT().
+		Job().DoAndReturn(func() jobRepository.JobRepository {
+		return repos.job
+	}).AnyTimes()
+	mockRepo.EXPECT().
+		LibraryPath().DoAndReturn(func() libraryPathRepository.LibraryPathRepository {
+		return repos.libraryPath
+	}).AnyTimes()
+	mockRepo.EXPECT().
+		Video().DoAndReturn(func() videoRepository.VideoRepository {
+		return repos.video
+	}).AnyTimes()
+
+	jr := testJobRunner()
+	jr.repo = mockRepo
+	jr.ws = websockets.New(jr.env, mockRepo)
+
+	return jr, repos
+}
+
+func scanLibraryJob(libraryId uuid.UUID) *model.Job {
+	data := fmt.Sprintf(`{"libraryId":"%v"}`, libraryId)
+	id, _ := uuid.NewRandom()
+	return &model.Job{ID: id, JobType: model.JobTypeEnum_ScanLibrary, Data: &data}
+}
+
+func Test_CreateScanPathJob(t *testing.T) {
+	libraryPathId, _ := uuid.NewRandom()
+	jobId, _ := uuid.NewRandom()
+
+	actual, err := CreateScanPathJob(libraryPathId, &jobId)
+	assert.Nil(t, err)
+
+	actualData := *actual.Data
+	actual.Data = nil
+
+	expectedData := fmt.Sprintf(`{"libraryPathId":"%v"}`, libraryPathId)
+	expected := model.Job{
+		JobType:  model.JobTypeEnum_ScanPath,
+		Status:   model.JobStatusEnum_NotStarted,
+		Data:     nil,
+		Parent:   &jobId,
+		Priority: dto.JobPriority_Medium,
+	}
+
+	assert.Equal(t, expected, *actual)
+	assert.Equal(t, expectedData, actualData)
+}
+
+func Test_ScanLibrary(t *testing.T) {
+	libraryId, _ := uuid.NewRandom()
+	libraryPaths := []model.LibraryPath{{ID: uuid.New()}, {ID: uuid.New()}}
+	repoErr := errors.New("repo error")
+
+	cases := []struct {
+		name         string
+		libraryPaths []model.LibraryPath
+		pathsErr     error
+		created      func(jobs []model.Job) ([]model.Job, error)
+		createCalls  int
+		expectErr    bool
+	}{
+		{
+			name:         "creates a scan path job for every library path",
+			libraryPaths: libraryPaths,
+			created:      func(jobs []model.Job) ([]model.Job, error) { return jobs, nil },
+			createCalls:  1,
+		},
+		{
+			name:         "does not create jobs for a library without paths",
+			libraryPaths: []model.LibraryPath{},
+		},
+		{
+			name:      "fails when library paths can not be fetched",
+			pathsErr:  repoErr,
+			expectErr: true,
+		},
+		{
+			name:         "fails when jobs can not be created",
+			libraryPaths: libraryPaths,
+			created:      func([]model.Job) ([]model.Job, error) { return nil, repoErr },
+			createCalls:  1,
+			expectErr:    true,
+		},
+		{
+			name:         "fails when not every job was saved",
+			libraryPaths: libraryPaths,
+			created:      func(jobs []model.Job) ([]model.Job, error) { return jobs[:1], nil },
+			createCalls:  1,
+			expectErr:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			jr, repos := testJobRunnerWithRepo(t)
+			job := scanLibraryJob(libraryId)
+
+			repos.libraryPath.EXPECT().
+				GetByLibraryId(libraryId).
+				Return(c.libraryPaths, c.pathsErr).
+				Times(1)
+
+			var createdJobs []model.Job
+			repos.job.EXPECT().
+				CreateAll(gomock.Any()).
+				DoAndReturn(func(jobs []model.Job) ([]model.Job, error) {
+					createdJobs = jobs
+					return c.created(jobs)
+				}).
+				Times(c.createCalls)
+
+			err := jr.scanLibrary(job)
+
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if c.createCalls == 0 {
+				return
+			}
+
+			if assert.Len(t, createdJobs, len(c.libraryPaths)) {
+				for i, j := range createdJobs {
+					assert.Equal(t, model.JobTypeEnum_ScanPath, j.JobType)
+					assert.Equal(t, &job.ID, j.Parent)
+					assert.Equal(t, fmt.Sprintf(`{"libraryPathId":"%v"}`, c.libraryPaths[i].ID), *j.Data)
+				}
+			}
+		})
+	}
+}
+
+func Test_ScanLibrary_WithInvalidData_ShouldReturnError(t *testing.T) {
+	jr, _ := testJobRunnerWithRepo(t)
+	data := "not json"
+
+	err := jr.scanLibrary(&model.Job{Data: &data})
+
+	assert.NotNil(t, err)
+}
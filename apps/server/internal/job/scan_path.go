@@ -1,9 +1,11 @@
 package job
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strconv"
 
@@ -24,27 +26,34 @@ import (
 const (
 	batchSize    = 100
 	maxDimension = 400
+
+	scanFilesProgress   float64 = 10
+	removeMediaProgress float64 = 15
 )
 
-func (jr *jobRunner) getFilesByExtension(path string, extensions []string, ch chan []media.File) {
+func (jr *jobRunner) getFilesByExtension(ctx context.Context, path string, extensions []string, ch chan []media.File) {
 	defer jr.wg.Done()
 
 	select {
-	case <-jr.shutdownCtx.Done():
-		jr.logger.Debugf("Shutdown context called")
+	case <-ctx.Done():
+		jr.logger.Debugf("Context done before getting files by extension")
 		return
 	default:
 		values, err := media.GetFilesByExtensions(path, extensions)
 		if err != nil {
 			jr.logger.Errorf("could not get files by extension: %v", err)
 			ch <- nil
+			return
+		}
+		if values == nil {
+			values = []media.File{} // nil is reserved for a failed listing
 		}
 		ch <- values
 	}
 
 }
 
-func (jr *jobRunner) ScanPath(job *model.Job) error {
+func (jr *jobRunner) ScanPath(ctx context.Context, job *model.Job) error {
 	var data dto.ScanPathData
 	if err := json.Unmarshal([]byte(*job.Data), &data); err != nil {
 		return errs.BuildError(err, "could not unmarshal scan path job data: %v", err)
@@ -58,39 +67,84 @@ func (jr *jobRunner) ScanPath(job *model.Job) error {
 		return fmt.Errorf("library path not found: %v", data.LibraryPathId)
 	}
 
-	videoChan := make(chan []media.File)
+	videoChan := make(chan []media.File, 1)
+	jr.wg.Add(1)
+	go jr.getFilesByExtension(ctx, libPath.Path, constants.VideoExtensions[:], videoChan)
+
+	imageChan := make(chan []media.File, 1)
 	jr.wg.Add(1)
-	go jr.getFilesByExtension(libPath.Path, constants.VideoExtensions[:], videoChan)
+	go jr.getFilesByExtension(ctx, libPath.Path, constants.ImageExtensions[:], imageChan)
 
-	imageChan := make(chan []media.File)
+	subtitleChan := make(chan []media.File, 1)
 	jr.wg.Add(1)
-	go jr.getFilesByExtension(libPath.Path, constants.ImageExtensions[:], imageChan)
+	go jr.getFilesByExtension(ctx, libPath.Path, constants.SubtitleExtensions[:], subtitleChan)
+
+	jr.reportProgress(job, 0, "finding files")
 
 	existingMedia, err := jr.repo.Media().GetByLibraryPathId(libPath.ID)
 	if err != nil {
 		return errs.BuildError(err, "could not get existing videos for library path: %v", libPath.ID)
 	}
 
-	for range 2 { // need to connsume off of each channel once
+	var videosOnDisk, imagesOnDisk, subtitlesOnDisk []media.File
+	for range 3 { // need to connsume off of each channel once
 		select {
-		case <-jr.shutdownCtx.Done():
-			const msg string = "shutdown signal received. stopping"
+		case <-ctx.Done():
+			const msg string = "job cancelled or shutdown signal received. stopping"
 			jr.logger.Warning(msg)
 			return errors.New(msg)
-		case imagesOnDisk := <-imageChan:
-			_ = imagesOnDisk
-			// TODO: handle images on disk
-			continue
-		case videosOnDisk := <-videoChan:
-			err := jr.handleVideosOnDisk(*job, *libPath, existingMedia, videosOnDisk)
-			if err != nil {
-				continue // TODO: concat errors to bigger errors object to return
-			}
+		case imagesOnDisk = <-imageChan:
+		case videosOnDisk = <-videoChan:
+		case subtitlesOnDisk = <-subtitleChan:
 		}
 	}
+
+	jr.reportProgress(job, scanFilesProgress, "removing missing media")
+
+	nonExistentMedia, ok := findMissingMedia(existingMedia, videosOnDisk, imagesOnDisk)
+	if !ok {
+		jr.logger.Warningf("could not list all files in %v. Not removing missing media", libPath.Path)
+	} else if len(nonExistentMedia) > 0 {
+		jr.removeMedia(ctx, nonExistentMedia)
+	}
+
+	total := len(videosOnDisk) + len(imagesOnDisk)
+	progress := func(offset int, step string) func(done int) {
+		return func(done int) {
+			jr.reportProgress(job, scaledProgress(removeMediaProgress, 100, float64(offset+done), float64(total)), step)
+		}
+	}
+
+	accErrs := []error{}
+	if err := jr.handleVideosOnDisk(ctx, *job, *libPath, existingMedia, videosOnDisk, progress(0, "adding videos")); err != nil {
+		accErrs = append(accErrs, err)
+	}
+
+	if err := jr.handleImagesOnDisk(ctx, *job, *libPath, existingMedia, imagesOnDisk, progress(len(videosOnDisk), "adding images")); err != nil {
+		accErrs = append(accErrs, err)
+	}
+
+	if err := jr.handleSubtitlesOnDisk(ctx, *job, existingMedia, videosOnDisk, subtitlesOnDisk); err != nil {
+		accErrs = append(accErrs, err)
+	}
+
+	if len(accErrs) > 0 {
+		jr.logger.Errorf("errors while scanning path %v: %v", libPath.Path, errors.Join(accErrs...).Error())
+	}
+
 	return nil
 }
 
+// findMissingMedia returns the existing media that is no longer on disk.
+// Nothing is reported missing when listing the videos or images failed
+func findMissingMedia(existingMedia []model.Media, videosOnDisk, imagesOnDisk []media.File) ([]model.Media, bool) {
+	if videosOnDisk == nil || imagesOnDisk == nil {
+		return nil, false
+	}
+
+	return media.FindNonExistentMedia(existingMedia, slices.Concat(videosOnDisk, imagesOnDisk)), true
+}
+
 func CreateNewMedia(
 	libPath *model.LibraryPath,
 	jobId *uuid.UUID,
@@ -103,10 +157,11 @@ func CreateNewMedia(
 		return fmt.Errorf("library path was nil, cant create new media")
 	}
 
-	data, err := ffmpeg.UnmarshalledProbe(f.Path)
+	details, err := ffmpeg.UnmarshalledProbeDetails(f.Path)
 	if err != nil {
 		return errs.BuildError(err, "could not get unmarshalled probe data: %v", f.Path)
 	}
+	data := details.Probe()
 
 	newMediaModel := model.Media{
 		LibraryPathID: libPath.ID,
@@ -146,6 +201,10 @@ func CreateNewMedia(
 		Runtime: float64(runtime),
 	}
 
+	if err := applyVideoMetadata(&newVideoModel, details.Metadata()); err != nil {
+		logger.Warningf("could not apply probe metadata for %v: %v", f.Path, err)
+	}
+
 	// this should probably happen in a transaction with the create media call
 	createdVideos, err := repo.Video().Insert([]model.Video{newVideoModel})
 	if err != nil {
@@ -167,18 +226,14 @@ func CreateNewMedia(
 	return nil
 }
 
-func (jr *jobRunner) handleVideosOnDisk(job model.Job, libPath model.LibraryPath, existingMedia []model.Media, videosOnDisk []media.File) error {
-	nonExistentMedia := media.FindNonExistentMedia(existingMedia, videosOnDisk)
-	if len(nonExistentMedia) > 0 {
-		jr.removeMedia(nonExistentMedia)
-	}
-
+func (jr *jobRunner) handleVideosOnDisk(ctx context.Context, job model.Job, libPath model.LibraryPath, existingMedia []model.Media, videosOnDisk []media.File, progress func(done int)) error {
 	accErrs := []error{}
-	for _, v := range videosOnDisk {
+	for i, v := range videosOnDisk {
 		select {
-		case <-jr.shutdownCtx.Done():
-			return fmt.Errorf("partially done, ended due to shutdown")
+		case <-ctx.Done():
+			return fmt.Errorf("partially done, ended due to cancellation or shutdown")
 		default:
+			progress(i + 1)
 			if mediaExists(existingMedia, v.Path) {
 				continue
 			}
@@ -198,10 +253,155 @@ func (jr *jobRunner) handleVideosOnDisk(job model.Job, libPath model.LibraryPath
 	return nil
 }
 
-func (jr *jobRunner) removeMedia(nonExistentMedia []model.Media) {
+// handleSubtitlesOnDisk extracts subtitles of existing videos again when sidecars were added next to them.
+// Subtitles of new videos are extracted when the video is created
+func (jr *jobRunner) handleSubtitlesOnDisk(ctx context.Context, job model.Job, existingMedia []model.Media, videosOnDisk, subtitlesOnDisk []media.File) error {
+	subtitlesByDir := map[string][]media.File{}
+	for _, s := range subtitlesOnDisk {
+		dir := filepath.Dir(s.Path)
+		subtitlesByDir[dir] = append(subtitlesByDir[dir], s)
+	}
+
+	jobs := []model.Job{}
+	for _, v := range videosOnDisk {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("partially done, ended due to cancellation or shutdown")
+		default:
+			sidecars := media.MatchSidecars(v.Path, subtitlesByDir[filepath.Dir(v.Path)])
+			if len(sidecars) == 0 {
+				continue
+			}
+
+			i := slices.IndexFunc(existingMedia, func(m model.Media) bool {
+				return m.Path == v.Path
+			})
+			if i < 0 {
+				continue
+			}
+
+			relations, err := jr.repo.Media().GetAssetsFor(existingMedia[i].ID)
+			if err != nil {
+				return errs.BuildError(err, "could not get assets of %v", existingMedia[i].ID.String())
+			}
+
+			if !hasUnconvertedSidecars(sidecars, relations) {
+				continue
+			}
+
+			subtitlesJob, err := CreateExtractSubtitlesJob(existingMedia[i].ID, &job.ID)
+			if err != nil {
+				return errs.BuildError(err, "could not create extract subtitles job for %v", existingMedia[i].ID.String())
+			}
+
+			jobs = append(jobs, *subtitlesJob)
+		}
+	}
+
+	if len(jobs) > 0 {
+		if _, err := jr.repo.Job().CreateAll(jobs); err != nil {
+			return errs.BuildError(err, "could not create extract subtitles jobs")
+		}
+	}
+
+	return nil
+}
+
+func CreateNewImageMedia(
+	libPath *model.LibraryPath,
+	jobId *uuid.UUID,
+	f media.File,
+	env environment.EnvironmentVariables,
+	repo repository.Repository,
+	logger logger.Logger,
+	ws websockets.Websockets) error {
+	if libPath == nil {
+		return fmt.Errorf("library path was nil, cant create new image media")
+	}
+
+	data, err := ffmpeg.UnmarshalledProbe(f.Path)
+	if err != nil {
+		return errs.BuildError(err, "could not get unmarshalled probe data: %v", f.Path)
+	}
+
+	height, width := 0, 0
+	dimension, err := ffmpeg.GetDimensions(data.Streams)
+	if err != nil {
+		logger.Warningf("could not extract dimensions for %v. Setting to 0. Reason: %v", f.Path, err)
+	} else {
+		if dimension.Height != nil {
+			height = *dimension.Height
+		}
+		if dimension.Width != nil {
+			width = *dimension.Width
+		}
+	}
+
+	newMediaModel := model.Media{
+		LibraryPathID: libPath.ID,
+		Title:         f.Name,
+		Size:          f.Size,
+		Path:          f.Path,
+		MediaType:     model.MediaTypeEnum_Primary,
+	}
+
+	createdMedia, err := repo.Media().Create([]model.Media{newMediaModel})
+	if err != nil {
+		return errs.BuildError(err, "could not create media")
+	}
+	if len(createdMedia) != 1 {
+		return fmt.Errorf("expected a created media but there was none")
+	}
+
+	// this should probably happen in a transaction with the create media call
+	createdImage, err := repo.Image().Create(&model.Image{
+		MediaID: createdMedia[0].ID,
+		Height:  int32(height),
+		Width:   int32(width),
+	})
+	if err != nil {
+		return errs.BuildError(err, "could not create image")
+	}
+
+	dto := (&dto.MediaOverviewDTO{}).FromModel(models.MediaOverviewModel{
+		Media: createdMedia[0],
+	})
+	ws.MediaCreate(*dto)
+
+	jobs := createNewImageMediaJobs(jobId, createdMedia[0], *createdImage, env.Assets)
+
+	_, err = repo.Job().CreateAll(jobs)
+	if err != nil {
+		return errs.BuildError(err, "could not create jobs for image: %v", createdImage.ID.String())
+	}
+
+	return nil
+}
+
+func (jr *jobRunner) handleImagesOnDisk(ctx context.Context, job model.Job, libPath model.LibraryPath, existingMedia []model.Media, imagesOnDisk []media.File, progress func(done int)) error {
+	for n, i := range imagesOnDisk {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("partially done, ended due to cancellation or shutdown")
+		default:
+			progress(n + 1)
+			if mediaExists(existingMedia, i.Path) {
+				continue
+			}
+
+			if err := CreateNewImageMedia(&libPath, &job.ID, i, *jr.env, jr.repo, jr.logger, jr.ws); err != nil {
+				return errs.BuildError(err, "could not create new media from handling images on disk")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (jr *jobRunner) removeMedia(ctx context.Context, nonExistentMedia []model.Media) {
 	for _, v := range nonExistentMedia {
 		select {
-		case <-jr.shutdownCtx.Done():
+		case <-ctx.Done():
 			return
 		default:
 			v.Exists = false
@@ -0,0 +1,131 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/slugger7/exorcist/apps/server/internal/media"
+	"github.com/stretchr/testify/assert"
+)
+
+func testJobRunner() *jobRunner {
+	env := &environment.EnvironmentVariables{LogLevel: "none"}
+	return &jobRunner{
+		env:    env,
+		logger: logger.New(env),
+		wg:     &sync.WaitGroup{},
+	}
+}
+
+func Test_FindMissingMedia_WithFailedVideoListing_ShouldNotRemoveMedia(t *testing.T) {
+	existing := []model.Media{{Path: "/videos/movie.mp4"}, {Path: "/images/image.jpg"}}
+
+	missing, ok := findMissingMedia(existing, nil, []media.File{{Path: "/images/image.jpg"}})
+
+	assert.False(t, ok)
+	assert.Empty(t, missing)
+}
+
+func Test_FindMissingMedia_WithFailedImageListing_ShouldNotRemoveMedia(t *testing.T) {
+	existing := []model.Media{{Path: "/videos/movie.mp4"}, {Path: "/images/image.jpg"}}
+
+	missing, ok := findMissingMedia(existing, []media.File{{Path: "/videos/movie.mp4"}}, nil)
+
+	assert.False(t, ok)
+	assert.Empty(t, missing)
+}
+
+func Test_FindMissingMedia_WithImageRemovedFromDisk(t *testing.T) {
+	existing := []model.Media{{Path: "/videos/movie.mp4"}, {Path: "/images/image.jpg"}}
+
+	missing, ok := findMissingMedia(existing, []media.File{{Path: "/videos/movie.mp4"}}, []media.File{})
+
+	assert.True(t, ok)
+	assert.Equal(t, []model.Media{{Path: "/images/image.jpg"}}, missing)
+}
+
+func Test_GetFilesByExtension_WithNoMatchingFiles_ShouldSendEmptyList(t *testing.T) {
+	jr := testJobRunner()
+	ch := make(chan []media.File, 1)
+
+	jr.wg.Add(1)
+	jr.getFilesByExtension(context.Background(), t.TempDir(), []string{".jpg"}, ch)
+
+	files := <-ch
+	assert.NotNil(t, files)
+	assert.Empty(t, files)
+}
+
+func Test_GetFilesByExtension_WithMissingPath_ShouldSendNil(t *testing.T) {
+	jr := testJobRunner()
+	ch := make(chan []media.File, 1)
+
+	jr.wg.Add(1)
+	jr.getFilesByExtension(context.Background(), "./does/not/exist", []string{".jpg"}, ch)
+
+	assert.Nil(t, <-ch)
+}
+
+func Test_HandleImagesOnDisk_WithExistingImages_ShouldNotCreateMedia(t *testing.T) {
+	jr := testJobRunner()
+	existing := []model.Media{{Path: "/images/image.jpg"}, {Path: "/images/image.png"}}
+	images := []media.File{{Path: "/images/image.jpg"}, {Path: "/images/image.png"}}
+
+	progress := []int{}
+	err := jr.handleImagesOnDisk(context.Background(), model.Job{ID: uuid.New()}, model.LibraryPath{}, existing, images, func(done int) {
+		progress = append(progress, done)
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, progress)
+}
+
+func Test_HandleImagesOnDisk_WithNewImageThatCanNotBeProbed_ShouldReturnError(t *testing.T) {
+	jr := testJobRunner()
+	images := []media.File{{Path: "./does/not/exist.jpg"}}
+
+	err := jr.handleImagesOnDisk(context.Background(), model.Job{ID: uuid.New()}, model.LibraryPath{}, []model.Media{}, images, func(int) {})
+
+	assert.NotNil(t, err)
+}
+
+func Test_HandleImagesOnDisk_WithCancelledContext_ShouldStop(t *testing.T) {
+	jr := testJobRunner()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := jr.handleImagesOnDisk(ctx, model.Job{ID: uuid.New()}, model.LibraryPath{}, []model.Media{}, []media.File{{Path: "/images/image.jpg"}}, func(int) {
+		t.Error("progress should not be reported after cancellation")
+	})
+
+	assert.NotNil(t, err)
+}
+
+func Test_CreateNewImageMedia_WithoutLibraryPath_ShouldReturnError(t *testing.T) {
+	err := CreateNewImageMedia(nil, nil, media.File{Path: "/images/image.jpg"}, environment.EnvironmentVariables{}, nil, nil, nil)
+
+	assert.NotNil(t, err)
+}
+
+func Test_CreateNewImageMediaJobs_WithoutDimensions_ShouldNotCreateThumbnailJob(t *testing.T) {
+	jobId := uuid.New()
+
+	jobs := createNewImageMediaJobs(&jobId, model.Media{ID: uuid.New(), Path: "/images/image.jpg"}, model.Image{}, "/assets")
+
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, model.JobTypeEnum_GenerateChecksum, jobs[0].JobType)
+}
+
+func Test_CreateNewImageMediaJobs_WithDimensions_ShouldCreateThumbnailJob(t *testing.T) {
+	jobId := uuid.New()
+
+	jobs := createNewImageMediaJobs(&jobId, model.Media{ID: uuid.New(), Path: "/images/image.jpg"}, model.Image{Width: 1920, Height: 1080}, "/assets")
+
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, model.JobTypeEnum_GenerateThumbnail, jobs[1].JobType)
+}
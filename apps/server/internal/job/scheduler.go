@@ -0,0 +1,96 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/slugger7/exorcist/apps/server/internal/repository"
+	"github.com/slugger7/exorcist/apps/server/internal/service"
+	"github.com/slugger7/exorcist/apps/server/internal/websockets"
+)
+
+type scheduler struct {
+	service     service.Service
+	repo        repository.Repository
+	logger      logger.Logger
+	shutdownCtx context.Context
+	wg          *sync.WaitGroup
+	ws          websockets.Websockets
+}
+
+var schedulerInstance *scheduler
+
+// NewScheduler starts a scheduler that enqueues the jobs of job schedules once they are due.
+// Schedules are checked at the start of every minute.
+func NewScheduler(
+	env *environment.EnvironmentVariables,
+	serv service.Service,
+	logger logger.Logger,
+	shutdownCtx context.Context,
+	wg *sync.WaitGroup,
+	ws websockets.Websockets,
+) {
+	if schedulerInstance != nil {
+		return
+	}
+
+	schedulerInstance = &scheduler{
+		service:     serv,
+		repo:        repository.New(env, context.Background()),
+		logger:      logger,
+		shutdownCtx: shutdownCtx,
+		wg:          wg,
+		ws:          ws,
+	}
+
+	logger.Debug("Job scheduler instance created")
+	wg.Add(1)
+	go schedulerInstance.loop()
+}
+
+func (s *scheduler) loop() {
+	defer s.wg.Done()
+
+	s.logger.Info("Running job scheduler")
+	for {
+		// schedules that were missed while the server was down are run once on startup
+		s.runDueSchedules(time.Now())
+
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+		select {
+		case <-s.shutdownCtx.Done():
+			timer.Stop()
+			s.logger.Debug("Shutdown signal received. Stopping job scheduler")
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *scheduler) runDueSchedules(now time.Time) {
+	schedules, err := s.repo.JobSchedule().GetDue(now)
+	if err != nil {
+		s.logger.Errorf("Could not get due job schedules: %v", err.Error())
+		return
+	}
+
+	for _, schedule := range schedules {
+		// RunSchedule wakes the job runner once the job has been enqueued
+		job, err := s.service.Job().RunSchedule(schedule, now)
+		if err != nil {
+			s.logger.Errorf("Could not run job schedule %v (%v): %v", schedule.Name, schedule.ID.String(), err.Error())
+			continue
+		}
+
+		if job == nil {
+			continue
+		}
+
+		s.logger.Infof("Enqueued %v job %v for schedule %v", job.JobType, job.ID.String(), schedule.Name)
+		s.ws.JobCreate(*job)
+	}
+}
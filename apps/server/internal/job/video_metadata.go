@@ -0,0 +1,37 @@
+package job
+
+import (
+	"encoding/json"
+
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
+)
+
+// applyVideoMetadata copies the probed codec and stream information onto the video model
+func applyVideoMetadata(video *model.Video, metadata ffmpeg.Metadata) error {
+	audioStreams, err := json.Marshal(metadata.AudioStreams)
+	if err != nil {
+		return errs.BuildError(err, "could not marshal audio streams")
+	}
+
+	subtitleStreams, err := json.Marshal(metadata.SubtitleStreams)
+	if err != nil {
+		return errs.BuildError(err, "could not marshal subtitle streams")
+	}
+
+	audio := string(audioStreams)
+	subtitles := string(subtitleStreams)
+
+	video.VideoCodec = metadata.VideoCodec
+	video.AudioCodec = metadata.AudioCodec
+	video.Container = metadata.Container
+	video.Bitrate = metadata.Bitrate
+	video.VideoBitrate = metadata.VideoBitrate
+	video.FrameRate = metadata.FrameRate
+	video.PixelFormat = metadata.PixelFormat
+	video.AudioStreams = &audio
+	video.SubtitleStreams = &subtitles
+
+	return nil
+}
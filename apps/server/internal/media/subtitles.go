@@ -0,0 +1,86 @@
+package media
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/slugger7/exorcist/apps/server/internal/constants"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+const (
+	sidecarTagForced  string = "forced"
+	sidecarTagDefault string = "default"
+)
+
+var languageTag = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,4})?$`)
+
+// Sidecar is a subtitle file next to a video that shares the name of the video.
+// Tags between the name and the extension describe the track, e.g. movie.en.forced.srt
+type Sidecar struct {
+	File
+	Language string
+	Forced   bool
+	Default  bool
+}
+
+// MatchSidecars returns the subtitle files that belong to the video at videoPath
+func MatchSidecars(videoPath string, subtitles []File) []Sidecar {
+	dir := filepath.Dir(videoPath)
+	name := GetTitleOfFile(filepath.Base(videoPath))
+
+	sidecars := []Sidecar{}
+	for _, s := range subtitles {
+		if filepath.Dir(s.Path) != dir {
+			continue
+		}
+
+		title := GetTitleOfFile(filepath.Base(s.Path))
+		if title != name && !strings.HasPrefix(title, name+".") {
+			continue
+		}
+
+		sidecar := Sidecar{File: s}
+		for _, tag := range strings.Split(strings.TrimPrefix(title, name), ".") {
+			switch lower := strings.ToLower(tag); {
+			case lower == sidecarTagForced:
+				sidecar.Forced = true
+			case lower == sidecarTagDefault:
+				sidecar.Default = true
+			case sidecar.Language == "" && languageTag.MatchString(lower):
+				sidecar.Language = tag
+			}
+		}
+
+		sidecars = append(sidecars, sidecar)
+	}
+
+	return sidecars
+}
+
+// FindSidecars looks for subtitle files next to the video at videoPath
+func FindSidecars(videoPath string) ([]Sidecar, error) {
+	entries, err := os.ReadDir(filepath.Dir(videoPath))
+	if err != nil {
+		return nil, errs.BuildError(err, "could not read directory of %v", videoPath)
+	}
+
+	subtitles := []File{}
+	for _, e := range entries {
+		if e.IsDir() || !slices.Contains(constants.SubtitleExtensions[:], strings.ToLower(filepath.Ext(e.Name()))) {
+			continue
+		}
+
+		file, err := GetFileInformation(filepath.Join(filepath.Dir(videoPath), e.Name()))
+		if err != nil {
+			return nil, errs.BuildError(err, "could not get information of subtitle %v", e.Name())
+		}
+
+		subtitles = append(subtitles, *file)
+	}
+
+	return MatchSidecars(videoPath, subtitles), nil
+}
@@ -0,0 +1,84 @@
+package media_test
+
+import (
+	"testing"
+
+	. "github.com/slugger7/exorcist/apps/server/internal/media"
+)
+
+func Test_MatchSidecars_WithSubtitlesInOtherDirectories_ShouldNotMatch(t *testing.T) {
+	subtitles := []File{{Path: "/other/movie.srt"}}
+
+	sidecars := MatchSidecars("/videos/movie.mkv", subtitles)
+
+	if len(sidecars) != 0 {
+		t.Errorf("Expected no sidecars but got %v", len(sidecars))
+	}
+}
+
+func Test_MatchSidecars_WithSubtitleOfAnotherVideo_ShouldNotMatch(t *testing.T) {
+	subtitles := []File{{Path: "/videos/movie 2.srt"}, {Path: "/videos/movies.srt"}}
+
+	sidecars := MatchSidecars("/videos/movie.mkv", subtitles)
+
+	if len(sidecars) != 0 {
+		t.Errorf("Expected no sidecars but got %v", len(sidecars))
+	}
+}
+
+func Test_MatchSidecars_WithTags_ShouldParseLanguageAndFlags(t *testing.T) {
+	subtitles := []File{{Path: "/videos/movie.pt-BR.forced.default.ass"}}
+
+	sidecars := MatchSidecars("/videos/movie.mkv", subtitles)
+
+	if len(sidecars) != 1 {
+		t.Fatalf("Expected one sidecar but got %v", len(sidecars))
+	}
+
+	sidecar := sidecars[0]
+	if sidecar.Language != "pt-BR" {
+		t.Errorf("Expected language pt-BR but got '%v'", sidecar.Language)
+	}
+	if !sidecar.Forced {
+		t.Error("Expected sidecar to be forced")
+	}
+	if !sidecar.Default {
+		t.Error("Expected sidecar to be default")
+	}
+}
+
+func Test_MatchSidecars_WithUnknownTag_ShouldMatchWithoutLanguage(t *testing.T) {
+	subtitles := []File{{Path: "/videos/movie.commentary.srt"}}
+
+	sidecars := MatchSidecars("/videos/movie.mkv", subtitles)
+
+	if len(sidecars) != 1 {
+		t.Fatalf("Expected one sidecar but got %v", len(sidecars))
+	}
+	if sidecars[0].Language != "" {
+		t.Errorf("Expected no language but got '%v'", sidecars[0].Language)
+	}
+}
+
+func Test_FindSidecars(t *testing.T) {
+	sidecars, err := FindSidecars("test_data/subtitles/movie.mkv")
+	if err != nil {
+		t.Fatalf("Error finding sidecars: %v", err)
+	}
+
+	want := []File{
+		{Path: "test_data/subtitles/movie.en.srt", FileName: "movie.en.srt"},
+		{Path: "test_data/subtitles/movie.vtt", FileName: "movie.vtt"},
+	}
+
+	got := []File{}
+	for _, s := range sidecars {
+		got = append(got, s.File)
+	}
+
+	compareFileArrays(t, got, want)
+
+	if len(sidecars) == len(want) && sidecars[0].Language != "en" {
+		t.Errorf("Expected language en but got '%v'", sidecars[0].Language)
+	}
+}
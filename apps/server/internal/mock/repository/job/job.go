@@ -12,6 +12,7 @@ package mock_jobRepository
 import (
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
 	gomock "go.uber.org/mock/gomock"
@@ -55,6 +56,51 @@ func (mr *MockJobRepositoryMockRecorder) CancelInprogress() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInprogress", reflect.TypeOf((*MockJobRepository)(nil).CancelInprogress))
 }
 
+// CancelNotStarted mocks base method.
+func (m *MockJobRepository) CancelNotStarted(id uuid.UUID) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNotStarted", id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelNotStarted indicates an expected call of CancelNotStarted.
+func (mr *MockJobRepositoryMockRecorder) CancelNotStarted(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNotStarted", reflect.TypeOf((*MockJobRepository)(nil).CancelNotStarted), id)
+}
+
+// CancelNotStartedChildren mocks base method.
+func (m *MockJobRepository) CancelNotStartedChildren(id uuid.UUID) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelNotStartedChildren", id)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelNotStartedChildren indicates an expected call of CancelNotStartedChildren.
+func (mr *MockJobRepositoryMockRecorder) CancelNotStartedChildren(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelNotStartedChildren", reflect.TypeOf((*MockJobRepository)(nil).CancelNotStartedChildren), id)
+}
+
+// CountChildrenByStatus mocks base method.
+func (m *MockJobRepository) CountChildrenByStatus(id uuid.UUID) (map[model.JobStatusEnum]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountChildrenByStatus", id)
+	ret0, _ := ret[0].(map[model.JobStatusEnum]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountChildrenByStatus indicates an expected call of CountChildrenByStatus.
+func (mr *MockJobRepositoryMockRecorder) CountChildrenByStatus(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChildrenByStatus", reflect.TypeOf((*MockJobRepository)(nil).CountChildrenByStatus), id)
+}
+
 // CreateAll mocks base method.
 func (m *MockJobRepository) CreateAll(jobs []model.Job) ([]model.Job, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +116,21 @@ func (mr *MockJobRepositoryMockRecorder) CreateAll(jobs any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockJobRepository)(nil).CreateAll), jobs)
 }
 
+// DeleteAll mocks base method.
+func (m *MockJobRepository) DeleteAll(arg0 dto.JobSearchDTO) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", arg0)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAll indicates an expected call of DeleteAll.
+func (mr *MockJobRepositoryMockRecorder) DeleteAll(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockJobRepository)(nil).DeleteAll), arg0)
+}
+
 // GetAll mocks base method.
 func (m *MockJobRepository) GetAll(arg0 dto.JobSearchDTO) (*dto.PageDTO[model.Job], error) {
 	m.ctrl.T.Helper()
@@ -85,31 +146,75 @@ func (mr *MockJobRepositoryMockRecorder) GetAll(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockJobRepository)(nil).GetAll), arg0)
 }
 
+// GetById mocks base method.
+func (m *MockJobRepository) GetById(id uuid.UUID) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockJobRepositoryMockRecorder) GetById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockJobRepository)(nil).GetById), id)
+}
+
 // GetNextJob mocks base method.
-func (m *MockJobRepository) GetNextJob() (*model.Job, error) {
+func (m *MockJobRepository) GetNextJob(jobTypes []model.JobTypeEnum) (*model.Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNextJob")
+	ret := m.ctrl.Call(m, "GetNextJob", jobTypes)
 	ret0, _ := ret[0].(*model.Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNextJob indicates an expected call of GetNextJob.
-func (mr *MockJobRepositoryMockRecorder) GetNextJob() *gomock.Call {
+func (mr *MockJobRepositoryMockRecorder) GetNextJob(jobTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextJob", reflect.TypeOf((*MockJobRepository)(nil).GetNextJob), jobTypes)
+}
+
+// GetTree mocks base method.
+func (m *MockJobRepository) GetTree(id uuid.UUID) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTree", id)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTree indicates an expected call of GetTree.
+func (mr *MockJobRepositoryMockRecorder) GetTree(id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextJob", reflect.TypeOf((*MockJobRepository)(nil).GetNextJob))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTree", reflect.TypeOf((*MockJobRepository)(nil).GetTree), id)
 }
 
 // UpdateJobStatus mocks base method.
-func (m *MockJobRepository) UpdateJobStatus(model *model.Job) error {
+func (m *MockJobRepository) UpdateJobStatus(arg0 *model.Job) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateJobStatus", model)
+	ret := m.ctrl.Call(m, "UpdateJobStatus", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateJobStatus indicates an expected call of UpdateJobStatus.
-func (mr *MockJobRepositoryMockRecorder) UpdateJobStatus(model any) *gomock.Call {
+func (mr *MockJobRepositoryMockRecorder) UpdateJobStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobStatus", reflect.TypeOf((*MockJobRepository)(nil).UpdateJobStatus), arg0)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockJobRepository) UpdateProgress(arg0 *model.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockJobRepositoryMockRecorder) UpdateProgress(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJobStatus", reflect.TypeOf((*MockJobRepository)(nil).UpdateJobStatus), model)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockJobRepository)(nil).UpdateProgress), arg0)
 }
@@ -43,6 +43,20 @@ func (m *MockLibraryRepository) EXPECT() *MockLibraryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddUser mocks base method.
+func (m *MockLibraryRepository) AddUser(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUser", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUser indicates an expected call of AddUser.
+func (mr *MockLibraryRepositoryMockRecorder) AddUser(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockLibraryRepository)(nil).AddUser), id, userId)
+}
+
 // Create mocks base method.
 func (m *MockLibraryRepository) Create(name string) (*model.Library, error) {
 	m.ctrl.T.Helper()
@@ -59,18 +73,18 @@ func (mr *MockLibraryRepositoryMockRecorder) Create(name any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockLibraryRepository) GetAll() ([]model.Library, error) {
+func (m *MockLibraryRepository) GetAll(userId uuid.UUID) ([]model.Library, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", userId)
 	ret0, _ := ret[0].([]model.Library)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockLibraryRepositoryMockRecorder) GetAll() *gomock.Call {
+func (mr *MockLibraryRepositoryMockRecorder) GetAll(userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryRepository)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryRepository)(nil).GetAll), userId)
 }
 
 // GetById mocks base method.
@@ -118,6 +132,35 @@ func (mr *MockLibraryRepositoryMockRecorder) GetMedia(id, userId, search any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockLibraryRepository)(nil).GetMedia), id, userId, search)
 }
 
+// GetUsers mocks base method.
+func (m *MockLibraryRepository) GetUsers(id uuid.UUID) ([]model.LibraryUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", id)
+	ret0, _ := ret[0].([]model.LibraryUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockLibraryRepositoryMockRecorder) GetUsers(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockLibraryRepository)(nil).GetUsers), id)
+}
+
+// RemoveUser mocks base method.
+func (m *MockLibraryRepository) RemoveUser(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUser", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockLibraryRepositoryMockRecorder) RemoveUser(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockLibraryRepository)(nil).RemoveUser), id, userId)
+}
+
 // Update mocks base method.
 func (m_2 *MockLibraryRepository) Update(m model.Library) (*model.Library, error) {
 	m_2.ctrl.T.Helper()
@@ -71,6 +71,21 @@ func (mr *MockLibraryPathRepositoryMockRecorder) GetAll() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryPathRepository)(nil).GetAll))
 }
 
+// GetAllForUser mocks base method.
+func (m *MockLibraryPathRepository) GetAllForUser(userId uuid.UUID) ([]model.LibraryPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllForUser", userId)
+	ret0, _ := ret[0].([]model.LibraryPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllForUser indicates an expected call of GetAllForUser.
+func (mr *MockLibraryPathRepositoryMockRecorder) GetAllForUser(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllForUser", reflect.TypeOf((*MockLibraryPathRepository)(nil).GetAllForUser), userId)
+}
+
 // GetById mocks base method.
 func (m *MockLibraryPathRepository) GetById(id uuid.UUID) (*model.LibraryPath, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +101,21 @@ func (mr *MockLibraryPathRepositoryMockRecorder) GetById(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockLibraryPathRepository)(nil).GetById), id)
 }
 
+// GetByIdForUser mocks base method.
+func (m *MockLibraryPathRepository) GetByIdForUser(id, userId uuid.UUID) (*model.LibraryPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIdForUser", id, userId)
+	ret0, _ := ret[0].(*model.LibraryPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIdForUser indicates an expected call of GetByIdForUser.
+func (mr *MockLibraryPathRepositoryMockRecorder) GetByIdForUser(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdForUser", reflect.TypeOf((*MockLibraryPathRepository)(nil).GetByIdForUser), id, userId)
+}
+
 // GetByLibraryId mocks base method.
 func (m *MockLibraryPathRepository) GetByLibraryId(libraryId uuid.UUID) ([]model.LibraryPath, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +131,21 @@ func (mr *MockLibraryPathRepositoryMockRecorder) GetByLibraryId(libraryId any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLibraryId", reflect.TypeOf((*MockLibraryPathRepository)(nil).GetByLibraryId), libraryId)
 }
 
+// GetByLibraryIdForUser mocks base method.
+func (m *MockLibraryPathRepository) GetByLibraryIdForUser(libraryId, userId uuid.UUID) ([]model.LibraryPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByLibraryIdForUser", libraryId, userId)
+	ret0, _ := ret[0].([]model.LibraryPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByLibraryIdForUser indicates an expected call of GetByLibraryIdForUser.
+func (mr *MockLibraryPathRepositoryMockRecorder) GetByLibraryIdForUser(libraryId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLibraryIdForUser", reflect.TypeOf((*MockLibraryPathRepository)(nil).GetByLibraryIdForUser), libraryId, userId)
+}
+
 // GetContainingPath mocks base method.
 func (m *MockLibraryPathRepository) GetContainingPath(path string) ([]model.LibraryPath, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/server/internal/repository/login/login.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/server/internal/repository/login/login.go
+//
+
+// Package mock_loginRepository is a generated GoMock package.
+package mock_loginRepository
+
+import (
+	reflect "reflect"
+
+	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginRepository is a mock of LoginRepository interface.
+type MockLoginRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginRepositoryMockRecorder is the mock recorder for MockLoginRepository.
+type MockLoginRepositoryMockRecorder struct {
+	mock *MockLoginRepository
+}
+
+// NewMockLoginRepository creates a new mock instance.
+func NewMockLoginRepository(ctrl *gomock.Controller) *MockLoginRepository {
+	mock := &MockLoginRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginRepository) EXPECT() *MockLoginRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFailure mocks base method.
+func (m_2 *MockLoginRepository) CreateFailure(m model.LoginFailure) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateFailure", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFailure indicates an expected call of CreateFailure.
+func (mr *MockLoginRepositoryMockRecorder) CreateFailure(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFailure", reflect.TypeOf((*MockLoginRepository)(nil).CreateFailure), m)
+}
+
+// DeleteThrottles mocks base method.
+func (m *MockLoginRepository) DeleteThrottles(keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteThrottles", keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteThrottles indicates an expected call of DeleteThrottles.
+func (mr *MockLoginRepositoryMockRecorder) DeleteThrottles(keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteThrottles", reflect.TypeOf((*MockLoginRepository)(nil).DeleteThrottles), keys)
+}
+
+// GetThrottles mocks base method.
+func (m *MockLoginRepository) GetThrottles(keys []string) ([]model.LoginThrottle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThrottles", keys)
+	ret0, _ := ret[0].([]model.LoginThrottle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThrottles indicates an expected call of GetThrottles.
+func (mr *MockLoginRepositoryMockRecorder) GetThrottles(keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThrottles", reflect.TypeOf((*MockLoginRepository)(nil).GetThrottles), keys)
+}
+
+// SaveThrottle mocks base method.
+func (m_2 *MockLoginRepository) SaveThrottle(m model.LoginThrottle) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SaveThrottle", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveThrottle indicates an expected call of SaveThrottle.
+func (mr *MockLoginRepositoryMockRecorder) SaveThrottle(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveThrottle", reflect.TypeOf((*MockLoginRepository)(nil).SaveThrottle), m)
+}
@@ -59,6 +59,20 @@ func (mr *MockMediaRepositoryMockRecorder) Create(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMediaRepository)(nil).Create), arg0)
 }
 
+// CreateHistory mocks base method.
+func (m *MockMediaRepository) CreateHistory(h model.WatchHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHistory", h)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHistory indicates an expected call of CreateHistory.
+func (mr *MockMediaRepositoryMockRecorder) CreateHistory(h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHistory", reflect.TypeOf((*MockMediaRepository)(nil).CreateHistory), h)
+}
+
 // Delete mocks base method.
 func (m_2 *MockMediaRepository) Delete(m model.Media) error {
 	m_2.ctrl.T.Helper()
@@ -73,6 +87,20 @@ func (mr *MockMediaRepositoryMockRecorder) Delete(m any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMediaRepository)(nil).Delete), m)
 }
 
+// DeleteRating mocks base method.
+func (m *MockMediaRepository) DeleteRating(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRating", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRating indicates an expected call of DeleteRating.
+func (mr *MockMediaRepositoryMockRecorder) DeleteRating(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockMediaRepository)(nil).DeleteRating), id, userId)
+}
+
 // DeleteRelations mocks base method.
 func (m *MockMediaRepository) DeleteRelations(id uuid.UUID, deleteDto dto.DeleteMediaRelationsDto) error {
 	m.ctrl.T.Helper()
@@ -207,6 +235,36 @@ func (mr *MockMediaRepositoryMockRecorder) GetByPath(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPath", reflect.TypeOf((*MockMediaRepository)(nil).GetByPath), p)
 }
 
+// GetDuplicatesByChecksum mocks base method.
+func (m *MockMediaRepository) GetDuplicatesByChecksum(userId uuid.UUID) ([]models.DuplicateMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDuplicatesByChecksum", userId)
+	ret0, _ := ret[0].([]models.DuplicateMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDuplicatesByChecksum indicates an expected call of GetDuplicatesByChecksum.
+func (mr *MockMediaRepositoryMockRecorder) GetDuplicatesByChecksum(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicatesByChecksum", reflect.TypeOf((*MockMediaRepository)(nil).GetDuplicatesByChecksum), userId)
+}
+
+// GetLatestHistory mocks base method.
+func (m *MockMediaRepository) GetLatestHistory(id, userId uuid.UUID) (*model.WatchHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestHistory", id, userId)
+	ret0, _ := ret[0].(*model.WatchHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestHistory indicates an expected call of GetLatestHistory.
+func (mr *MockMediaRepositoryMockRecorder) GetLatestHistory(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestHistory", reflect.TypeOf((*MockMediaRepository)(nil).GetLatestHistory), id, userId)
+}
+
 // GetProgressForUser mocks base method.
 func (m *MockMediaRepository) GetProgressForUser(id, userId uuid.UUID) (*model.MediaProgress, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +280,36 @@ func (mr *MockMediaRepositoryMockRecorder) GetProgressForUser(id, userId any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgressForUser", reflect.TypeOf((*MockMediaRepository)(nil).GetProgressForUser), id, userId)
 }
 
+// GetSubtitleFor mocks base method.
+func (m *MockMediaRepository) GetSubtitleFor(id, trackId uuid.UUID) (*model.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtitleFor", id, trackId)
+	ret0, _ := ret[0].(*model.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtitleFor indicates an expected call of GetSubtitleFor.
+func (mr *MockMediaRepositoryMockRecorder) GetSubtitleFor(id, trackId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtitleFor", reflect.TypeOf((*MockMediaRepository)(nil).GetSubtitleFor), id, trackId)
+}
+
+// GetSubtitlesFor mocks base method.
+func (m *MockMediaRepository) GetSubtitlesFor(id uuid.UUID) ([]models.MediaRelation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubtitlesFor", id)
+	ret0, _ := ret[0].([]models.MediaRelation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubtitlesFor indicates an expected call of GetSubtitlesFor.
+func (mr *MockMediaRepositoryMockRecorder) GetSubtitlesFor(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubtitlesFor", reflect.TypeOf((*MockMediaRepository)(nil).GetSubtitlesFor), id)
+}
+
 // GetThumbnailFor mocks base method.
 func (m *MockMediaRepository) GetThumbnailFor(id uuid.UUID) (*model.Media, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +325,50 @@ func (mr *MockMediaRepositoryMockRecorder) GetThumbnailFor(id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThumbnailFor", reflect.TypeOf((*MockMediaRepository)(nil).GetThumbnailFor), id)
 }
 
+// GetWithPerceptualHash mocks base method.
+func (m *MockMediaRepository) GetWithPerceptualHash(userId uuid.UUID) ([]models.DuplicateMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithPerceptualHash", userId)
+	ret0, _ := ret[0].([]models.DuplicateMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithPerceptualHash indicates an expected call of GetWithPerceptualHash.
+func (mr *MockMediaRepositoryMockRecorder) GetWithPerceptualHash(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithPerceptualHash", reflect.TypeOf((*MockMediaRepository)(nil).GetWithPerceptualHash), userId)
+}
+
+// HasAccess mocks base method.
+func (m *MockMediaRepository) HasAccess(id, userId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAccess", id, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAccess indicates an expected call of HasAccess.
+func (mr *MockMediaRepositoryMockRecorder) HasAccess(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAccess", reflect.TypeOf((*MockMediaRepository)(nil).HasAccess), id, userId)
+}
+
+// Merge mocks base method.
+func (m *MockMediaRepository) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", keepId, mergeIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockMediaRepositoryMockRecorder) Merge(keepId, mergeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockMediaRepository)(nil).Merge), keepId, mergeIds)
+}
+
 // Relate mocks base method.
 func (m *MockMediaRepository) Relate(arg0 []model.MediaRelation) ([]model.MediaRelation, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +398,20 @@ func (mr *MockMediaRepositoryMockRecorder) RemoveRelation(id, relatedTo any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRelation", reflect.TypeOf((*MockMediaRepository)(nil).RemoveRelation), id, relatedTo)
 }
 
+// ResetProgress mocks base method.
+func (m *MockMediaRepository) ResetProgress(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetProgress", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetProgress indicates an expected call of ResetProgress.
+func (mr *MockMediaRepositoryMockRecorder) ResetProgress(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetProgress", reflect.TypeOf((*MockMediaRepository)(nil).ResetProgress), id, userId)
+}
+
 // Update mocks base method.
 func (m_2 *MockMediaRepository) Update(m model.Media, columns postgres.ColumnList) (*model.Media, error) {
 	m_2.ctrl.T.Helper()
@@ -309,6 +455,20 @@ func (mr *MockMediaRepositoryMockRecorder) UpdateExists(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExists", reflect.TypeOf((*MockMediaRepository)(nil).UpdateExists), arg0)
 }
 
+// UpdateHistory mocks base method.
+func (m *MockMediaRepository) UpdateHistory(h model.WatchHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistory", h)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHistory indicates an expected call of UpdateHistory.
+func (mr *MockMediaRepositoryMockRecorder) UpdateHistory(h any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistory", reflect.TypeOf((*MockMediaRepository)(nil).UpdateHistory), h)
+}
+
 // UpsertProgress mocks base method.
 func (m *MockMediaRepository) UpsertProgress(prog model.MediaProgress) (*model.MediaProgress, error) {
 	m.ctrl.T.Helper()
@@ -323,3 +483,33 @@ func (mr *MockMediaRepositoryMockRecorder) UpsertProgress(prog any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProgress", reflect.TypeOf((*MockMediaRepository)(nil).UpsertProgress), prog)
 }
+
+// UpsertRating mocks base method.
+func (m_2 *MockMediaRepository) UpsertRating(m model.MediaRating) (*model.MediaRating, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpsertRating", m)
+	ret0, _ := ret[0].(*model.MediaRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRating indicates an expected call of UpsertRating.
+func (mr *MockMediaRepositoryMockRecorder) UpsertRating(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRating", reflect.TypeOf((*MockMediaRepository)(nil).UpsertRating), m)
+}
+
+// UpsertWatched mocks base method.
+func (m *MockMediaRepository) UpsertWatched(prog model.MediaProgress) (*model.MediaProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWatched", prog)
+	ret0, _ := ret[0].(*model.MediaProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWatched indicates an expected call of UpsertWatched.
+func (mr *MockMediaRepositoryMockRecorder) UpsertWatched(prog any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWatched", reflect.TypeOf((*MockMediaRepository)(nil).UpsertWatched), prog)
+}
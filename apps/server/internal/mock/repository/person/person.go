@@ -43,6 +43,20 @@ func (m *MockPersonRepository) EXPECT() *MockPersonRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddFavourite mocks base method.
+func (m *MockPersonRepository) AddFavourite(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavourite", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavourite indicates an expected call of AddFavourite.
+func (mr *MockPersonRepositoryMockRecorder) AddFavourite(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockPersonRepository)(nil).AddFavourite), id, userId)
+}
+
 // AddToMedia mocks base method.
 func (m *MockPersonRepository) AddToMedia(mediaPeople []model.MediaPerson) ([]model.MediaPerson, error) {
 	m.ctrl.T.Helper()
@@ -73,6 +87,21 @@ func (mr *MockPersonRepositoryMockRecorder) Create(names any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPersonRepository)(nil).Create), names)
 }
 
+// CreateAlias mocks base method.
+func (m_2 *MockPersonRepository) CreateAlias(m model.PersonAlias) (*model.PersonAlias, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateAlias", m)
+	ret0, _ := ret[0].(*model.PersonAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlias indicates an expected call of CreateAlias.
+func (mr *MockPersonRepositoryMockRecorder) CreateAlias(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlias", reflect.TypeOf((*MockPersonRepository)(nil).CreateAlias), m)
+}
+
 // Delete mocks base method.
 func (m *MockPersonRepository) Delete(id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -87,19 +116,78 @@ func (mr *MockPersonRepositoryMockRecorder) Delete(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPersonRepository)(nil).Delete), id)
 }
 
+// DeleteAlias mocks base method.
+func (m *MockPersonRepository) DeleteAlias(id, aliasId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlias", id, aliasId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlias indicates an expected call of DeleteAlias.
+func (mr *MockPersonRepositoryMockRecorder) DeleteAlias(id, aliasId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockPersonRepository)(nil).DeleteAlias), id, aliasId)
+}
+
+// DeleteRating mocks base method.
+func (m *MockPersonRepository) DeleteRating(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRating", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRating indicates an expected call of DeleteRating.
+func (mr *MockPersonRepositoryMockRecorder) DeleteRating(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockPersonRepository)(nil).DeleteRating), id, userId)
+}
+
+// GetAliases mocks base method.
+func (m *MockPersonRepository) GetAliases(id uuid.UUID) ([]model.PersonAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliases", id)
+	ret0, _ := ret[0].([]model.PersonAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliases indicates an expected call of GetAliases.
+func (mr *MockPersonRepositoryMockRecorder) GetAliases(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliases", reflect.TypeOf((*MockPersonRepository)(nil).GetAliases), id)
+}
+
 // GetAll mocks base method.
-func (m *MockPersonRepository) GetAll(search dto.PersonSearchDTO) ([]model.Person, error) {
+func (m *MockPersonRepository) GetAll(userId uuid.UUID, search dto.PersonSearchDTO) ([]models.Person, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", search)
-	ret0, _ := ret[0].([]model.Person)
+	ret := m.ctrl.Call(m, "GetAll", userId, search)
+	ret0, _ := ret[0].([]models.Person)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockPersonRepositoryMockRecorder) GetAll(search any) *gomock.Call {
+func (mr *MockPersonRepositoryMockRecorder) GetAll(userId, search any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPersonRepository)(nil).GetAll), search)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPersonRepository)(nil).GetAll), userId, search)
+}
+
+// GetByAlias mocks base method.
+func (m *MockPersonRepository) GetByAlias(alias string) (*model.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAlias", alias)
+	ret0, _ := ret[0].(*model.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAlias indicates an expected call of GetByAlias.
+func (mr *MockPersonRepositoryMockRecorder) GetByAlias(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAlias", reflect.TypeOf((*MockPersonRepository)(nil).GetByAlias), alias)
 }
 
 // GetById mocks base method.
@@ -147,6 +235,35 @@ func (mr *MockPersonRepositoryMockRecorder) GetMedia(id, userId, search any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockPersonRepository)(nil).GetMedia), id, userId, search)
 }
 
+// Merge mocks base method.
+func (m *MockPersonRepository) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", keepId, mergeIds)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockPersonRepositoryMockRecorder) Merge(keepId, mergeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockPersonRepository)(nil).Merge), keepId, mergeIds)
+}
+
+// RemoveFavourite mocks base method.
+func (m *MockPersonRepository) RemoveFavourite(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavourite", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavourite indicates an expected call of RemoveFavourite.
+func (mr *MockPersonRepositoryMockRecorder) RemoveFavourite(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockPersonRepository)(nil).RemoveFavourite), id, userId)
+}
+
 // RemoveFromMedia mocks base method.
 func (m *MockPersonRepository) RemoveFromMedia(mediaPerson model.MediaPerson) error {
 	m.ctrl.T.Helper()
@@ -175,3 +292,18 @@ func (mr *MockPersonRepositoryMockRecorder) Update(m any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPersonRepository)(nil).Update), m)
 }
+
+// UpsertRating mocks base method.
+func (m_2 *MockPersonRepository) UpsertRating(m model.PersonRating) (*model.PersonRating, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpsertRating", m)
+	ret0, _ := ret[0].(*model.PersonRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRating indicates an expected call of UpsertRating.
+func (mr *MockPersonRepositoryMockRecorder) UpsertRating(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRating", reflect.TypeOf((*MockPersonRepository)(nil).UpsertRating), m)
+}
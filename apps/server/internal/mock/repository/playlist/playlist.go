@@ -0,0 +1,251 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/server/internal/repository/playlist/playlist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/server/internal/repository/playlist/playlist.go
+//
+
+// Package mock_playlistRepository is a generated GoMock package.
+package mock_playlistRepository
+
+import (
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
+	models "github.com/slugger7/exorcist/apps/server/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlaylistRepository is a mock of PlaylistRepository interface.
+type MockPlaylistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaylistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPlaylistRepositoryMockRecorder is the mock recorder for MockPlaylistRepository.
+type MockPlaylistRepositoryMockRecorder struct {
+	mock *MockPlaylistRepository
+}
+
+// NewMockPlaylistRepository creates a new mock instance.
+func NewMockPlaylistRepository(ctrl *gomock.Controller) *MockPlaylistRepository {
+	mock := &MockPlaylistRepository{ctrl: ctrl}
+	mock.recorder = &MockPlaylistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaylistRepository) EXPECT() *MockPlaylistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMedia mocks base method.
+func (m *MockPlaylistRepository) AddMedia(id uuid.UUID, mediaIds []uuid.UUID) ([]model.PlaylistMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMedia", id, mediaIds)
+	ret0, _ := ret[0].([]model.PlaylistMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMedia indicates an expected call of AddMedia.
+func (mr *MockPlaylistRepositoryMockRecorder) AddMedia(id, mediaIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMedia", reflect.TypeOf((*MockPlaylistRepository)(nil).AddMedia), id, mediaIds)
+}
+
+// AddUser mocks base method.
+func (m *MockPlaylistRepository) AddUser(id, userId uuid.UUID, access model.PlaylistAccessEnum) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUser", id, userId, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUser indicates an expected call of AddUser.
+func (mr *MockPlaylistRepositoryMockRecorder) AddUser(id, userId, access any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockPlaylistRepository)(nil).AddUser), id, userId, access)
+}
+
+// CreateAll mocks base method.
+func (m *MockPlaylistRepository) CreateAll(playlists []model.Playlist) ([]model.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", playlists)
+	ret0, _ := ret[0].([]model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockPlaylistRepositoryMockRecorder) CreateAll(playlists any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockPlaylistRepository)(nil).CreateAll), playlists)
+}
+
+// Delete mocks base method.
+func (m *MockPlaylistRepository) Delete(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPlaylistRepositoryMockRecorder) Delete(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPlaylistRepository)(nil).Delete), id)
+}
+
+// GetAll mocks base method.
+func (m *MockPlaylistRepository) GetAll(userId uuid.UUID) ([]model.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userId)
+	ret0, _ := ret[0].([]model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPlaylistRepositoryMockRecorder) GetAll(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPlaylistRepository)(nil).GetAll), userId)
+}
+
+// GetById mocks base method.
+func (m *MockPlaylistRepository) GetById(id uuid.UUID) (*model.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", id)
+	ret0, _ := ret[0].(*model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockPlaylistRepositoryMockRecorder) GetById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockPlaylistRepository)(nil).GetById), id)
+}
+
+// GetMedia mocks base method.
+func (m *MockPlaylistRepository) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMedia", id, userId, search)
+	ret0, _ := ret[0].(*dto.PageDTO[models.MediaOverviewModel])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMedia indicates an expected call of GetMedia.
+func (mr *MockPlaylistRepositoryMockRecorder) GetMedia(id, userId, search any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockPlaylistRepository)(nil).GetMedia), id, userId, search)
+}
+
+// GetShared mocks base method.
+func (m *MockPlaylistRepository) GetShared(userId uuid.UUID) ([]models.SharedPlaylist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShared", userId)
+	ret0, _ := ret[0].([]models.SharedPlaylist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShared indicates an expected call of GetShared.
+func (mr *MockPlaylistRepositoryMockRecorder) GetShared(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShared", reflect.TypeOf((*MockPlaylistRepository)(nil).GetShared), userId)
+}
+
+// GetUser mocks base method.
+func (m *MockPlaylistRepository) GetUser(id, userId uuid.UUID) (*model.PlaylistUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", id, userId)
+	ret0, _ := ret[0].(*model.PlaylistUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockPlaylistRepositoryMockRecorder) GetUser(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockPlaylistRepository)(nil).GetUser), id, userId)
+}
+
+// GetUsers mocks base method.
+func (m *MockPlaylistRepository) GetUsers(id uuid.UUID) ([]model.PlaylistUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", id)
+	ret0, _ := ret[0].([]model.PlaylistUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockPlaylistRepositoryMockRecorder) GetUsers(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockPlaylistRepository)(nil).GetUsers), id)
+}
+
+// MoveMedia mocks base method.
+func (m *MockPlaylistRepository) MoveMedia(id, mediaId uuid.UUID, position int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMedia", id, mediaId, position)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMedia indicates an expected call of MoveMedia.
+func (mr *MockPlaylistRepositoryMockRecorder) MoveMedia(id, mediaId, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMedia", reflect.TypeOf((*MockPlaylistRepository)(nil).MoveMedia), id, mediaId, position)
+}
+
+// RemoveMedia mocks base method.
+func (m *MockPlaylistRepository) RemoveMedia(id, mediaId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMedia", id, mediaId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMedia indicates an expected call of RemoveMedia.
+func (mr *MockPlaylistRepositoryMockRecorder) RemoveMedia(id, mediaId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMedia", reflect.TypeOf((*MockPlaylistRepository)(nil).RemoveMedia), id, mediaId)
+}
+
+// RemoveUser mocks base method.
+func (m *MockPlaylistRepository) RemoveUser(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUser", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockPlaylistRepositoryMockRecorder) RemoveUser(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockPlaylistRepository)(nil).RemoveUser), id, userId)
+}
+
+// Update mocks base method.
+func (m_2 *MockPlaylistRepository) Update(m model.Playlist) (*model.Playlist, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", m)
+	ret0, _ := ret[0].(*model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPlaylistRepositoryMockRecorder) Update(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPlaylistRepository)(nil).Update), m)
+}
@@ -12,13 +12,17 @@ package mock_repository
 import (
 	reflect "reflect"
 
+	apiTokenRepository "github.com/slugger7/exorcist/apps/server/internal/repository/api_token"
 	imageRepository "github.com/slugger7/exorcist/apps/server/internal/repository/image"
 	jobRepository "github.com/slugger7/exorcist/apps/server/internal/repository/job"
+	jobScheduleRepository "github.com/slugger7/exorcist/apps/server/internal/repository/job_schedule"
 	libraryRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library"
 	libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library_path"
+	loginRepository "github.com/slugger7/exorcist/apps/server/internal/repository/login"
 	mediaRepository "github.com/slugger7/exorcist/apps/server/internal/repository/media"
 	personRepository "github.com/slugger7/exorcist/apps/server/internal/repository/person"
 	playlistRepository "github.com/slugger7/exorcist/apps/server/internal/repository/playlist"
+	sessionRepository "github.com/slugger7/exorcist/apps/server/internal/repository/session"
 	tagRepository "github.com/slugger7/exorcist/apps/server/internal/repository/tag"
 	userRepository "github.com/slugger7/exorcist/apps/server/internal/repository/user"
 	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
@@ -49,6 +53,20 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApiToken mocks base method.
+func (m *MockRepository) ApiToken() apiTokenRepository.ApiTokenRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApiToken")
+	ret0, _ := ret[0].(apiTokenRepository.ApiTokenRepository)
+	return ret0
+}
+
+// ApiToken indicates an expected call of ApiToken.
+func (mr *MockRepositoryMockRecorder) ApiToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApiToken", reflect.TypeOf((*MockRepository)(nil).ApiToken))
+}
+
 // Close mocks base method.
 func (m *MockRepository) Close() error {
 	m.ctrl.T.Helper()
@@ -105,6 +123,20 @@ func (mr *MockRepositoryMockRecorder) Job() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockRepository)(nil).Job))
 }
 
+// JobSchedule mocks base method.
+func (m *MockRepository) JobSchedule() jobScheduleRepository.JobScheduleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobSchedule")
+	ret0, _ := ret[0].(jobScheduleRepository.JobScheduleRepository)
+	return ret0
+}
+
+// JobSchedule indicates an expected call of JobSchedule.
+func (mr *MockRepositoryMockRecorder) JobSchedule() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobSchedule", reflect.TypeOf((*MockRepository)(nil).JobSchedule))
+}
+
 // Library mocks base method.
 func (m *MockRepository) Library() libraryRepository.LibraryRepository {
 	m.ctrl.T.Helper()
@@ -133,6 +165,20 @@ func (mr *MockRepositoryMockRecorder) LibraryPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LibraryPath", reflect.TypeOf((*MockRepository)(nil).LibraryPath))
 }
 
+// Login mocks base method.
+func (m *MockRepository) Login() loginRepository.LoginRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login")
+	ret0, _ := ret[0].(loginRepository.LoginRepository)
+	return ret0
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockRepositoryMockRecorder) Login() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockRepository)(nil).Login))
+}
+
 // Media mocks base method.
 func (m *MockRepository) Media() mediaRepository.MediaRepository {
 	m.ctrl.T.Helper()
@@ -175,6 +221,20 @@ func (mr *MockRepositoryMockRecorder) Playlist() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Playlist", reflect.TypeOf((*MockRepository)(nil).Playlist))
 }
 
+// Session mocks base method.
+func (m *MockRepository) Session() sessionRepository.SessionRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Session")
+	ret0, _ := ret[0].(sessionRepository.SessionRepository)
+	return ret0
+}
+
+// Session indicates an expected call of Session.
+func (mr *MockRepositoryMockRecorder) Session() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockRepository)(nil).Session))
+}
+
 // Tag mocks base method.
 func (m *MockRepository) Tag() tagRepository.TagRepository {
 	m.ctrl.T.Helper()
@@ -73,6 +73,21 @@ func (mr *MockTagRepositoryMockRecorder) Create(names any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTagRepository)(nil).Create), names)
 }
 
+// CreateAlias mocks base method.
+func (m_2 *MockTagRepository) CreateAlias(m model.TagAlias) (*model.TagAlias, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateAlias", m)
+	ret0, _ := ret[0].(*model.TagAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlias indicates an expected call of CreateAlias.
+func (mr *MockTagRepositoryMockRecorder) CreateAlias(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlias", reflect.TypeOf((*MockTagRepository)(nil).CreateAlias), m)
+}
+
 // Delete mocks base method.
 func (m *MockTagRepository) Delete(id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -87,6 +102,36 @@ func (mr *MockTagRepositoryMockRecorder) Delete(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagRepository)(nil).Delete), id)
 }
 
+// DeleteAlias mocks base method.
+func (m *MockTagRepository) DeleteAlias(id, aliasId uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlias", id, aliasId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlias indicates an expected call of DeleteAlias.
+func (mr *MockTagRepositoryMockRecorder) DeleteAlias(id, aliasId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockTagRepository)(nil).DeleteAlias), id, aliasId)
+}
+
+// GetAliases mocks base method.
+func (m *MockTagRepository) GetAliases(id uuid.UUID) ([]model.TagAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliases", id)
+	ret0, _ := ret[0].([]model.TagAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliases indicates an expected call of GetAliases.
+func (mr *MockTagRepositoryMockRecorder) GetAliases(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliases", reflect.TypeOf((*MockTagRepository)(nil).GetAliases), id)
+}
+
 // GetAll mocks base method.
 func (m *MockTagRepository) GetAll(search dto.TagSearchDTO) ([]model.Tag, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +147,21 @@ func (mr *MockTagRepositoryMockRecorder) GetAll(search any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTagRepository)(nil).GetAll), search)
 }
 
+// GetByAlias mocks base method.
+func (m *MockTagRepository) GetByAlias(alias string) (*model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAlias", alias)
+	ret0, _ := ret[0].(*model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAlias indicates an expected call of GetByAlias.
+func (mr *MockTagRepositoryMockRecorder) GetByAlias(alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAlias", reflect.TypeOf((*MockTagRepository)(nil).GetByAlias), alias)
+}
+
 // GetById mocks base method.
 func (m *MockTagRepository) GetById(id uuid.UUID) (*model.Tag, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +207,21 @@ func (mr *MockTagRepositoryMockRecorder) GetMedia(id, userId, search any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockTagRepository)(nil).GetMedia), id, userId, search)
 }
 
+// Merge mocks base method.
+func (m *MockTagRepository) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", keepId, mergeIds)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockTagRepositoryMockRecorder) Merge(keepId, mergeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagRepository)(nil).Merge), keepId, mergeIds)
+}
+
 // RemoveFromMedia mocks base method.
 func (m *MockTagRepository) RemoveFromMedia(mediaTag model.MediaTag) error {
 	m.ctrl.T.Helper()
@@ -71,6 +71,36 @@ func (mr *MockUserRepositoryMockRecorder) CreateUser(user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), user)
 }
 
+// Delete mocks base method.
+func (m *MockUserRepository) Delete(id uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserRepositoryMockRecorder) Delete(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), id)
+}
+
+// GetAll mocks base method.
+func (m *MockUserRepository) GetAll() ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockUserRepositoryMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockUserRepository)(nil).GetAll))
+}
+
 // GetById mocks base method.
 func (m *MockUserRepository) GetById(id uuid.UUID) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +166,21 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByUsernameAndPassword(username,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsernameAndPassword", reflect.TypeOf((*MockUserRepository)(nil).GetUserByUsernameAndPassword), username, password)
 }
 
+// IsUsernameTaken mocks base method.
+func (m *MockUserRepository) IsUsernameTaken(username string, except uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUsernameTaken", username, except)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUsernameTaken indicates an expected call of IsUsernameTaken.
+func (mr *MockUserRepositoryMockRecorder) IsUsernameTaken(username, except any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsernameTaken", reflect.TypeOf((*MockUserRepository)(nil).IsUsernameTaken), username, except)
+}
+
 // RemoveFavourite mocks base method.
 func (m *MockUserRepository) RemoveFavourite(id, mediaId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -150,6 +195,20 @@ func (mr *MockUserRepositoryMockRecorder) RemoveFavourite(id, mediaId any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockUserRepository)(nil).RemoveFavourite), id, mediaId)
 }
 
+// Update mocks base method.
+func (m *MockUserRepository) Update(user model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserRepositoryMockRecorder) Update(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), user)
+}
+
 // UpdatePassword mocks base method.
 func (m *MockUserRepository) UpdatePassword(user *model.User) error {
 	m.ctrl.T.Helper()
@@ -12,8 +12,10 @@ package mock_videoRepository
 import (
 	reflect "reflect"
 
+	postgres "github.com/go-jet/jet/v2/postgres"
 	uuid "github.com/google/uuid"
 	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
 	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -72,6 +74,21 @@ func (mr *MockVideoRepositoryMockRecorder) GetByIdWithMedia(id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdWithMedia", reflect.TypeOf((*MockVideoRepository)(nil).GetByIdWithMedia), id)
 }
 
+// GetByLibraryId mocks base method.
+func (m *MockVideoRepository) GetByLibraryId(libraryId uuid.UUID, pageRequest *dto.PageRequestDTO) (*dto.PageDTO[model.Video], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByLibraryId", libraryId, pageRequest)
+	ret0, _ := ret[0].(*dto.PageDTO[model.Video])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByLibraryId indicates an expected call of GetByLibraryId.
+func (mr *MockVideoRepositoryMockRecorder) GetByLibraryId(libraryId, pageRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLibraryId", reflect.TypeOf((*MockVideoRepository)(nil).GetByLibraryId), libraryId, pageRequest)
+}
+
 // GetByMediaId mocks base method.
 func (m *MockVideoRepository) GetByMediaId(id uuid.UUID) (*videoRepository.MediaVideoModel, error) {
 	m.ctrl.T.Helper()
@@ -101,3 +118,18 @@ func (mr *MockVideoRepositoryMockRecorder) Insert(models any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockVideoRepository)(nil).Insert), models)
 }
+
+// Update mocks base method.
+func (m_2 *MockVideoRepository) Update(m model.Video, columns postgres.ColumnList) (*model.Video, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", m, columns)
+	ret0, _ := ret[0].(*model.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVideoRepositoryMockRecorder) Update(m, columns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVideoRepository)(nil).Update), m, columns)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/server/internal/service/hls/hls.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/server/internal/service/hls/hls.go
+//
+
+// Package mock_hlsService is a generated GoMock package.
+package mock_hlsService
+
+import (
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHlsService is a mock of HlsService interface.
+type MockHlsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHlsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockHlsServiceMockRecorder is the mock recorder for MockHlsService.
+type MockHlsServiceMockRecorder struct {
+	mock *MockHlsService
+}
+
+// NewMockHlsService creates a new mock instance.
+func NewMockHlsService(ctrl *gomock.Controller) *MockHlsService {
+	mock := &MockHlsService{ctrl: ctrl}
+	mock.recorder = &MockHlsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHlsService) EXPECT() *MockHlsServiceMockRecorder {
+	return m.recorder
+}
+
+// MasterPlaylist mocks base method.
+func (m *MockHlsService) MasterPlaylist(mediaId uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MasterPlaylist", mediaId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MasterPlaylist indicates an expected call of MasterPlaylist.
+func (mr *MockHlsServiceMockRecorder) MasterPlaylist(mediaId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MasterPlaylist", reflect.TypeOf((*MockHlsService)(nil).MasterPlaylist), mediaId)
+}
+
+// RenditionPlaylist mocks base method.
+func (m *MockHlsService) RenditionPlaylist(mediaId uuid.UUID, rendition string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenditionPlaylist", mediaId, rendition)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenditionPlaylist indicates an expected call of RenditionPlaylist.
+func (mr *MockHlsServiceMockRecorder) RenditionPlaylist(mediaId, rendition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenditionPlaylist", reflect.TypeOf((*MockHlsService)(nil).RenditionPlaylist), mediaId, rendition)
+}
+
+// Segment mocks base method.
+func (m *MockHlsService) Segment(mediaId uuid.UUID, rendition string, index int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Segment", mediaId, rendition, index)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Segment indicates an expected call of Segment.
+func (mr *MockHlsServiceMockRecorder) Segment(mediaId, rendition, index any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Segment", reflect.TypeOf((*MockHlsService)(nil).Segment), mediaId, rendition, index)
+}
@@ -0,0 +1,175 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/server/internal/service/job/job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/server/internal/service/job/job.go
+//
+
+// Package mock_jobService is a generated GoMock package.
+package mock_jobService
+
+import (
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobService is a mock of JobService interface.
+type MockJobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockJobServiceMockRecorder is the mock recorder for MockJobService.
+type MockJobServiceMockRecorder struct {
+	mock *MockJobService
+}
+
+// NewMockJobService creates a new mock instance.
+func NewMockJobService(ctrl *gomock.Controller) *MockJobService {
+	mock := &MockJobService{ctrl: ctrl}
+	mock.recorder = &MockJobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobService) EXPECT() *MockJobServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockJobService) Cancel(id uuid.UUID) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockJobServiceMockRecorder) Cancel(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockJobService)(nil).Cancel), id)
+}
+
+// Create mocks base method.
+func (m *MockJobService) Create(arg0 dto.CreateJobDTO) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockJobServiceMockRecorder) Create(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockJobService)(nil).Create), arg0)
+}
+
+// CreateSchedule mocks base method.
+func (m *MockJobService) CreateSchedule(arg0 dto.CreateJobScheduleDTO) (*model.JobSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", arg0)
+	ret0, _ := ret[0].(*model.JobSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockJobServiceMockRecorder) CreateSchedule(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockJobService)(nil).CreateSchedule), arg0)
+}
+
+// Delete mocks base method.
+func (m *MockJobService) Delete(arg0 dto.JobSearchDTO) ([]model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].([]model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockJobServiceMockRecorder) Delete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockJobService)(nil).Delete), arg0)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockJobService) DeleteSchedule(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockJobServiceMockRecorder) DeleteSchedule(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockJobService)(nil).DeleteSchedule), id)
+}
+
+// Retry mocks base method.
+func (m *MockJobService) Retry(id uuid.UUID) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", id)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockJobServiceMockRecorder) Retry(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockJobService)(nil).Retry), id)
+}
+
+// RunSchedule mocks base method.
+func (m *MockJobService) RunSchedule(schedule model.JobSchedule, now time.Time) (*model.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunSchedule", schedule, now)
+	ret0, _ := ret[0].(*model.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunSchedule indicates an expected call of RunSchedule.
+func (mr *MockJobServiceMockRecorder) RunSchedule(schedule, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSchedule", reflect.TypeOf((*MockJobService)(nil).RunSchedule), schedule, now)
+}
+
+// StartJobRunner mocks base method.
+func (m *MockJobService) StartJobRunner() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartJobRunner")
+}
+
+// StartJobRunner indicates an expected call of StartJobRunner.
+func (mr *MockJobServiceMockRecorder) StartJobRunner() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJobRunner", reflect.TypeOf((*MockJobService)(nil).StartJobRunner))
+}
+
+// UpdateSchedule mocks base method.
+func (m_2 *MockJobService) UpdateSchedule(id uuid.UUID, m dto.CreateJobScheduleDTO) (*model.JobSchedule, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateSchedule", id, m)
+	ret0, _ := ret[0].(*model.JobSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockJobServiceMockRecorder) UpdateSchedule(id, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockJobService)(nil).UpdateSchedule), id, m)
+}
@@ -59,18 +59,18 @@ func (mr *MockLibraryServiceMockRecorder) Create(newLibrary any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockLibraryService) GetAll() ([]model.Library, error) {
+func (m *MockLibraryService) GetAll(userId uuid.UUID) ([]model.Library, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", userId)
 	ret0, _ := ret[0].([]model.Library)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockLibraryServiceMockRecorder) GetAll() *gomock.Call {
+func (mr *MockLibraryServiceMockRecorder) GetAll(userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryService)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryService)(nil).GetAll), userId)
 }
 
 // GetMedia mocks base method.
@@ -87,3 +87,46 @@ func (mr *MockLibraryServiceMockRecorder) GetMedia(id, userId, search any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockLibraryService)(nil).GetMedia), id, userId, search)
 }
+
+// GetUsers mocks base method.
+func (m *MockLibraryService) GetUsers(id uuid.UUID) ([]model.LibraryUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", id)
+	ret0, _ := ret[0].([]model.LibraryUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockLibraryServiceMockRecorder) GetUsers(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockLibraryService)(nil).GetUsers), id)
+}
+
+// GrantAccess mocks base method.
+func (m *MockLibraryService) GrantAccess(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccess", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockLibraryServiceMockRecorder) GrantAccess(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockLibraryService)(nil).GrantAccess), id, userId)
+}
+
+// RevokeAccess mocks base method.
+func (m *MockLibraryService) RevokeAccess(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccess", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockLibraryServiceMockRecorder) RevokeAccess(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockLibraryService)(nil).RevokeAccess), id, userId)
+}
@@ -12,6 +12,7 @@ package mock_libraryPathService
 import (
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,16 +57,16 @@ func (mr *MockLibraryPathServiceMockRecorder) Create(m any) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockLibraryPathService) GetAll() ([]model.LibraryPath, error) {
+func (m *MockLibraryPathService) GetAll(userId uuid.UUID) ([]model.LibraryPath, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", userId)
 	ret0, _ := ret[0].([]model.LibraryPath)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockLibraryPathServiceMockRecorder) GetAll() *gomock.Call {
+func (mr *MockLibraryPathServiceMockRecorder) GetAll(userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryPathService)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockLibraryPathService)(nil).GetAll), userId)
 }
@@ -15,6 +15,7 @@ import (
 	uuid "github.com/google/uuid"
 	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
+	models "github.com/slugger7/exorcist/apps/server/internal/models"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -72,6 +73,21 @@ func (mr *MockMediaServiceMockRecorder) AddTag(id, tagId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockMediaService)(nil).AddTag), id, tagId)
 }
 
+// ContinueWatching mocks base method.
+func (m *MockMediaService) ContinueWatching(userId uuid.UUID, page dto.PageRequestDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContinueWatching", userId, page)
+	ret0, _ := ret[0].(*dto.PageDTO[models.MediaOverviewModel])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContinueWatching indicates an expected call of ContinueWatching.
+func (mr *MockMediaServiceMockRecorder) ContinueWatching(userId, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContinueWatching", reflect.TypeOf((*MockMediaService)(nil).ContinueWatching), userId, page)
+}
+
 // CopyPeople mocks base method.
 func (m *MockMediaService) CopyPeople(toId, fromId uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -128,6 +144,36 @@ func (mr *MockMediaServiceMockRecorder) DeleteRelations(id, deleteDto any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelations", reflect.TypeOf((*MockMediaService)(nil).DeleteRelations), id, deleteDto)
 }
 
+// DuplicatesByChecksum mocks base method.
+func (m *MockMediaService) DuplicatesByChecksum(userId uuid.UUID) ([][]models.DuplicateMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicatesByChecksum", userId)
+	ret0, _ := ret[0].([][]models.DuplicateMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicatesByChecksum indicates an expected call of DuplicatesByChecksum.
+func (mr *MockMediaServiceMockRecorder) DuplicatesByChecksum(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicatesByChecksum", reflect.TypeOf((*MockMediaService)(nil).DuplicatesByChecksum), userId)
+}
+
+// DuplicatesByPerceptualHash mocks base method.
+func (m *MockMediaService) DuplicatesByPerceptualHash(userId uuid.UUID, threshold int) ([][]models.DuplicateMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicatesByPerceptualHash", userId, threshold)
+	ret0, _ := ret[0].([][]models.DuplicateMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicatesByPerceptualHash indicates an expected call of DuplicatesByPerceptualHash.
+func (mr *MockMediaServiceMockRecorder) DuplicatesByPerceptualHash(userId, threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicatesByPerceptualHash", reflect.TypeOf((*MockMediaService)(nil).DuplicatesByPerceptualHash), userId, threshold)
+}
+
 // LogProgress mocks base method.
 func (m *MockMediaService) LogProgress(id, userId uuid.UUID, progress dto.ProgressUpdateDTO) (*model.MediaProgress, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +189,64 @@ func (mr *MockMediaServiceMockRecorder) LogProgress(id, userId, progress any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogProgress", reflect.TypeOf((*MockMediaService)(nil).LogProgress), id, userId, progress)
 }
 
+// MarkUnwatched mocks base method.
+func (m *MockMediaService) MarkUnwatched(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUnwatched", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUnwatched indicates an expected call of MarkUnwatched.
+func (mr *MockMediaServiceMockRecorder) MarkUnwatched(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUnwatched", reflect.TypeOf((*MockMediaService)(nil).MarkUnwatched), id, userId)
+}
+
+// MarkWatched mocks base method.
+func (m *MockMediaService) MarkWatched(id, userId uuid.UUID) (*model.MediaProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkWatched", id, userId)
+	ret0, _ := ret[0].(*model.MediaProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkWatched indicates an expected call of MarkWatched.
+func (mr *MockMediaServiceMockRecorder) MarkWatched(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkWatched", reflect.TypeOf((*MockMediaService)(nil).MarkWatched), id, userId)
+}
+
+// Merge mocks base method.
+func (m *MockMediaService) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", keepId, mergeIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockMediaServiceMockRecorder) Merge(keepId, mergeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockMediaService)(nil).Merge), keepId, mergeIds)
+}
+
+// Rate mocks base method.
+func (m *MockMediaService) Rate(id, userId uuid.UUID, rating int16) (*model.MediaRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rate", id, userId, rating)
+	ret0, _ := ret[0].(*model.MediaRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rate indicates an expected call of Rate.
+func (mr *MockMediaServiceMockRecorder) Rate(id, userId, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rate", reflect.TypeOf((*MockMediaService)(nil).Rate), id, userId, rating)
+}
+
 // Relate mocks base method.
 func (m *MockMediaService) Relate(id uuid.UUID, relateDto dto.PutMediaRelationDto) ([]model.MediaRelation, error) {
 	m.ctrl.T.Helper()
@@ -157,3 +261,17 @@ func (mr *MockMediaServiceMockRecorder) Relate(id, relateDto any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relate", reflect.TypeOf((*MockMediaService)(nil).Relate), id, relateDto)
 }
+
+// Unrate mocks base method.
+func (m *MockMediaService) Unrate(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unrate", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unrate indicates an expected call of Unrate.
+func (mr *MockMediaServiceMockRecorder) Unrate(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unrate", reflect.TypeOf((*MockMediaService)(nil).Unrate), id, userId)
+}
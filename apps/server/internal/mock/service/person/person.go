@@ -43,6 +43,35 @@ func (m *MockPersonService) EXPECT() *MockPersonServiceMockRecorder {
 	return m.recorder
 }
 
+// AddAlias mocks base method.
+func (m *MockPersonService) AddAlias(id uuid.UUID, alias string) (*model.PersonAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAlias", id, alias)
+	ret0, _ := ret[0].(*model.PersonAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAlias indicates an expected call of AddAlias.
+func (mr *MockPersonServiceMockRecorder) AddAlias(id, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAlias", reflect.TypeOf((*MockPersonService)(nil).AddAlias), id, alias)
+}
+
+// AddFavourite mocks base method.
+func (m *MockPersonService) AddFavourite(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavourite", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavourite indicates an expected call of AddFavourite.
+func (mr *MockPersonServiceMockRecorder) AddFavourite(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavourite", reflect.TypeOf((*MockPersonService)(nil).AddFavourite), id, userId)
+}
+
 // Delete mocks base method.
 func (m *MockPersonService) Delete(id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -57,6 +86,21 @@ func (mr *MockPersonServiceMockRecorder) Delete(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPersonService)(nil).Delete), id)
 }
 
+// GetAliases mocks base method.
+func (m *MockPersonService) GetAliases(id uuid.UUID) ([]model.PersonAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliases", id)
+	ret0, _ := ret[0].([]model.PersonAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliases indicates an expected call of GetAliases.
+func (mr *MockPersonServiceMockRecorder) GetAliases(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliases", reflect.TypeOf((*MockPersonService)(nil).GetAliases), id)
+}
+
 // GetMedia mocks base method.
 func (m *MockPersonService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
 	m.ctrl.T.Helper()
@@ -72,6 +116,78 @@ func (mr *MockPersonServiceMockRecorder) GetMedia(id, userId, search any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockPersonService)(nil).GetMedia), id, userId, search)
 }
 
+// Merge mocks base method.
+func (m *MockPersonService) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", keepId, mergeIds)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockPersonServiceMockRecorder) Merge(keepId, mergeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockPersonService)(nil).Merge), keepId, mergeIds)
+}
+
+// Rate mocks base method.
+func (m *MockPersonService) Rate(id, userId uuid.UUID, rating int16) (*model.PersonRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rate", id, userId, rating)
+	ret0, _ := ret[0].(*model.PersonRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rate indicates an expected call of Rate.
+func (mr *MockPersonServiceMockRecorder) Rate(id, userId, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rate", reflect.TypeOf((*MockPersonService)(nil).Rate), id, userId, rating)
+}
+
+// RemoveAlias mocks base method.
+func (m *MockPersonService) RemoveAlias(id, aliasId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAlias", id, aliasId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAlias indicates an expected call of RemoveAlias.
+func (mr *MockPersonServiceMockRecorder) RemoveAlias(id, aliasId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAlias", reflect.TypeOf((*MockPersonService)(nil).RemoveAlias), id, aliasId)
+}
+
+// RemoveFavourite mocks base method.
+func (m *MockPersonService) RemoveFavourite(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavourite", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavourite indicates an expected call of RemoveFavourite.
+func (mr *MockPersonServiceMockRecorder) RemoveFavourite(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavourite", reflect.TypeOf((*MockPersonService)(nil).RemoveFavourite), id, userId)
+}
+
+// Unrate mocks base method.
+func (m *MockPersonService) Unrate(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unrate", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unrate indicates an expected call of Unrate.
+func (mr *MockPersonServiceMockRecorder) Unrate(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unrate", reflect.TypeOf((*MockPersonService)(nil).Unrate), id, userId)
+}
+
 // Upsert mocks base method.
 func (m *MockPersonService) Upsert(name string) (*model.Person, error) {
 	m.ctrl.T.Helper()
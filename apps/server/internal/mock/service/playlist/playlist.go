@@ -0,0 +1,219 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/server/internal/service/playlist/playlist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/server/internal/service/playlist/playlist.go
+//
+
+// Package mock_playlistService is a generated GoMock package.
+package mock_playlistService
+
+import (
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
+	models "github.com/slugger7/exorcist/apps/server/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPlaylistService is a mock of PlaylistService interface.
+type MockPlaylistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaylistServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPlaylistServiceMockRecorder is the mock recorder for MockPlaylistService.
+type MockPlaylistServiceMockRecorder struct {
+	mock *MockPlaylistService
+}
+
+// NewMockPlaylistService creates a new mock instance.
+func NewMockPlaylistService(ctrl *gomock.Controller) *MockPlaylistService {
+	mock := &MockPlaylistService{ctrl: ctrl}
+	mock.recorder = &MockPlaylistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaylistService) EXPECT() *MockPlaylistServiceMockRecorder {
+	return m.recorder
+}
+
+// AddMedia mocks base method.
+func (m *MockPlaylistService) AddMedia(id, userId uuid.UUID, playlistMedia []dto.CreatePlaylistMediaDTO) ([]model.PlaylistMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMedia", id, userId, playlistMedia)
+	ret0, _ := ret[0].([]model.PlaylistMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMedia indicates an expected call of AddMedia.
+func (mr *MockPlaylistServiceMockRecorder) AddMedia(id, userId, playlistMedia any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMedia", reflect.TypeOf((*MockPlaylistService)(nil).AddMedia), id, userId, playlistMedia)
+}
+
+// CreateAll mocks base method.
+func (m *MockPlaylistService) CreateAll(userId uuid.UUID, playlists []dto.CreatePlaylistDTO) ([]model.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", userId, playlists)
+	ret0, _ := ret[0].([]model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockPlaylistServiceMockRecorder) CreateAll(userId, playlists any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockPlaylistService)(nil).CreateAll), userId, playlists)
+}
+
+// Delete mocks base method.
+func (m *MockPlaylistService) Delete(id, userId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPlaylistServiceMockRecorder) Delete(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPlaylistService)(nil).Delete), id, userId)
+}
+
+// GetAll mocks base method.
+func (m *MockPlaylistService) GetAll(userId uuid.UUID) ([]model.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userId)
+	ret0, _ := ret[0].([]model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPlaylistServiceMockRecorder) GetAll(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPlaylistService)(nil).GetAll), userId)
+}
+
+// GetMedia mocks base method.
+func (m *MockPlaylistService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMedia", id, userId, search)
+	ret0, _ := ret[0].(*dto.PageDTO[models.MediaOverviewModel])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMedia indicates an expected call of GetMedia.
+func (mr *MockPlaylistServiceMockRecorder) GetMedia(id, userId, search any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockPlaylistService)(nil).GetMedia), id, userId, search)
+}
+
+// GetShared mocks base method.
+func (m *MockPlaylistService) GetShared(userId uuid.UUID) ([]models.SharedPlaylist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShared", userId)
+	ret0, _ := ret[0].([]models.SharedPlaylist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShared indicates an expected call of GetShared.
+func (mr *MockPlaylistServiceMockRecorder) GetShared(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShared", reflect.TypeOf((*MockPlaylistService)(nil).GetShared), userId)
+}
+
+// GetUsers mocks base method.
+func (m *MockPlaylistService) GetUsers(id, userId uuid.UUID) ([]model.PlaylistUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", id, userId)
+	ret0, _ := ret[0].([]model.PlaylistUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockPlaylistServiceMockRecorder) GetUsers(id, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockPlaylistService)(nil).GetUsers), id, userId)
+}
+
+// GrantAccess mocks base method.
+func (m *MockPlaylistService) GrantAccess(id, userId, sharedWith uuid.UUID, access model.PlaylistAccessEnum) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccess", id, userId, sharedWith, access)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockPlaylistServiceMockRecorder) GrantAccess(id, userId, sharedWith, access any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockPlaylistService)(nil).GrantAccess), id, userId, sharedWith, access)
+}
+
+// MoveMedia mocks base method.
+func (m *MockPlaylistService) MoveMedia(id, userId, mediaId uuid.UUID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMedia", id, userId, mediaId, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveMedia indicates an expected call of MoveMedia.
+func (mr *MockPlaylistServiceMockRecorder) MoveMedia(id, userId, mediaId, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMedia", reflect.TypeOf((*MockPlaylistService)(nil).MoveMedia), id, userId, mediaId, position)
+}
+
+// RemoveMedia mocks base method.
+func (m *MockPlaylistService) RemoveMedia(id, userId, mediaId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMedia", id, userId, mediaId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMedia indicates an expected call of RemoveMedia.
+func (mr *MockPlaylistServiceMockRecorder) RemoveMedia(id, userId, mediaId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMedia", reflect.TypeOf((*MockPlaylistService)(nil).RemoveMedia), id, userId, mediaId)
+}
+
+// RevokeAccess mocks base method.
+func (m *MockPlaylistService) RevokeAccess(id, userId, sharedWith uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccess", id, userId, sharedWith)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockPlaylistServiceMockRecorder) RevokeAccess(id, userId, sharedWith any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockPlaylistService)(nil).RevokeAccess), id, userId, sharedWith)
+}
+
+// Update mocks base method.
+func (m *MockPlaylistService) Update(id, userId uuid.UUID, update dto.PlaylistUpdateDTO) (*model.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", id, userId, update)
+	ret0, _ := ret[0].(*model.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPlaylistServiceMockRecorder) Update(id, userId, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPlaylistService)(nil).Update), id, userId, update)
+}
@@ -12,6 +12,7 @@ package mock_service
 import (
 	reflect "reflect"
 
+	hlsService "github.com/slugger7/exorcist/apps/server/internal/service/hls"
 	jobService "github.com/slugger7/exorcist/apps/server/internal/service/job"
 	libraryService "github.com/slugger7/exorcist/apps/server/internal/service/library"
 	libraryPathService "github.com/slugger7/exorcist/apps/server/internal/service/library_path"
@@ -47,6 +48,20 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Hls mocks base method.
+func (m *MockService) Hls() hlsService.HlsService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hls")
+	ret0, _ := ret[0].(hlsService.HlsService)
+	return ret0
+}
+
+// Hls indicates an expected call of Hls.
+func (mr *MockServiceMockRecorder) Hls() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hls", reflect.TypeOf((*MockService)(nil).Hls))
+}
+
 // Job mocks base method.
 func (m *MockService) Job() jobService.JobService {
 	m.ctrl.T.Helper()
@@ -43,6 +43,21 @@ func (m *MockTagService) EXPECT() *MockTagServiceMockRecorder {
 	return m.recorder
 }
 
+// AddAlias mocks base method.
+func (m *MockTagService) AddAlias(id uuid.UUID, alias string) (*model.TagAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAlias", id, alias)
+	ret0, _ := ret[0].(*model.TagAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAlias indicates an expected call of AddAlias.
+func (mr *MockTagServiceMockRecorder) AddAlias(id, alias any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAlias", reflect.TypeOf((*MockTagService)(nil).AddAlias), id, alias)
+}
+
 // Delete mocks base method.
 func (m *MockTagService) Delete(id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -57,6 +72,21 @@ func (mr *MockTagServiceMockRecorder) Delete(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagService)(nil).Delete), id)
 }
 
+// GetAliases mocks base method.
+func (m *MockTagService) GetAliases(id uuid.UUID) ([]model.TagAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliases", id)
+	ret0, _ := ret[0].([]model.TagAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliases indicates an expected call of GetAliases.
+func (mr *MockTagServiceMockRecorder) GetAliases(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliases", reflect.TypeOf((*MockTagService)(nil).GetAliases), id)
+}
+
 // GetMedia mocks base method.
 func (m *MockTagService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
 	m.ctrl.T.Helper()
@@ -72,6 +102,35 @@ func (mr *MockTagServiceMockRecorder) GetMedia(id, userId, search any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMedia", reflect.TypeOf((*MockTagService)(nil).GetMedia), id, userId, search)
 }
 
+// Merge mocks base method.
+func (m *MockTagService) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", keepId, mergeIds)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockTagServiceMockRecorder) Merge(keepId, mergeIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagService)(nil).Merge), keepId, mergeIds)
+}
+
+// RemoveAlias mocks base method.
+func (m *MockTagService) RemoveAlias(id, aliasId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAlias", id, aliasId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAlias indicates an expected call of RemoveAlias.
+func (mr *MockTagServiceMockRecorder) RemoveAlias(id, aliasId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAlias", reflect.TypeOf((*MockTagService)(nil).RemoveAlias), id, aliasId)
+}
+
 // Upsert mocks base method.
 func (m *MockTagService) Upsert(name string) (*model.Tag, error) {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 	uuid "github.com/google/uuid"
 	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
+	userService "github.com/slugger7/exorcist/apps/server/internal/service/user"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,33 +57,209 @@ func (mr *MockUserServiceMockRecorder) AddMediaToFavourites(id, mediaId any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMediaToFavourites", reflect.TypeOf((*MockUserService)(nil).AddMediaToFavourites), id, mediaId)
 }
 
+// Authenticate mocks base method.
+func (m *MockUserService) Authenticate(attempt userService.LoginAttempt) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", attempt)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockUserServiceMockRecorder) Authenticate(attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUserService)(nil).Authenticate), attempt)
+}
+
 // Create mocks base method.
-func (m *MockUserService) Create(username, password string) (*model.User, error) {
+func (m *MockUserService) Create(username, password string, role model.UserRoleEnum) (*model.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", username, password)
+	ret := m.ctrl.Call(m, "Create", username, password, role)
 	ret0, _ := ret[0].(*model.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockUserServiceMockRecorder) Create(username, password any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) Create(username, password, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserService)(nil).Create), username, password, role)
+}
+
+// CreateApiToken mocks base method.
+func (m_2 *MockUserService) CreateApiToken(userId uuid.UUID, m dto.CreateApiTokenDTO) (*model.APIToken, string, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateApiToken", userId, m)
+	ret0, _ := ret[0].(*model.APIToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateApiToken indicates an expected call of CreateApiToken.
+func (mr *MockUserServiceMockRecorder) CreateApiToken(userId, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiToken", reflect.TypeOf((*MockUserService)(nil).CreateApiToken), userId, m)
+}
+
+// Delete mocks base method.
+func (m *MockUserService) Delete(actorId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", actorId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserServiceMockRecorder) Delete(actorId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserService)(nil).Delete), actorId, id)
+}
+
+// GetAll mocks base method.
+func (m *MockUserService) GetAll() ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockUserServiceMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockUserService)(nil).GetAll))
+}
+
+// GetApiTokens mocks base method.
+func (m *MockUserService) GetApiTokens(userId uuid.UUID) ([]model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiTokens", userId)
+	ret0, _ := ret[0].([]model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiTokens indicates an expected call of GetApiTokens.
+func (mr *MockUserServiceMockRecorder) GetApiTokens(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiTokens", reflect.TypeOf((*MockUserService)(nil).GetApiTokens), userId)
+}
+
+// GetById mocks base method.
+func (m *MockUserService) GetById(id uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockUserServiceMockRecorder) GetById(id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserService)(nil).Create), username, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUserService)(nil).GetById), id)
+}
+
+// GetSessions mocks base method.
+func (m *MockUserService) GetSessions(userId uuid.UUID) ([]model.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", userId)
+	ret0, _ := ret[0].([]model.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockUserServiceMockRecorder) GetSessions(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockUserService)(nil).GetSessions), userId)
+}
+
+// RevokeApiToken mocks base method.
+func (m *MockUserService) RevokeApiToken(userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiToken", userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeApiToken indicates an expected call of RevokeApiToken.
+func (mr *MockUserServiceMockRecorder) RevokeApiToken(userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiToken", reflect.TypeOf((*MockUserService)(nil).RevokeApiToken), userId, id)
+}
+
+// RevokeSession mocks base method.
+func (m *MockUserService) RevokeSession(userId, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUserServiceMockRecorder) RevokeSession(userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUserService)(nil).RevokeSession), userId, id)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockUserService) RevokeSessions(userId uuid.UUID, keep *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", userId, keep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockUserServiceMockRecorder) RevokeSessions(userId, keep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockUserService)(nil).RevokeSessions), userId, keep)
+}
+
+// SetPassword mocks base method.
+func (m *MockUserService) SetPassword(id uuid.UUID, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", id, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword.
+func (mr *MockUserServiceMockRecorder) SetPassword(id, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockUserService)(nil).SetPassword), id, password)
+}
+
+// Update mocks base method.
+func (m_2 *MockUserService) Update(actorId, id uuid.UUID, m dto.UpdateUserDTO) (*model.User, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", actorId, id, m)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServiceMockRecorder) Update(actorId, id, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserService)(nil).Update), actorId, id, m)
 }
 
 // UpdatePassword mocks base method.
-func (m *MockUserService) UpdatePassword(id uuid.UUID, model dto.ResetPasswordDTO) error {
+func (m *MockUserService) UpdatePassword(id uuid.UUID, arg1 dto.ResetPasswordDTO, keepSession *uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", id, model)
+	ret := m.ctrl.Call(m, "UpdatePassword", id, arg1, keepSession)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword.
-func (mr *MockUserServiceMockRecorder) UpdatePassword(id, model any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) UpdatePassword(id, arg1, keepSession any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserService)(nil).UpdatePassword), id, model)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserService)(nil).UpdatePassword), id, arg1, keepSession)
 }
 
 // Validate mocks base method.
@@ -99,3 +276,18 @@ func (mr *MockUserServiceMockRecorder) Validate(username, password any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockUserService)(nil).Validate), username, password)
 }
+
+// ValidateApiToken mocks base method.
+func (m *MockUserService) ValidateApiToken(token string) (*model.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateApiToken", token)
+	ret0, _ := ret[0].(*model.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateApiToken indicates an expected call of ValidateApiToken.
+func (mr *MockUserServiceMockRecorder) ValidateApiToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateApiToken", reflect.TypeOf((*MockUserService)(nil).ValidateApiToken), token)
+}
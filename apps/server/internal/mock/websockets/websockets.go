@@ -0,0 +1,205 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./apps/server/internal/websockets/websockets.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./apps/server/internal/websockets/websockets.go
+//
+
+// Package mock_websockets is a generated GoMock package.
+package mock_websockets
+
+import (
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	model "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	dto "github.com/slugger7/exorcist/apps/server/internal/dto"
+	models "github.com/slugger7/exorcist/apps/server/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebsockets is a mock of Websockets interface.
+type MockWebsockets struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebsocketsMockRecorder
+	isgomock struct{}
+}
+
+// MockWebsocketsMockRecorder is the mock recorder for MockWebsockets.
+type MockWebsocketsMockRecorder struct {
+	mock *MockWebsockets
+}
+
+// NewMockWebsockets creates a new mock instance.
+func NewMockWebsockets(ctrl *gomock.Controller) *MockWebsockets {
+	mock := &MockWebsockets{ctrl: ctrl}
+	mock.recorder = &MockWebsocketsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebsockets) EXPECT() *MockWebsocketsMockRecorder {
+	return m.recorder
+}
+
+// AddWs mocks base method.
+func (m *MockWebsockets) AddWs(id uuid.UUID, wsConn *models.WSConn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddWs", id, wsConn)
+}
+
+// AddWs indicates an expected call of AddWs.
+func (mr *MockWebsocketsMockRecorder) AddWs(id, wsConn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWs", reflect.TypeOf((*MockWebsockets)(nil).AddWs), id, wsConn)
+}
+
+// JobCreate mocks base method.
+func (m *MockWebsockets) JobCreate(job model.Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JobCreate", job)
+}
+
+// JobCreate indicates an expected call of JobCreate.
+func (mr *MockWebsocketsMockRecorder) JobCreate(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobCreate", reflect.TypeOf((*MockWebsockets)(nil).JobCreate), job)
+}
+
+// JobDelete mocks base method.
+func (m *MockWebsockets) JobDelete(job model.Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JobDelete", job)
+}
+
+// JobDelete indicates an expected call of JobDelete.
+func (mr *MockWebsocketsMockRecorder) JobDelete(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobDelete", reflect.TypeOf((*MockWebsockets)(nil).JobDelete), job)
+}
+
+// JobProgress mocks base method.
+func (m *MockWebsockets) JobProgress(job model.Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JobProgress", job)
+}
+
+// JobProgress indicates an expected call of JobProgress.
+func (mr *MockWebsocketsMockRecorder) JobProgress(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobProgress", reflect.TypeOf((*MockWebsockets)(nil).JobProgress), job)
+}
+
+// JobUpdate mocks base method.
+func (m *MockWebsockets) JobUpdate(job model.Job) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "JobUpdate", job)
+}
+
+// JobUpdate indicates an expected call of JobUpdate.
+func (mr *MockWebsocketsMockRecorder) JobUpdate(job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobUpdate", reflect.TypeOf((*MockWebsockets)(nil).JobUpdate), job)
+}
+
+// MediaCreate mocks base method.
+func (m *MockWebsockets) MediaCreate(media dto.MediaOverviewDTO) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MediaCreate", media)
+}
+
+// MediaCreate indicates an expected call of MediaCreate.
+func (mr *MockWebsocketsMockRecorder) MediaCreate(media any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaCreate", reflect.TypeOf((*MockWebsockets)(nil).MediaCreate), media)
+}
+
+// MediaDelete mocks base method.
+func (m *MockWebsockets) MediaDelete(media dto.MediaOverviewDTO) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MediaDelete", media)
+}
+
+// MediaDelete indicates an expected call of MediaDelete.
+func (mr *MockWebsocketsMockRecorder) MediaDelete(media any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaDelete", reflect.TypeOf((*MockWebsockets)(nil).MediaDelete), media)
+}
+
+// MediaOverviewUpdate mocks base method.
+func (m *MockWebsockets) MediaOverviewUpdate(media dto.MediaOverviewDTO) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MediaOverviewUpdate", media)
+}
+
+// MediaOverviewUpdate indicates an expected call of MediaOverviewUpdate.
+func (mr *MockWebsocketsMockRecorder) MediaOverviewUpdate(media any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaOverviewUpdate", reflect.TypeOf((*MockWebsockets)(nil).MediaOverviewUpdate), media)
+}
+
+// MediaUpdate mocks base method.
+func (m *MockWebsockets) MediaUpdate(media dto.MediaDTO) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MediaUpdate", media)
+}
+
+// MediaUpdate indicates an expected call of MediaUpdate.
+func (mr *MockWebsocketsMockRecorder) MediaUpdate(media any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaUpdate", reflect.TypeOf((*MockWebsockets)(nil).MediaUpdate), media)
+}
+
+// PingDuration mocks base method.
+func (m *MockWebsockets) PingDuration() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PingDuration")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// PingDuration indicates an expected call of PingDuration.
+func (mr *MockWebsocketsMockRecorder) PingDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingDuration", reflect.TypeOf((*MockWebsockets)(nil).PingDuration))
+}
+
+// PongDuration mocks base method.
+func (m *MockWebsockets) PongDuration() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PongDuration")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// PongDuration indicates an expected call of PongDuration.
+func (mr *MockWebsocketsMockRecorder) PongDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PongDuration", reflect.TypeOf((*MockWebsockets)(nil).PongDuration))
+}
+
+// Shutdown mocks base method.
+func (m *MockWebsockets) Shutdown() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Shutdown")
+}
+
+// Shutdown indicates an expected call of Shutdown.
+func (mr *MockWebsocketsMockRecorder) Shutdown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Shutdown", reflect.TypeOf((*MockWebsockets)(nil).Shutdown))
+}
+
+// WebSocketHeartbeat mocks base method.
+func (m *MockWebsockets) WebSocketHeartbeat() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WebSocketHeartbeat")
+}
+
+// WebSocketHeartbeat indicates an expected call of WebSocketHeartbeat.
+func (mr *MockWebsocketsMockRecorder) WebSocketHeartbeat() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebSocketHeartbeat", reflect.TypeOf((*MockWebsockets)(nil).WebSocketHeartbeat))
+}
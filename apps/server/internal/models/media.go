@@ -18,6 +18,7 @@ type MediaOverviewModel struct {
 	model.MediaProgress
 	*model.Video
 	*model.FavouriteMedia
+	*model.MediaRating
 }
 
 type Media struct {
@@ -26,6 +27,7 @@ type Media struct {
 	*model.Video
 	*model.MediaProgress
 	*model.FavouriteMedia
+	*model.MediaRating
 	People         []model.Person
 	Tags           []model.Tag
 	MediaRelations []MediaRelation
@@ -35,3 +37,8 @@ type MediaImage struct {
 	model.Image
 	model.Media
 }
+
+type DuplicateMedia struct {
+	model.Media
+	*model.Video
+}
@@ -0,0 +1,10 @@
+package models
+
+import "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+
+// Person is a person along with the rating and favourite of a user
+type Person struct {
+	model.Person
+	*model.PersonRating
+	*model.FavouritePerson
+}
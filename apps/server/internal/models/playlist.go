@@ -6,3 +6,9 @@ type Playlist struct {
 	model.Playlist
 	Media model.PlaylistMedia
 }
+
+// SharedPlaylist is a playlist along with the access that a user has been granted to it
+type SharedPlaylist struct {
+	model.Playlist
+	model.PlaylistUser
+}
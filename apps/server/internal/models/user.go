@@ -1,6 +1,9 @@
 package models
 
+import "github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+
 type CreateUserDTO struct {
-	Username string `json:"username" binding:"required"`
-	Password string `json:"password" binding:"required"`
+	Username string              `json:"username" binding:"required"`
+	Password string              `json:"password" binding:"required"`
+	Role     *model.UserRoleEnum `json:"role" binding:"omitempty,oneof=admin editor viewer"`
 }
@@ -0,0 +1,140 @@
+package apiTokenRepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+type ApiTokenRepository interface {
+	GetByUserId(userId uuid.UUID) ([]model.APIToken, error)
+	GetByHash(hash string) (*model.APIToken, error)
+	Create(m model.APIToken) (*model.APIToken, error)
+	UpdateLastUsed(id uuid.UUID, lastUsed time.Time) error
+	Delete(id, userId uuid.UUID) (bool, error)
+}
+
+type apiTokenRepository struct {
+	env *environment.EnvironmentVariables
+	db  *sql.DB
+	ctx context.Context
+}
+
+// GetByUserId implements ApiTokenRepository.
+func (r *apiTokenRepository) GetByUserId(userId uuid.UUID) ([]model.APIToken, error) {
+	statement := table.APIToken.SELECT(table.APIToken.AllColumns).
+		FROM(table.APIToken).
+		WHERE(table.APIToken.UserID.EQ(postgres.UUID(userId))).
+		ORDER_BY(table.APIToken.Created.DESC())
+
+	util.DebugCheck(r.env, statement)
+
+	var tokens []model.APIToken
+	if err := statement.QueryContext(r.ctx, r.db, &tokens); err != nil {
+		return nil, errs.BuildError(err, "could not query api tokens of user: %v", userId.String())
+	}
+
+	return tokens, nil
+}
+
+// GetByHash implements ApiTokenRepository.
+func (r *apiTokenRepository) GetByHash(hash string) (*model.APIToken, error) {
+	statement := table.APIToken.SELECT(table.APIToken.AllColumns).
+		FROM(table.APIToken).
+		WHERE(table.APIToken.TokenHash.EQ(postgres.String(hash)))
+
+	util.DebugCheck(r.env, statement)
+
+	var tokens []model.APIToken
+	if err := statement.QueryContext(r.ctx, r.db, &tokens); err != nil {
+		return nil, errs.BuildError(err, "could not query api token by hash")
+	}
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	return &tokens[0], nil
+}
+
+// Create implements ApiTokenRepository.
+func (r *apiTokenRepository) Create(m model.APIToken) (*model.APIToken, error) {
+	statement := table.APIToken.INSERT(
+		table.APIToken.UserID,
+		table.APIToken.Name,
+		table.APIToken.TokenHash,
+		table.APIToken.Scope,
+		table.APIToken.Expires,
+	).
+		MODEL(m).
+		RETURNING(table.APIToken.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var token model.APIToken
+	if err := statement.QueryContext(r.ctx, r.db, &token); err != nil {
+		return nil, errs.BuildError(err, "could not create api token for user: %v", m.UserID.String())
+	}
+
+	return &token, nil
+}
+
+// UpdateLastUsed implements ApiTokenRepository.
+func (r *apiTokenRepository) UpdateLastUsed(id uuid.UUID, lastUsed time.Time) error {
+	statement := table.APIToken.UPDATE(table.APIToken.LastUsed).
+		SET(postgres.TimestampT(lastUsed)).
+		WHERE(table.APIToken.ID.EQ(postgres.UUID(id)))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not update last used of api token: %v", id.String())
+	}
+
+	return nil
+}
+
+// Delete implements ApiTokenRepository. Tokens can only be deleted by the user that they belong to.
+func (r *apiTokenRepository) Delete(id, userId uuid.UUID) (bool, error) {
+	statement := table.APIToken.DELETE().
+		WHERE(table.APIToken.ID.EQ(postgres.UUID(id)).
+			AND(table.APIToken.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(r.env, statement)
+
+	res, err := statement.ExecContext(r.ctx, r.db)
+	if err != nil {
+		return false, errs.BuildError(err, "could not delete api token: %v", id.String())
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.BuildError(err, "could not determine if api token was deleted: %v", id.String())
+	}
+
+	return affected == 1, nil
+}
+
+var apiTokenRepositoryInstance *apiTokenRepository
+
+func New(env *environment.EnvironmentVariables, db *sql.DB, context context.Context) ApiTokenRepository {
+	if apiTokenRepositoryInstance != nil {
+		return apiTokenRepositoryInstance
+	}
+
+	apiTokenRepositoryInstance = &apiTokenRepository{
+		env: env,
+		db:  db,
+		ctx: context,
+	}
+
+	return apiTokenRepositoryInstance
+}
@@ -9,3 +9,12 @@ func OrderByDirectionColumn(asc bool, column postgres.Column, stmnt postgres.Sel
 
 	return stmnt.ORDER_BY(column.ASC())
 }
+
+// OrderByDirectionColumnNullsLast orders rows without a value last whichever the direction is
+func OrderByDirectionColumnNullsLast(asc bool, column postgres.Column, stmnt postgres.SelectStatement) postgres.SelectStatement {
+	if !asc {
+		return stmnt.ORDER_BY(column.DESC().NULLS_LAST())
+	}
+
+	return stmnt.ORDER_BY(column.ASC().NULLS_LAST())
+}
@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+)
+
+// isAdmin is true when the user is an admin. Admins have access to every library.
+func isAdmin(userId uuid.UUID) postgres.BoolExpression {
+	return postgres.EXISTS(
+		table.User.SELECT(table.User.ID).
+			FROM(table.User).
+			WHERE(table.User.ID.EQ(postgres.UUID(userId)).
+				AND(table.User.Role.EQ(postgres.NewEnumValue(model.UserRoleEnum_Admin.String())))),
+	)
+}
+
+// LibraryAccess limits libraries to the ones that the user has been granted access to
+func LibraryAccess(userId uuid.UUID) postgres.BoolExpression {
+	return isAdmin(userId).OR(table.Library.ID.IN(
+		table.LibraryUser.SELECT(table.LibraryUser.LibraryID).
+			FROM(table.LibraryUser).
+			WHERE(table.LibraryUser.UserID.EQ(postgres.UUID(userId))),
+	))
+}
+
+// LibraryPathAccess limits library paths to the ones in libraries that the user has been granted access to
+func LibraryPathAccess(userId uuid.UUID) postgres.BoolExpression {
+	return table.LibraryPath.LibraryID.IN(
+		table.Library.SELECT(table.Library.ID).
+			FROM(table.Library).
+			WHERE(LibraryAccess(userId)),
+	)
+}
+
+// MediaAccess limits media to the ones in libraries that the user has been granted access to
+func MediaAccess(userId uuid.UUID) postgres.BoolExpression {
+	return isAdmin(userId).OR(table.Media.LibraryPathID.IN(
+		table.LibraryPath.SELECT(table.LibraryPath.ID).
+			FROM(table.LibraryPath.
+				INNER_JOIN(table.LibraryUser, table.LibraryUser.LibraryID.EQ(table.LibraryPath.LibraryID))).
+			WHERE(table.LibraryUser.UserID.EQ(postgres.UUID(userId))),
+	))
+}
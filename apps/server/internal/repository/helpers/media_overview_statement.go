@@ -3,8 +3,6 @@ package helpers
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"strings"
 
 	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
@@ -20,12 +18,10 @@ import (
 type RelationFn func(relationTable postgres.ReadableTable) postgres.ReadableTable
 type WhereFn func(currentWhere postgres.BoolExpression) postgres.BoolExpression
 
-func mediaOverviewStatement(userId uuid.UUID, search dto.MediaSearchDTO, relationFn RelationFn, whereFn WhereFn) postgres.Statement {
-	tagFilter := len(search.Tags) > 0
-	personFilter := len(search.People) > 0
+// mediaOverviewStatement selects a page of media. Media can only be ordered by position when it is the media of a playlist.
+func mediaOverviewStatement(userId uuid.UUID, search dto.MediaSearchDTO, relationFn RelationFn, whereFn WhereFn, inPlaylist bool) postgres.Statement {
 	media := table.Media
 	mediaRelation := table.MediaRelation
-	tag := table.Tag
 
 	fromStmnt := relationFn(
 		media.LEFT_JOIN(
@@ -42,100 +38,88 @@ func mediaOverviewStatement(userId uuid.UUID, search dto.MediaSearchDTO, relatio
 			table.FavouriteMedia,
 			table.FavouriteMedia.MediaID.EQ(media.ID).
 				AND(table.FavouriteMedia.UserID.EQ(postgres.UUID(userId))),
+		).LEFT_JOIN(
+			table.MediaRating,
+			table.MediaRating.MediaID.EQ(media.ID).
+				AND(table.MediaRating.UserID.EQ(postgres.UUID(userId))),
 		))
 
-	if tagFilter {
-		applyJoin := func(tbl postgres.ReadableTable) postgres.ReadableTable {
-			return tbl.LEFT_JOIN(
-				table.MediaTag,
-				media.ID.EQ(table.MediaTag.MediaID),
-			).LEFT_JOIN(
-				tag,
-				table.MediaTag.TagID.EQ(table.Tag.ID),
-			)
-		}
-
-		fromStmnt = applyJoin(fromStmnt)
-	}
-
-	if personFilter {
-		applyJoin := func(tbl postgres.ReadableTable) postgres.ReadableTable {
-			return tbl.LEFT_JOIN(
-				table.MediaPerson,
-				media.ID.EQ(table.MediaPerson.MediaID),
-			).LEFT_JOIN(
-				table.Person,
-				table.MediaPerson.PersonID.EQ(table.Person.ID),
-			)
-		}
-
-		fromStmnt = applyJoin(fromStmnt)
-	}
-
-	selectStatement := media.SELECT(
+	projections := []postgres.Projection{
 		media.ID,
 		media.Title,
 		table.MediaProgress.Timestamp,
+		table.MediaProgress.PlayCount,
+		table.MediaProgress.Watched,
 		table.Video.Runtime,
 		table.FavouriteMedia.ID,
+		table.MediaRating.ID,
+		table.MediaRating.Rating,
 		postgres.COUNT(postgres.STAR).OVER().AS("total"),
-	).
+	}
+
+	if search.Search != "" {
+		projections = append(projections, mediaRelevance(search.Search).AS(dto.MediaOrdinal_Relevance.String()))
+	}
+
+	selectStatement := media.SELECT(projections[0], projections[1:]...).
 		FROM(fromStmnt)
 
-	selectStatement = OrderByDirectionColumn(search.Asc, search.OrderBy.ToColumn(), selectStatement)
+	orderBy := search.OrderBy
+	if orderBy == dto.MediaOrdinal_Relevance && search.Search == "" {
+		orderBy = dto.MediaOrdinal_Added
+	}
+	if orderBy == dto.MediaOrdinal_Position && !inPlaylist {
+		orderBy = dto.MediaOrdinal_Added
+	}
+	if orderBy == dto.MediaOrdinal_Rating {
+		selectStatement = OrderByDirectionColumnNullsLast(search.Asc, orderBy.ToColumn(), selectStatement)
+	} else {
+		selectStatement = OrderByDirectionColumn(search.Asc, orderBy.ToColumn(), selectStatement)
+	}
 
 	whr := media.MediaType.EQ(postgres.NewEnumValue(model.MediaTypeEnum_Primary.String())).
 		AND(media.Deleted.EQ(postgres.Bool(*search.Deleted))).
-		AND(media.Exists.EQ(postgres.Bool(*search.Exists)))
+		AND(media.Exists.EQ(postgres.Bool(*search.Exists))).
+		AND(MediaAccess(userId))
 
 	if search.Favourites {
 		whr = whr.AND(table.FavouriteMedia.UserID.IS_NOT_NULL())
 	}
 
-	if search.Search != "" {
-		caseInsensitive := strings.ToLower(search.Search)
-		likeExpression := fmt.Sprintf("%%%v%%", caseInsensitive)
-		whr = whr.
-			AND(
-				postgres.LOWER(media.Title).LIKE(postgres.String(likeExpression)).
-					OR(postgres.LOWER(media.Path).LIKE(postgres.String(likeExpression))),
-			)
-	}
-
-	if tagFilter {
-		tagExpressions := make([]postgres.Expression, len(search.Tags))
-		for i, t := range search.Tags {
-			tagExpressions[i] = postgres.String(t)
-		}
+	if search.MinRating != nil {
+		whr = whr.AND(table.MediaRating.Rating.GT_EQ(postgres.Int16(*search.MinRating)))
+	}
 
-		whr = whr.AND(
-			tag.Name.IN(tagExpressions...),
-		)
+	if search.MinPersonRating != nil {
+		whr = whr.AND(hasRatedPerson(userId, *search.MinPersonRating))
 	}
 
-	if personFilter {
-		peopleExpression := make([]postgres.Expression, len(search.People))
-		for i, p := range search.People {
-			peopleExpression[i] = postgres.String(p)
-		}
+	if search.Search != "" {
+		whr = whr.AND(mediaSearch(search.Search))
+	}
 
-		whr = whr.AND(
-			table.Person.Name.IN(peopleExpression...),
-		)
+	if len(search.Tags) > 0 {
+		whr = whr.AND(hasAnyTag(search.Tags))
+	}
+
+	if len(search.People) > 0 {
+		whr = whr.AND(hasAnyPerson(search.People))
 	}
 
 	if len(search.WatchStatuses) > 0 {
 		var watchWhere postgres.BoolExpression
 		for _, w := range search.WatchStatuses {
 			var t postgres.BoolExpression
+			// Media is watched once it has been watched past the threshold or marked as watched
 			switch w {
 			case dto.WatchStatus_Watched:
-				t = table.MediaProgress.Timestamp.GT(table.Video.Runtime.MUL(postgres.Float(0.9)))
+				t = table.MediaProgress.Watched.IS_NOT_NULL()
 			case dto.WatchStatus_Unwatched:
-				t = table.MediaProgress.Timestamp.LT(table.Video.Runtime.MUL(postgres.Float(0.1))).OR(table.MediaProgress.Timestamp.IS_NULL())
+				t = table.MediaProgress.Watched.IS_NULL().
+					AND(table.MediaProgress.Timestamp.LT(table.Video.Runtime.MUL(postgres.Float(0.1))).OR(table.MediaProgress.Timestamp.IS_NULL()))
 			case dto.WatchStatus_InProgress:
-				t = table.MediaProgress.Timestamp.GT(table.Video.Runtime.MUL(postgres.Float(0.1))).
-					AND(table.MediaProgress.Timestamp.LT(table.Video.Runtime.MUL(postgres.Float(0.9))))
+				t = table.MediaProgress.Watched.IS_NULL().
+					AND(table.MediaProgress.Timestamp.GT_EQ(table.Video.Runtime.MUL(postgres.Float(0.1))))
 			default:
 				continue
 			}
@@ -152,23 +136,6 @@ func mediaOverviewStatement(userId uuid.UUID, search dto.MediaSearchDTO, relatio
 
 	selectStatement = selectStatement.WHERE(whereFn(whr))
 
-	if tagFilter || personFilter {
-		selectStatement = selectStatement.GROUP_BY(
-			media.ID,
-			table.Video.Runtime,
-			table.MediaProgress.Timestamp,
-			table.FavouriteMedia.ID,
-		)
-	}
-
-	if tagFilter {
-		selectStatement = selectStatement.HAVING(postgres.COUNT(postgres.DISTINCT(tag.Name)).EQ(postgres.Int32(int32(len(search.Tags)))))
-	}
-
-	if personFilter {
-		selectStatement = selectStatement.HAVING(postgres.COUNT(postgres.DISTINCT(table.Person.Name)).EQ(postgres.Int32(int32(len(search.People)))))
-	}
-
 	selectStatement = selectStatement.
 		LIMIT(int64(search.Limit)).
 		OFFSET(int64(search.Skip))
@@ -177,7 +144,35 @@ func mediaOverviewStatement(userId uuid.UUID, search dto.MediaSearchDTO, relatio
 }
 
 func QueryMediaOverview(userId uuid.UUID, search dto.MediaSearchDTO, relationFn RelationFn, whereFn WhereFn, ctx context.Context, db *sql.DB, env *environment.EnvironmentVariables) (*dto.PageDTO[models.MediaOverviewModel], error) {
-	selectStatement := mediaOverviewStatement(userId, search, relationFn, whereFn)
+	return queryMediaOverview(mediaOverviewStatement(userId, search, relationFn, whereFn, false), search, ctx, db, env)
+}
+
+// QueryPlaylistMediaOverview queries the media of a playlist. Media is in playlist order unless the search orders it otherwise.
+func QueryPlaylistMediaOverview(id, userId uuid.UUID, search dto.MediaSearchDTO, ctx context.Context, db *sql.DB, env *environment.EnvironmentVariables) (*dto.PageDTO[models.MediaOverviewModel], error) {
+	return queryMediaOverview(playlistMediaOverviewStatement(id, userId, search), search, ctx, db, env)
+}
+
+func playlistMediaOverviewStatement(id, userId uuid.UUID, search dto.MediaSearchDTO) postgres.Statement {
+	if search.OrderBy == "" {
+		search.OrderBy = dto.MediaOrdinal_Position
+		search.Asc = true
+	}
+
+	relationFn := func(rel postgres.ReadableTable) postgres.ReadableTable {
+		return rel.INNER_JOIN(
+			table.PlaylistMedia,
+			table.PlaylistMedia.MediaID.EQ(table.Media.ID),
+		)
+	}
+
+	whereFn := func(whr postgres.BoolExpression) postgres.BoolExpression {
+		return whr.AND(table.PlaylistMedia.PlaylistID.EQ(postgres.UUID(id)))
+	}
+
+	return mediaOverviewStatement(userId, search, relationFn, whereFn, true)
+}
+
+func queryMediaOverview(selectStatement postgres.Statement, search dto.MediaSearchDTO, ctx context.Context, db *sql.DB, env *environment.EnvironmentVariables) (*dto.PageDTO[models.MediaOverviewModel], error) {
 
 	util.DebugCheck(env, selectStatement)
 
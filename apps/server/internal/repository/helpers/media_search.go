@@ -0,0 +1,135 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+)
+
+// Matches on the title count the most. Paths contain a lot of noise so they count the least.
+const (
+	pathWeight   = 0.5
+	tagWeight    = 0.8
+	personWeight = 0.8
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchPattern matches the term anywhere in the text. Wildcards in the term are matched literally.
+func searchPattern(term string) postgres.StringExpression {
+	return postgres.String(fmt.Sprintf("%%%v%%", likeEscaper.Replace(term)))
+}
+
+// iLike is a case insensitive LIKE which, unlike LOWER(column) LIKE, can use the trigram indexes
+func iLike(column postgres.StringExpression, pattern postgres.StringExpression) postgres.BoolExpression {
+	return postgres.BoolExp(postgres.BinaryOperator(column, pattern, "ILIKE"))
+}
+
+func wordSimilarity(term postgres.StringExpression, column postgres.StringExpression) postgres.FloatExpression {
+	return postgres.FloatExp(postgres.Func("WORD_SIMILARITY", term, column))
+}
+
+// mediaSearch matches media whose title or path contains the term or that have a tag or person whose name or alias contains it
+func mediaSearch(term string) postgres.BoolExpression {
+	pattern := searchPattern(term)
+
+	tags := table.MediaTag.SELECT(table.MediaTag.MediaID).
+		FROM(table.MediaTag.
+			INNER_JOIN(table.Tag, table.Tag.ID.EQ(table.MediaTag.TagID)).
+			LEFT_JOIN(table.TagAlias, table.TagAlias.TagID.EQ(table.Tag.ID))).
+		WHERE(iLike(table.Tag.Name, pattern).
+			OR(iLike(table.TagAlias.Alias_, pattern)))
+
+	people := table.MediaPerson.SELECT(table.MediaPerson.MediaID).
+		FROM(table.MediaPerson.
+			INNER_JOIN(table.Person, table.Person.ID.EQ(table.MediaPerson.PersonID)).
+			LEFT_JOIN(table.PersonAlias, table.PersonAlias.PersonID.EQ(table.Person.ID))).
+		WHERE(iLike(table.Person.Name, pattern).
+			OR(iLike(table.PersonAlias.Alias_, pattern)))
+
+	return iLike(table.Media.Title, pattern).
+		OR(iLike(table.Media.Path, pattern)).
+		OR(table.Media.ID.IN(tags)).
+		OR(table.Media.ID.IN(people))
+}
+
+// hasAnyTag matches media that has a tag with any of the names or an alias of it
+func hasAnyTag(names []string) postgres.BoolExpression {
+	n := lowerStrings(names)
+
+	tags := table.MediaTag.SELECT(table.MediaTag.MediaID).
+		FROM(table.MediaTag.
+			INNER_JOIN(table.Tag, table.Tag.ID.EQ(table.MediaTag.TagID)).
+			LEFT_JOIN(table.TagAlias, table.TagAlias.TagID.EQ(table.Tag.ID))).
+		WHERE(postgres.LOWER(table.Tag.Name).IN(n...).
+			OR(postgres.LOWER(table.TagAlias.Alias_).IN(n...)))
+
+	return table.Media.ID.IN(tags)
+}
+
+// hasAnyPerson matches media that has a person with any of the names or an alias of it
+func hasAnyPerson(names []string) postgres.BoolExpression {
+	n := lowerStrings(names)
+
+	people := table.MediaPerson.SELECT(table.MediaPerson.MediaID).
+		FROM(table.MediaPerson.
+			INNER_JOIN(table.Person, table.Person.ID.EQ(table.MediaPerson.PersonID)).
+			LEFT_JOIN(table.PersonAlias, table.PersonAlias.PersonID.EQ(table.Person.ID))).
+		WHERE(postgres.LOWER(table.Person.Name).IN(n...).
+			OR(postgres.LOWER(table.PersonAlias.Alias_).IN(n...)))
+
+	return table.Media.ID.IN(people)
+}
+
+func lowerStrings(strs []string) []postgres.Expression {
+	expressions := make([]postgres.Expression, len(strs))
+	for i, s := range strs {
+		expressions[i] = postgres.String(strings.ToLower(s))
+	}
+
+	return expressions
+}
+
+// hasRatedPerson matches media that has a person who the user rated at least the rating
+func hasRatedPerson(userId uuid.UUID, rating int16) postgres.BoolExpression {
+	people := table.MediaPerson.SELECT(table.MediaPerson.MediaID).
+		FROM(table.MediaPerson.
+			INNER_JOIN(table.PersonRating, table.PersonRating.PersonID.EQ(table.MediaPerson.PersonID))).
+		WHERE(table.PersonRating.UserID.EQ(postgres.UUID(userId)).
+			AND(table.PersonRating.Rating.GT_EQ(postgres.Int16(rating))))
+
+	return table.Media.ID.IN(people)
+}
+
+// mediaRelevance ranks how closely media matches the term from 0 to 1
+func mediaRelevance(term string) postgres.FloatExpression {
+	t := postgres.String(term)
+
+	tagScore := table.MediaTag.SELECT(postgres.MAXf(postgres.FloatExp(postgres.GREATEST(
+		wordSimilarity(t, table.Tag.Name),
+		postgres.COALESCE(wordSimilarity(t, table.TagAlias.Alias_), postgres.Float(0)),
+	)))).
+		FROM(table.MediaTag.
+			INNER_JOIN(table.Tag, table.Tag.ID.EQ(table.MediaTag.TagID)).
+			LEFT_JOIN(table.TagAlias, table.TagAlias.TagID.EQ(table.Tag.ID))).
+		WHERE(table.MediaTag.MediaID.EQ(table.Media.ID))
+
+	personScore := table.MediaPerson.SELECT(postgres.MAXf(postgres.FloatExp(postgres.GREATEST(
+		wordSimilarity(t, table.Person.Name),
+		postgres.COALESCE(wordSimilarity(t, table.PersonAlias.Alias_), postgres.Float(0)),
+	)))).
+		FROM(table.MediaPerson.
+			INNER_JOIN(table.Person, table.Person.ID.EQ(table.MediaPerson.PersonID)).
+			LEFT_JOIN(table.PersonAlias, table.PersonAlias.PersonID.EQ(table.Person.ID))).
+		WHERE(table.MediaPerson.MediaID.EQ(table.Media.ID))
+
+	return postgres.FloatExp(postgres.GREATEST(
+		wordSimilarity(t, table.Media.Title),
+		wordSimilarity(t, table.Media.Path).MUL(postgres.Float(pathWeight)),
+		postgres.FloatExp(postgres.COALESCE(tagScore, postgres.Float(0))).MUL(postgres.Float(tagWeight)),
+		postgres.FloatExp(postgres.COALESCE(personScore, postgres.Float(0))).MUL(postgres.Float(personWeight)),
+	))
+}
@@ -0,0 +1,94 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+)
+
+func searchStatement(search dto.MediaSearchDTO) (string, []interface{}) {
+	deleted, exists := false, true
+	search.Deleted = &deleted
+	search.Exists = &exists
+
+	statement := mediaOverviewStatement(uuid.New(), search,
+		func(r postgres.ReadableTable) postgres.ReadableTable { return r },
+		func(w postgres.BoolExpression) postgres.BoolExpression { return w }, false)
+
+	return statement.Sql()
+}
+
+func Test_SearchPattern_EscapesWildcards(t *testing.T) {
+	_, args := postgres.SELECT(searchPattern(`50%_off\`)).Sql()
+
+	assert.Eq(t, `%50\%\_off\\%`, args[0].(string))
+}
+
+func Test_MediaOverviewStatement_SearchMatchesTagsAndPeople(t *testing.T) {
+	actual, args := searchStatement(dto.MediaSearchDTO{Search: "alice"})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\",\n     GREATEST(WORD_SIMILARITY($1::text, media.title), WORD_SIMILARITY($2::text, media.path) * $3, COALESCE((\n          SELECT MAX(GREATEST(WORD_SIMILARITY($4::text, tag.name), COALESCE(WORD_SIMILARITY($5::text, tag_alias.alias), $6)))\n          FROM public.media_tag\n               INNER JOIN public.tag ON (tag.id = media_tag.tag_id)\n               LEFT JOIN public.tag_alias ON (tag_alias.tag_id = tag.id)\n          WHERE media_tag.media_id = media.id\n     ), $7) * $8, COALESCE((\n          SELECT MAX(GREATEST(WORD_SIMILARITY($9::text, person.name), COALESCE(WORD_SIMILARITY($10::text, person_alias.alias), $11)))\n          FROM public.media_person\n               INNER JOIN public.person ON (person.id = media_person.person_id)\n               LEFT JOIN public.person_alias ON (person_alias.person_id = person.id)\n          WHERE media_person.media_id = media.id\n     ), $12) * $13) AS \"relevance\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $14::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $15::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $16::uuid))\nWHERE ((((media.media_type = 'primary') AND (media.deleted = $17::boolean)) AND (media.exists = $18::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $19::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $20::uuid\n      ))))) AND ((((media.title ILIKE $21::text) OR (media.path ILIKE $22::text)) OR (media.id IN ((\n           SELECT media_tag.media_id AS \"media_tag.media_id\"\n           FROM public.media_tag\n                INNER JOIN public.tag ON (tag.id = media_tag.tag_id)\n                LEFT JOIN public.tag_alias ON (tag_alias.tag_id = tag.id)\n           WHERE (tag.name ILIKE $23::text) OR (tag_alias.alias ILIKE $24::text)\n      )))) OR (media.id IN ((\n           SELECT media_person.media_id AS \"media_person.media_id\"\n           FROM public.media_person\n                INNER JOIN public.person ON (person.id = media_person.person_id)\n                LEFT JOIN public.person_alias ON (person_alias.person_id = person.id)\n           WHERE (person.name ILIKE $25::text) OR (person_alias.alias ILIKE $26::text)\n      ))))\nORDER BY media.added DESC\nLIMIT $27\nOFFSET $28;\n"
+	assert.Eq(t, expected, actual)
+	assert.Eq(t, "%alice%", args[20])
+}
+
+func Test_MediaOverviewStatement_OrderByRelevance(t *testing.T) {
+	actual, _ := searchStatement(dto.MediaSearchDTO{Search: "alice", OrderBy: dto.MediaOrdinal_Relevance})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\",\n     GREATEST(WORD_SIMILARITY($1::text, media.title), WORD_SIMILARITY($2::text, media.path) * $3, COALESCE((\n          SELECT MAX(GREATEST(WORD_SIMILARITY($4::text, tag.name), COALESCE(WORD_SIMILARITY($5::text, tag_alias.alias), $6)))\n          FROM public.media_tag\n               INNER JOIN public.tag ON (tag.id = media_tag.tag_id)\n               LEFT JOIN public.tag_alias ON (tag_alias.tag_id = tag.id)\n          WHERE media_tag.media_id = media.id\n     ), $7) * $8, COALESCE((\n          SELECT MAX(GREATEST(WORD_SIMILARITY($9::text, person.name), COALESCE(WORD_SIMILARITY($10::text, person_alias.alias), $11)))\n          FROM public.media_person\n               INNER JOIN public.person ON (person.id = media_person.person_id)\n               LEFT JOIN public.person_alias ON (person_alias.person_id = person.id)\n          WHERE media_person.media_id = media.id\n     ), $12) * $13) AS \"relevance\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $14::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $15::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $16::uuid))\nWHERE ((((media.media_type = 'primary') AND (media.deleted = $17::boolean)) AND (media.exists = $18::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $19::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $20::uuid\n      ))))) AND ((((media.title ILIKE $21::text) OR (media.path ILIKE $22::text)) OR (media.id IN ((\n           SELECT media_tag.media_id AS \"media_tag.media_id\"\n           FROM public.media_tag\n                INNER JOIN public.tag ON (tag.id = media_tag.tag_id)\n                LEFT JOIN public.tag_alias ON (tag_alias.tag_id = tag.id)\n           WHERE (tag.name ILIKE $23::text) OR (tag_alias.alias ILIKE $24::text)\n      )))) OR (media.id IN ((\n           SELECT media_person.media_id AS \"media_person.media_id\"\n           FROM public.media_person\n                INNER JOIN public.person ON (person.id = media_person.person_id)\n                LEFT JOIN public.person_alias ON (person_alias.person_id = person.id)\n           WHERE (person.name ILIKE $25::text) OR (person_alias.alias ILIKE $26::text)\n      ))))\nORDER BY relevance DESC\nLIMIT $27\nOFFSET $28;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MediaOverviewStatement_OrderByRelevanceWithoutSearch(t *testing.T) {
+	actual, _ := searchStatement(dto.MediaSearchDTO{OrderBy: dto.MediaOrdinal_Relevance})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $1::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $2::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $3::uuid))\nWHERE (((media.media_type = 'primary') AND (media.deleted = $4::boolean)) AND (media.exists = $5::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $6::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $7::uuid\n      ))))\nORDER BY media.added DESC\nLIMIT $8\nOFFSET $9;\n"
+	assert.Eq(t, expected, actual)
+}
+
+// Media matches when it has any of the requested tags and any of the requested people, by name or alias
+func Test_MediaOverviewStatement_FiltersMatchAliases(t *testing.T) {
+	actual, args := searchStatement(dto.MediaSearchDTO{Tags: []string{"Sci-Fi", "drama"}, People: []string{"alice"}})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $1::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $2::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $3::uuid))\nWHERE (((((media.media_type = 'primary') AND (media.deleted = $4::boolean)) AND (media.exists = $5::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $6::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $7::uuid\n      ))))) AND (media.id IN ((\n           SELECT media_tag.media_id AS \"media_tag.media_id\"\n           FROM public.media_tag\n                INNER JOIN public.tag ON (tag.id = media_tag.tag_id)\n                LEFT JOIN public.tag_alias ON (tag_alias.tag_id = tag.id)\n           WHERE (LOWER(tag.name) IN ($8::text, $9::text)) OR (LOWER(tag_alias.alias) IN ($10::text, $11::text))\n      )))) AND (media.id IN ((\n           SELECT media_person.media_id AS \"media_person.media_id\"\n           FROM public.media_person\n                INNER JOIN public.person ON (person.id = media_person.person_id)\n                LEFT JOIN public.person_alias ON (person_alias.person_id = person.id)\n           WHERE (LOWER(person.name) IN ($12::text)) OR (LOWER(person_alias.alias) IN ($13::text))\n      )))\nORDER BY media.added DESC\nLIMIT $14\nOFFSET $15;\n"
+	assert.Eq(t, expected, actual)
+	assert.Eq(t, "sci-fi", args[7])
+	assert.Eq(t, "drama", args[8])
+	assert.Eq(t, "sci-fi", args[9])
+	assert.Eq(t, "alice", args[11])
+}
+
+func Test_PlaylistMediaOverviewStatement_InPlaylistOrderByDefault(t *testing.T) {
+	deleted, exists := false, true
+	search := dto.MediaSearchDTO{Deleted: &deleted, Exists: &exists}
+
+	actual, _ := playlistMediaOverviewStatement(uuid.New(), uuid.New(), search).Sql()
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $1::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $2::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $3::uuid))\n     INNER JOIN public.playlist_media ON (playlist_media.media_id = media.id)\nWHERE ((((media.media_type = 'primary') AND (media.deleted = $4::boolean)) AND (media.exists = $5::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $6::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $7::uuid\n      ))))) AND (playlist_media.playlist_id = $8::uuid)\nORDER BY playlist_media.position ASC\nLIMIT $9\nOFFSET $10;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MediaOverviewStatement_OrderByPositionOutsideOfPlaylist(t *testing.T) {
+	actual, _ := searchStatement(dto.MediaSearchDTO{OrderBy: dto.MediaOrdinal_Position})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $1::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $2::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $3::uuid))\nWHERE (((media.media_type = 'primary') AND (media.deleted = $4::boolean)) AND (media.exists = $5::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $6::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $7::uuid\n      ))))\nORDER BY media.added DESC\nLIMIT $8\nOFFSET $9;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MediaOverviewStatement_OrderByRatingUnratedLast(t *testing.T) {
+	actual, _ := searchStatement(dto.MediaSearchDTO{OrderBy: dto.MediaOrdinal_Rating})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $1::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $2::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $3::uuid))\nWHERE (((media.media_type = 'primary') AND (media.deleted = $4::boolean)) AND (media.exists = $5::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $6::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $7::uuid\n      ))))\nORDER BY media_rating.rating DESC NULLS LAST\nLIMIT $8\nOFFSET $9;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MediaOverviewStatement_FiltersMinRatings(t *testing.T) {
+	min := int16(4)
+	actual, _ := searchStatement(dto.MediaSearchDTO{MinRating: &min, MinPersonRating: &min})
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.title AS \"media.title\",\n     media_progress.timestamp AS \"media_progress.timestamp\",\n     media_progress.play_count AS \"media_progress.play_count\",\n     media_progress.watched AS \"media_progress.watched\",\n     video.runtime AS \"video.runtime\",\n     favourite_media.id AS \"favourite_media.id\",\n     media_rating.id AS \"media_rating.id\",\n     media_rating.rating AS \"media_rating.rating\",\n     COUNT(*) OVER () AS \"total\"\nFROM public.media\n     LEFT JOIN public.media_relation ON ((media.id = media_relation.media_id) AND (media_relation.relation_type = 'thumbnail'))\n     LEFT JOIN public.video ON (video.media_id = media.id)\n     LEFT JOIN public.media_progress ON ((media_progress.media_id = media.id) AND (media_progress.user_id = $1::uuid))\n     LEFT JOIN public.favourite_media ON ((favourite_media.media_id = media.id) AND (favourite_media.user_id = $2::uuid))\n     LEFT JOIN public.media_rating ON ((media_rating.media_id = media.id) AND (media_rating.user_id = $3::uuid))\nWHERE (((((media.media_type = 'primary') AND (media.deleted = $4::boolean)) AND (media.exists = $5::boolean)) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $6::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $7::uuid\n      ))))) AND (media_rating.rating >= $8::smallint)) AND (media.id IN ((\n           SELECT media_person.media_id AS \"media_person.media_id\"\n           FROM public.media_person\n                INNER JOIN public.person_rating ON (person_rating.person_id = media_person.person_id)\n           WHERE (person_rating.user_id = $9::uuid) AND (person_rating.rating >= $10::smallint)\n      )))\nORDER BY media.added DESC\nLIMIT $11\nOFFSET $12;\n"
+	assert.Eq(t, expected, actual)
+}
@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+)
+
+// MergeTable is a table that links the entities that are merged to other entities
+type MergeTable struct {
+	Table    postgres.Table
+	ID       postgres.ColumnString
+	Owner    postgres.ColumnString
+	Key      postgres.ColumnString
+	Modified postgres.ColumnTimestamp
+}
+
+// MoveStatement moves the most recently modified row for every key of the merged owners over to the kept owner.
+// Keys that the kept owner already has are not moved.
+func (t MergeTable) MoveStatement(keepId uuid.UUID, mergeIds []postgres.Expression) postgres.Statement {
+	latestPerKey := t.Table.SELECT(t.ID).
+		DISTINCT(t.Key).
+		FROM(t.Table).
+		WHERE(t.Owner.IN(mergeIds...)).
+		ORDER_BY(t.Key, t.Modified.DESC())
+
+	keptKeys := t.Table.SELECT(t.Key).
+		FROM(t.Table).
+		WHERE(t.Owner.EQ(postgres.UUID(keepId)))
+
+	return t.Table.UPDATE(t.Owner, t.Modified).
+		SET(postgres.UUID(keepId), postgres.LOCALTIMESTAMP()).
+		WHERE(t.ID.IN(latestPerKey).
+			AND(t.Key.NOT_IN(keptKeys)))
+}
+
+// UUIDExpressions converts ids so that they can be used in IN expressions
+func UUIDExpressions(ids []uuid.UUID) []postgres.Expression {
+	expressions := make([]postgres.Expression, len(ids))
+	for i, id := range ids {
+		expressions[i] = postgres.UUID(id)
+	}
+
+	return expressions
+}
@@ -23,10 +23,17 @@ type JobStatement struct {
 
 type JobRepository interface {
 	CreateAll(jobs []model.Job) ([]model.Job, error)
-	GetNextJob() (*model.Job, error)
+	GetNextJob(jobTypes []model.JobTypeEnum) (*model.Job, error)
 	UpdateJobStatus(model *model.Job) error
+	UpdateProgress(model *model.Job) error
 	GetAll(dto.JobSearchDTO) (*dto.PageDTO[model.Job], error)
 	CancelInprogress() error
+	GetById(id uuid.UUID) (*model.Job, error)
+	CancelNotStarted(id uuid.UUID) (*model.Job, error)
+	CancelNotStartedChildren(id uuid.UUID) ([]model.Job, error)
+	DeleteAll(dto.JobSearchDTO) ([]model.Job, error)
+	GetTree(id uuid.UUID) ([]model.Job, error)
+	CountChildrenByStatus(id uuid.UUID) (map[model.JobStatusEnum]int, error)
 }
 
 type jobRepository struct {
@@ -87,9 +94,13 @@ func (j *jobRepository) CreateAll(jobs []model.Job) ([]model.Job, error) {
 	return jobModels, nil
 }
 
-func (j *jobRepository) GetNextJob() (*model.Job, error) {
+func (j *jobRepository) GetNextJob(jobTypes []model.JobTypeEnum) (*model.Job, error) {
+	if len(jobTypes) == 0 {
+		return nil, nil
+	}
+
 	var job []struct{ model.Job }
-	if err := j.getNextJobStatement().Query(&job); err != nil {
+	if err := j.getNextJobStatement(jobTypes).Query(&job); err != nil {
 		return nil, errs.BuildError(err, "could not get next job")
 	}
 	if len(job) == 1 {
@@ -108,6 +119,15 @@ func (j *jobRepository) UpdateJobStatus(model *model.Job) error {
 	return nil
 }
 
+func (j *jobRepository) UpdateProgress(model *model.Job) error {
+	model.Modified = time.Now()
+	if _, err := j.updateProgressStatement(model).Exec(); err != nil {
+		return errs.BuildError(err, "could not update job %v progress to %v", model.ID, model.Progress)
+	}
+
+	return nil
+}
+
 func (r *jobRepository) GetAll(m dto.JobSearchDTO) (*dto.PageDTO[model.Job], error) {
 	if m.Limit == 0 {
 		m.Limit = 100
@@ -121,30 +141,7 @@ func (r *jobRepository) GetAll(m dto.JobSearchDTO) (*dto.PageDTO[model.Job], err
 
 	countStatement := table.Job.SELECT(postgres.COUNT(table.Job.ID).AS("total")).FROM(table.Job)
 
-	var whereExpression postgres.BoolExpression
-	if m.Parent == nil {
-		whereExpression = table.Job.Parent.IS_NULL()
-	} else {
-		id, _ := uuid.Parse(*m.Parent)
-		whereExpression = table.Job.Parent.EQ(postgres.UUID(id))
-	}
-
-	statusExpressions := make([]postgres.Expression, len(m.Statuses))
-	for i, s := range m.Statuses {
-		statusExpressions[i] = postgres.NewEnumValue(string(s))
-	}
-	if len(statusExpressions) > 0 {
-		whereExpression = whereExpression.AND(table.Job.Status.IN(statusExpressions...))
-	}
-
-	jobTypeExpression := make([]postgres.Expression, len(m.JobTypes))
-	for i, t := range m.JobTypes {
-		jobTypeExpression[i] = postgres.NewEnumValue(string(t))
-	}
-	if len(jobTypeExpression) > 0 {
-		whereExpression = whereExpression.AND(table.Job.JobType.IN(jobTypeExpression...))
-	}
-
+	whereExpression := jobSearchExpression(m)
 	statement = statement.WHERE(whereExpression)
 	countStatement = countStatement.WHERE(whereExpression)
 
@@ -175,3 +172,136 @@ func (r *jobRepository) GetAll(m dto.JobSearchDTO) (*dto.PageDTO[model.Job], err
 		Data:  jobs,
 	}, nil
 }
+
+// GetById implements JobRepository.
+func (r *jobRepository) GetById(id uuid.UUID) (*model.Job, error) {
+	statement := table.Job.SELECT(table.Job.AllColumns).
+		FROM(table.Job).
+		WHERE(table.Job.ID.EQ(postgres.UUID(id)))
+
+	util.DebugCheck(r.env, statement)
+
+	var jobs []struct{ model.Job }
+	if err := statement.QueryContext(r.ctx, r.db, &jobs); err != nil {
+		return nil, errs.BuildError(err, "could not get job by id: %v", id.String())
+	}
+
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	return &jobs[0].Job, nil
+}
+
+// CancelNotStarted implements JobRepository.
+// It returns nil when the job has already been picked up by the job runner.
+func (r *jobRepository) CancelNotStarted(id uuid.UUID) (*model.Job, error) {
+	var jobs []struct{ model.Job }
+	if err := r.cancelNotStartedStatement(id).Query(&jobs); err != nil {
+		return nil, errs.BuildError(err, "could not cancel job: %v", id.String())
+	}
+
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	return &jobs[0].Job, nil
+}
+
+// CancelNotStartedChildren implements JobRepository.
+// Children that have already been picked up by the job runner are left alone.
+func (r *jobRepository) CancelNotStartedChildren(id uuid.UUID) ([]model.Job, error) {
+	var jobsStruct []struct{ model.Job }
+	if err := r.cancelNotStartedChildrenStatement(id).Query(&jobsStruct); err != nil {
+		return nil, errs.BuildError(err, "could not cancel children of job: %v", id.String())
+	}
+
+	jobs := make([]model.Job, len(jobsStruct))
+	for i, j := range jobsStruct {
+		jobs[i] = j.Job
+	}
+
+	return jobs, nil
+}
+
+// DeleteAll implements JobRepository.
+// Jobs that are in progress are never deleted. Children of deleted jobs are deleted along with them.
+func (r *jobRepository) DeleteAll(m dto.JobSearchDTO) ([]model.Job, error) {
+	var jobsStruct []struct{ model.Job }
+	if err := r.deleteAllStatement(m).Query(&jobsStruct); err != nil {
+		return nil, errs.BuildError(err, "could not delete jobs with %v", m)
+	}
+
+	jobs := make([]model.Job, len(jobsStruct))
+	for i, j := range jobsStruct {
+		jobs[i] = j.Job
+	}
+
+	return jobs, nil
+}
+
+// GetTree implements JobRepository.
+// The job is returned first followed by all of its descendants. Nothing is returned when the job does not exist.
+func (r *jobRepository) GetTree(id uuid.UUID) ([]model.Job, error) {
+	var jobsStruct []struct{ model.Job }
+	if err := r.getTreeStatement(id).Query(&jobsStruct); err != nil {
+		return nil, errs.BuildError(err, "could not get job tree for: %v", id.String())
+	}
+
+	jobs := []model.Job{}
+	for _, j := range jobsStruct {
+		if j.Job.ID == id {
+			jobs = append([]model.Job{j.Job}, jobs...)
+			continue
+		}
+		jobs = append(jobs, j.Job)
+	}
+
+	return jobs, nil
+}
+
+// CountChildrenByStatus implements JobRepository.
+func (r *jobRepository) CountChildrenByStatus(id uuid.UUID) (map[model.JobStatusEnum]int, error) {
+	var counts []struct {
+		model.Job
+		Total int
+	}
+	if err := r.countChildrenByStatusStatement(id).Query(&counts); err != nil {
+		return nil, errs.BuildError(err, "could not count children of job: %v", id.String())
+	}
+
+	result := map[model.JobStatusEnum]int{}
+	for _, c := range counts {
+		result[c.Status] = c.Total
+	}
+
+	return result, nil
+}
+
+func jobSearchExpression(m dto.JobSearchDTO) postgres.BoolExpression {
+	var whereExpression postgres.BoolExpression
+	if m.Parent == nil {
+		whereExpression = table.Job.Parent.IS_NULL()
+	} else {
+		id, _ := uuid.Parse(*m.Parent)
+		whereExpression = table.Job.Parent.EQ(postgres.UUID(id))
+	}
+
+	statusExpressions := make([]postgres.Expression, len(m.Statuses))
+	for i, s := range m.Statuses {
+		statusExpressions[i] = postgres.NewEnumValue(string(s))
+	}
+	if len(statusExpressions) > 0 {
+		whereExpression = whereExpression.AND(table.Job.Status.IN(statusExpressions...))
+	}
+
+	jobTypeExpression := make([]postgres.Expression, len(m.JobTypes))
+	for i, t := range m.JobTypes {
+		jobTypeExpression[i] = postgres.NewEnumValue(string(t))
+	}
+	if len(jobTypeExpression) > 0 {
+		whereExpression = whereExpression.AND(table.Job.JobType.IN(jobTypeExpression...))
+	}
+
+	return whereExpression
+}
@@ -2,10 +2,13 @@ package jobRepository
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
 )
 
@@ -27,12 +30,29 @@ func (jb *jobRepository) createAllStatement(jobs []model.Job) JobStatement {
 	return JobStatement{db: jb.db, Statement: statement}
 }
 
-func (jb *jobRepository) getNextJobStatement() JobStatement {
-	statement := table.Job.SELECT(table.Job.AllColumns).
+// getNextJobStatement claims the next job of one of the job types by marking it as in progress.
+// Rows that are locked by another runner are skipped so that a job can only be claimed once.
+func (jb *jobRepository) getNextJobStatement(jobTypes []model.JobTypeEnum) JobStatement {
+	jobTypeExpressions := make([]postgres.Expression, len(jobTypes))
+	for i, t := range jobTypes {
+		jobTypeExpressions[i] = postgres.NewEnumValue(string(t))
+	}
+
+	nextJob := table.Job.SELECT(table.Job.ID).
 		FROM(table.Job).
-		WHERE(table.Job.Status.EQ(postgres.NewEnumValue(string(model.JobStatusEnum_NotStarted)))).
+		WHERE(table.Job.Status.EQ(postgres.NewEnumValue(string(model.JobStatusEnum_NotStarted))).
+			AND(table.Job.JobType.IN(jobTypeExpressions...))).
 		ORDER_BY(table.Job.Priority.ASC(), table.Job.Created.ASC()).
-		LIMIT(1)
+		LIMIT(1).
+		FOR(postgres.UPDATE().SKIP_LOCKED())
+
+	statement := table.Job.UPDATE(table.Job.Status, table.Job.Modified).
+		MODEL(model.Job{
+			Status:   model.JobStatusEnum_InProgress,
+			Modified: time.Now(),
+		}).
+		WHERE(table.Job.ID.IN(nextJob)).
+		RETURNING(table.Job.AllColumns)
 
 	util.DebugCheck(jb.env, statement)
 
@@ -40,7 +60,93 @@ func (jb *jobRepository) getNextJobStatement() JobStatement {
 }
 
 func (jb *jobRepository) updateJobStatusStatement(model *model.Job) JobStatement {
-	statement := table.Job.UPDATE(table.Job.Modified, table.Job.Status, table.Job.Outcome).
+	statement := table.Job.UPDATE(table.Job.Modified, table.Job.Status, table.Job.Outcome, table.Job.Progress, table.Job.Step).
+		MODEL(model).
+		WHERE(table.Job.ID.EQ(postgres.UUID(model.ID)))
+
+	util.DebugCheck(jb.env, statement)
+
+	return JobStatement{statement, jb.db, jb.ctx}
+}
+
+func (jb *jobRepository) cancelNotStartedStatement(id uuid.UUID) JobStatement {
+	outcome := "cancelled before it was started"
+	statement := table.Job.UPDATE(table.Job.Status, table.Job.Modified, table.Job.Outcome).
+		MODEL(model.Job{
+			Status:   model.JobStatusEnum_Cancelled,
+			Modified: time.Now(),
+			Outcome:  &outcome,
+		}).
+		WHERE(table.Job.ID.EQ(postgres.UUID(id)).
+			AND(table.Job.Status.EQ(postgres.NewEnumValue(string(model.JobStatusEnum_NotStarted))))).
+		RETURNING(table.Job.AllColumns)
+
+	util.DebugCheck(jb.env, statement)
+
+	return JobStatement{statement, jb.db, jb.ctx}
+}
+
+func (jb *jobRepository) cancelNotStartedChildrenStatement(id uuid.UUID) JobStatement {
+	outcome := "cancelled before it was started"
+	statement := table.Job.UPDATE(table.Job.Status, table.Job.Modified, table.Job.Outcome).
+		MODEL(model.Job{
+			Status:   model.JobStatusEnum_Cancelled,
+			Modified: time.Now(),
+			Outcome:  &outcome,
+		}).
+		WHERE(table.Job.Parent.EQ(postgres.UUID(id)).
+			AND(table.Job.Status.EQ(postgres.NewEnumValue(string(model.JobStatusEnum_NotStarted))))).
+		RETURNING(table.Job.AllColumns)
+
+	util.DebugCheck(jb.env, statement)
+
+	return JobStatement{statement, jb.db, jb.ctx}
+}
+
+// deleteAllStatement deletes finished jobs that match the search together with all of their descendants.
+// A job is left alone while anything in its tree has not started or is still in progress.
+func (jb *jobRepository) deleteAllStatement(m dto.JobSearchDTO) JobStatement {
+	tree := postgres.CTE("tree")
+	root := postgres.StringColumn("root")
+
+	finished := table.Job.Status.IN(
+		postgres.NewEnumValue(string(model.JobStatusEnum_Completed)),
+		postgres.NewEnumValue(string(model.JobStatusEnum_Failed)),
+		postgres.NewEnumValue(string(model.JobStatusEnum_Cancelled)),
+	)
+
+	unfinishedRoots := tree.SELECT(root.From(tree)).
+		WHERE(table.Job.Status.From(tree).IN(
+			postgres.NewEnumValue(string(model.JobStatusEnum_NotStarted)),
+			postgres.NewEnumValue(string(model.JobStatusEnum_InProgress)),
+		))
+
+	statement := postgres.WITH_RECURSIVE(
+		tree.AS(
+			table.Job.SELECT(table.Job.ID, table.Job.Status, table.Job.ID.AS("root")).
+				FROM(table.Job).
+				WHERE(jobSearchExpression(m).AND(finished)).
+				UNION_ALL(
+					table.Job.SELECT(table.Job.ID, table.Job.Status, root.From(tree)).
+						FROM(table.Job.INNER_JOIN(tree, table.Job.Parent.EQ(table.Job.ID.From(tree)))),
+				),
+		),
+	)(
+		table.Job.DELETE().
+			WHERE(table.Job.ID.IN(
+				tree.SELECT(table.Job.ID.From(tree)).
+					WHERE(root.From(tree).NOT_IN(unfinishedRoots)),
+			)).
+			RETURNING(table.Job.AllColumns),
+	)
+
+	util.DebugCheck(jb.env, statement)
+
+	return JobStatement{statement, jb.db, jb.ctx}
+}
+
+func (jb *jobRepository) updateProgressStatement(model *model.Job) JobStatement {
+	statement := table.Job.UPDATE(table.Job.Modified, table.Job.Progress, table.Job.Step).
 		MODEL(model).
 		WHERE(table.Job.ID.EQ(postgres.UUID(model.ID)))
 
@@ -48,3 +154,39 @@ func (jb *jobRepository) updateJobStatusStatement(model *model.Job) JobStatement
 
 	return JobStatement{statement, jb.db, jb.ctx}
 }
+
+// getTreeStatement selects the job and all of its descendants
+func (jb *jobRepository) getTreeStatement(id uuid.UUID) JobStatement {
+	tree := postgres.CTE("tree")
+
+	statement := postgres.WITH_RECURSIVE(
+		tree.AS(
+			table.Job.SELECT(table.Job.AllColumns).
+				FROM(table.Job).
+				WHERE(table.Job.ID.EQ(postgres.UUID(id))).
+				UNION_ALL(
+					table.Job.SELECT(table.Job.AllColumns).
+						FROM(table.Job.INNER_JOIN(tree, table.Job.Parent.EQ(table.Job.ID.From(tree)))),
+				),
+		),
+	)(
+		postgres.SELECT(tree.AllColumns()).
+			FROM(tree).
+			ORDER_BY(table.Job.Created.From(tree).ASC()),
+	)
+
+	util.DebugCheck(jb.env, statement)
+
+	return JobStatement{statement, jb.db, jb.ctx}
+}
+
+func (jb *jobRepository) countChildrenByStatusStatement(id uuid.UUID) JobStatement {
+	statement := table.Job.SELECT(table.Job.Status, postgres.COUNT(table.Job.ID).AS("total")).
+		FROM(table.Job).
+		WHERE(table.Job.Parent.EQ(postgres.UUID(id))).
+		GROUP_BY(table.Job.Status)
+
+	util.DebugCheck(jb.env, statement)
+
+	return JobStatement{statement, jb.db, jb.ctx}
+}
@@ -0,0 +1,62 @@
+package jobRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+)
+
+var s = jobRepository{
+	env: &environment.EnvironmentVariables{DebugSql: false},
+}
+
+func Test_GetNextJobStatement(t *testing.T) {
+	actual, _ := s.getNextJobStatement([]model.JobTypeEnum{model.JobTypeEnum_GenerateChecksum, model.JobTypeEnum_Convert}).Sql()
+
+	expected := "\nUPDATE public.job\nSET (status, modified) = ($1, $2)\nWHERE job.id IN ((\n           SELECT job.id AS \"job.id\"\n           FROM public.job\n           WHERE (job.status = 'not_started') AND (job.job_type IN ('generate_checksum', 'convert'))\n           ORDER BY job.priority ASC, job.created ASC\n           LIMIT $3\n           FOR UPDATE SKIP LOCKED\n      ))\nRETURNING job.id AS \"job.id\",\n          job.parent AS \"job.parent\",\n          job.priority AS \"job.priority\",\n          job.job_type AS \"job.job_type\",\n          job.status AS \"job.status\",\n          job.data AS \"job.data\",\n          job.outcome AS \"job.outcome\",\n          job.created AS \"job.created\",\n          job.modified AS \"job.modified\",\n          job.progress AS \"job.progress\",\n          job.step AS \"job.step\";\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_CancelNotStartedStatement(t *testing.T) {
+	actual, _ := s.cancelNotStartedStatement(uuid.New()).Sql()
+
+	expected := "\nUPDATE public.job\nSET (status, modified, outcome) = ($1, $2, $3)\nWHERE (job.id = $4::uuid) AND (job.status = 'not_started')\nRETURNING job.id AS \"job.id\",\n          job.parent AS \"job.parent\",\n          job.priority AS \"job.priority\",\n          job.job_type AS \"job.job_type\",\n          job.status AS \"job.status\",\n          job.data AS \"job.data\",\n          job.outcome AS \"job.outcome\",\n          job.created AS \"job.created\",\n          job.modified AS \"job.modified\",\n          job.progress AS \"job.progress\",\n          job.step AS \"job.step\";\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_CancelNotStartedChildrenStatement(t *testing.T) {
+	actual, _ := s.cancelNotStartedChildrenStatement(uuid.New()).Sql()
+
+	expected := "\nUPDATE public.job\nSET (status, modified, outcome) = ($1, $2, $3)\nWHERE (job.parent = $4::uuid) AND (job.status = 'not_started')\nRETURNING job.id AS \"job.id\",\n          job.parent AS \"job.parent\",\n          job.priority AS \"job.priority\",\n          job.job_type AS \"job.job_type\",\n          job.status AS \"job.status\",\n          job.data AS \"job.data\",\n          job.outcome AS \"job.outcome\",\n          job.created AS \"job.created\",\n          job.modified AS \"job.modified\",\n          job.progress AS \"job.progress\",\n          job.step AS \"job.step\";\n"
+	assert.Eq(t, expected, actual)
+}
+
+// The recursive part of the tree follows job.parent from every level so grandchildren are deleted with their root
+// and a tree is kept when any descendant has not started or is in progress
+func Test_DeleteAllStatement(t *testing.T) {
+	actual, _ := s.deleteAllStatement(dto.JobSearchDTO{
+		Statuses: []model.JobStatusEnum{model.JobStatusEnum_Failed},
+		JobTypes: []model.JobTypeEnum{model.JobTypeEnum_Convert},
+	}).Sql()
+
+	expected := "\nWITH RECURSIVE tree AS (\n     (\n          SELECT job.id AS \"job.id\",\n               job.status AS \"job.status\",\n               job.id AS \"root\"\n          FROM public.job\n          WHERE ((job.parent IS NULL AND (job.status IN ('failed'))) AND (job.job_type IN ('convert'))) AND (job.status IN ('completed', 'failed', 'cancelled'))\n     )\n     UNION ALL\n     (\n          SELECT job.id AS \"job.id\",\n               job.status AS \"job.status\",\n               tree.root AS \"root\"\n          FROM public.job\n               INNER JOIN tree ON (job.parent = tree.\"job.id\")\n     )\n)\nDELETE FROM public.job\nWHERE job.id IN ((\n           SELECT tree.\"job.id\" AS \"job.id\"\n           FROM tree\n           WHERE tree.root NOT IN ((\n                      SELECT tree.root AS \"root\"\n                      FROM tree\n                      WHERE tree.\"job.status\" IN ('not_started', 'in_progress')\n                 ))\n      ))\nRETURNING job.id AS \"job.id\",\n          job.parent AS \"job.parent\",\n          job.priority AS \"job.priority\",\n          job.job_type AS \"job.job_type\",\n          job.status AS \"job.status\",\n          job.data AS \"job.data\",\n          job.outcome AS \"job.outcome\",\n          job.created AS \"job.created\",\n          job.modified AS \"job.modified\",\n          job.progress AS \"job.progress\",\n          job.step AS \"job.step\";\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_GetTreeStatement(t *testing.T) {
+	actual, _ := s.getTreeStatement(uuid.New()).Sql()
+
+	expected := "\nWITH RECURSIVE tree AS (\n     (\n          SELECT job.id AS \"job.id\",\n               job.parent AS \"job.parent\",\n               job.priority AS \"job.priority\",\n               job.job_type AS \"job.job_type\",\n               job.status AS \"job.status\",\n               job.data AS \"job.data\",\n               job.outcome AS \"job.outcome\",\n               job.created AS \"job.created\",\n               job.modified AS \"job.modified\",\n               job.progress AS \"job.progress\",\n               job.step AS \"job.step\"\n          FROM public.job\n          WHERE job.id = $1::uuid\n     )\n     UNION ALL\n     (\n          SELECT job.id AS \"job.id\",\n               job.parent AS \"job.parent\",\n               job.priority AS \"job.priority\",\n               job.job_type AS \"job.job_type\",\n               job.status AS \"job.status\",\n               job.data AS \"job.data\",\n               job.outcome AS \"job.outcome\",\n               job.created AS \"job.created\",\n               job.modified AS \"job.modified\",\n               job.progress AS \"job.progress\",\n               job.step AS \"job.step\"\n          FROM public.job\n               INNER JOIN tree ON (job.parent = tree.\"job.id\")\n     )\n)\nSELECT tree.\"job.id\" AS \"job.id\",\n     tree.\"job.parent\" AS \"job.parent\",\n     tree.\"job.priority\" AS \"job.priority\",\n     tree.\"job.job_type\" AS \"job.job_type\",\n     tree.\"job.status\" AS \"job.status\",\n     tree.\"job.data\" AS \"job.data\",\n     tree.\"job.outcome\" AS \"job.outcome\",\n     tree.\"job.created\" AS \"job.created\",\n     tree.\"job.modified\" AS \"job.modified\",\n     tree.\"job.progress\" AS \"job.progress\",\n     tree.\"job.step\" AS \"job.step\"\nFROM tree\nORDER BY tree.\"job.created\" ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_CountChildrenByStatusStatement(t *testing.T) {
+	actual, _ := s.countChildrenByStatusStatement(uuid.New()).Sql()
+
+	expected := "\nSELECT job.status AS \"job.status\",\n     COUNT(job.id) AS \"total\"\nFROM public.job\nWHERE job.parent = $1::uuid\nGROUP BY job.status;\n"
+	assert.Eq(t, expected, actual)
+}
@@ -0,0 +1,233 @@
+package jobScheduleRepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+type JobScheduleRepository interface {
+	GetAll() ([]model.JobSchedule, error)
+	GetById(id uuid.UUID) (*model.JobSchedule, error)
+	GetDue(now time.Time) ([]model.JobSchedule, error)
+	Create(m model.JobSchedule) (*model.JobSchedule, error)
+	Update(m model.JobSchedule) (*model.JobSchedule, error)
+	Run(m model.JobSchedule, previousNextRun *time.Time, job model.Job) (*model.Job, error)
+	Delete(id uuid.UUID) error
+}
+
+type jobScheduleRepository struct {
+	env *environment.EnvironmentVariables
+	db  *sql.DB
+	ctx context.Context
+}
+
+// GetAll implements JobScheduleRepository.
+func (r *jobScheduleRepository) GetAll() ([]model.JobSchedule, error) {
+	statement := table.JobSchedule.SELECT(table.JobSchedule.AllColumns).
+		FROM(table.JobSchedule).
+		ORDER_BY(table.JobSchedule.Name.ASC())
+
+	util.DebugCheck(r.env, statement)
+
+	var schedules []model.JobSchedule
+	if err := statement.QueryContext(r.ctx, r.db, &schedules); err != nil {
+		return nil, errs.BuildError(err, "could not query job schedules")
+	}
+
+	return schedules, nil
+}
+
+// GetById implements JobScheduleRepository.
+func (r *jobScheduleRepository) GetById(id uuid.UUID) (*model.JobSchedule, error) {
+	statement := table.JobSchedule.SELECT(table.JobSchedule.AllColumns).
+		FROM(table.JobSchedule).
+		WHERE(table.JobSchedule.ID.EQ(postgres.UUID(id)))
+
+	util.DebugCheck(r.env, statement)
+
+	var schedules []model.JobSchedule
+	if err := statement.QueryContext(r.ctx, r.db, &schedules); err != nil {
+		return nil, errs.BuildError(err, "could not query job schedule by id: %v", id.String())
+	}
+
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+
+	return &schedules[0], nil
+}
+
+// GetDue implements JobScheduleRepository.
+func (r *jobScheduleRepository) GetDue(now time.Time) ([]model.JobSchedule, error) {
+	statement := table.JobSchedule.SELECT(table.JobSchedule.AllColumns).
+		FROM(table.JobSchedule).
+		WHERE(table.JobSchedule.Enabled.IS_TRUE().
+			AND(table.JobSchedule.NextRun.LT_EQ(postgres.TimestampT(now)))).
+		ORDER_BY(table.JobSchedule.NextRun.ASC())
+
+	util.DebugCheck(r.env, statement)
+
+	var schedules []model.JobSchedule
+	if err := statement.QueryContext(r.ctx, r.db, &schedules); err != nil {
+		return nil, errs.BuildError(err, "could not query due job schedules")
+	}
+
+	return schedules, nil
+}
+
+// Create implements JobScheduleRepository.
+func (r *jobScheduleRepository) Create(m model.JobSchedule) (*model.JobSchedule, error) {
+	statement := table.JobSchedule.INSERT(
+		table.JobSchedule.Name,
+		table.JobSchedule.Cron,
+		table.JobSchedule.JobType,
+		table.JobSchedule.Data,
+		table.JobSchedule.Priority,
+		table.JobSchedule.Enabled,
+		table.JobSchedule.NextRun,
+	).
+		MODEL(m).
+		RETURNING(table.JobSchedule.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var schedule model.JobSchedule
+	if err := statement.QueryContext(r.ctx, r.db, &schedule); err != nil {
+		return nil, errs.BuildError(err, "could not create job schedule")
+	}
+
+	return &schedule, nil
+}
+
+// Update implements JobScheduleRepository.
+func (r *jobScheduleRepository) Update(m model.JobSchedule) (*model.JobSchedule, error) {
+	m.Modified = time.Now()
+
+	statement := table.JobSchedule.UPDATE(
+		table.JobSchedule.Name,
+		table.JobSchedule.Cron,
+		table.JobSchedule.JobType,
+		table.JobSchedule.Data,
+		table.JobSchedule.Priority,
+		table.JobSchedule.Enabled,
+		table.JobSchedule.NextRun,
+		table.JobSchedule.Modified,
+	).
+		MODEL(m).
+		WHERE(table.JobSchedule.ID.EQ(postgres.UUID(m.ID))).
+		RETURNING(table.JobSchedule.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var schedules []model.JobSchedule
+	if err := statement.QueryContext(r.ctx, r.db, &schedules); err != nil {
+		return nil, errs.BuildError(err, "could not update job schedule: %v", m.ID.String())
+	}
+
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+
+	return &schedules[0], nil
+}
+
+// Run implements JobScheduleRepository.
+// The job is created and the schedule moved on to its next run in one transaction so that a failed job never skips a run.
+// The schedule is only run while next run is still previousNextRun so that it is only run once when more than one
+// scheduler is running. No job is returned when another scheduler has already run it.
+func (r *jobScheduleRepository) Run(m model.JobSchedule, previousNextRun *time.Time, job model.Job) (*model.Job, error) {
+	tx, err := r.db.BeginTx(r.ctx, nil)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not begin transaction to run job schedule: %v", m.ID.String())
+	}
+	defer tx.Rollback()
+
+	updateStatement := r.updateRunStatement(m, previousNextRun)
+
+	util.DebugCheck(r.env, updateStatement)
+
+	res, err := updateStatement.ExecContext(r.ctx, tx)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not update run of job schedule: %v", m.ID.String())
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, errs.BuildError(err, "could not determine if job schedule was updated: %v", m.ID.String())
+	}
+
+	if affected != 1 {
+		return nil, nil
+	}
+
+	createStatement := table.Job.INSERT(table.Job.JobType, table.Job.Status, table.Job.Data, table.Job.Priority).
+		MODEL(job).
+		RETURNING(table.Job.AllColumns)
+
+	util.DebugCheck(r.env, createStatement)
+
+	var created model.Job
+	if err := createStatement.QueryContext(r.ctx, tx, &created); err != nil {
+		return nil, errs.BuildError(err, "could not create job for job schedule: %v", m.ID.String())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errs.BuildError(err, "could not commit run of job schedule: %v", m.ID.String())
+	}
+
+	return &created, nil
+}
+
+func (r *jobScheduleRepository) updateRunStatement(m model.JobSchedule, previousNextRun *time.Time) postgres.UpdateStatement {
+	m.Modified = time.Now()
+
+	whereExpression := table.JobSchedule.ID.EQ(postgres.UUID(m.ID))
+	if previousNextRun == nil {
+		whereExpression = whereExpression.AND(table.JobSchedule.NextRun.IS_NULL())
+	} else {
+		whereExpression = whereExpression.AND(table.JobSchedule.NextRun.EQ(postgres.TimestampT(*previousNextRun)))
+	}
+
+	return table.JobSchedule.UPDATE(table.JobSchedule.LastRun, table.JobSchedule.NextRun, table.JobSchedule.Modified).
+		MODEL(m).
+		WHERE(whereExpression)
+}
+
+// Delete implements JobScheduleRepository.
+func (r *jobScheduleRepository) Delete(id uuid.UUID) error {
+	statement := table.JobSchedule.DELETE().
+		WHERE(table.JobSchedule.ID.EQ(postgres.UUID(id)))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete job schedule: %v", id.String())
+	}
+
+	return nil
+}
+
+var jobScheduleRepositoryInstance *jobScheduleRepository
+
+func New(env *environment.EnvironmentVariables, db *sql.DB, context context.Context) JobScheduleRepository {
+	if jobScheduleRepositoryInstance != nil {
+		return jobScheduleRepositoryInstance
+	}
+
+	jobScheduleRepositoryInstance = &jobScheduleRepository{
+		env: env,
+		db:  db,
+		ctx: context,
+	}
+
+	return jobScheduleRepositoryInstance
+}
@@ -20,10 +20,13 @@ import (
 type LibraryRepository interface {
 	Create(name string) (*model.Library, error)
 	GetByName(name string) (*model.Library, error)
-	GetAll() ([]model.Library, error)
+	GetAll(userId uuid.UUID) ([]model.Library, error)
 	GetById(uuid.UUID) (*model.Library, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
 	Update(m model.Library) (*model.Library, error)
+	GetUsers(id uuid.UUID) ([]model.LibraryUser, error)
+	AddUser(id, userId uuid.UUID) error
+	RemoveUser(id, userId uuid.UUID) error
 }
 
 type libraryRepository struct {
@@ -50,6 +53,54 @@ func (ls *libraryRepository) Update(m model.Library) (*model.Library, error) {
 	return &updatedModel, nil
 }
 
+// GetUsers implements LibraryRepository.
+func (ls *libraryRepository) GetUsers(id uuid.UUID) ([]model.LibraryUser, error) {
+	statement := table.LibraryUser.SELECT(table.LibraryUser.AllColumns).
+		FROM(table.LibraryUser).
+		WHERE(table.LibraryUser.LibraryID.EQ(postgres.UUID(id))).
+		ORDER_BY(table.LibraryUser.Created.ASC())
+
+	util.DebugCheck(ls.env, statement)
+
+	var users []model.LibraryUser
+	if err := statement.QueryContext(ls.ctx, ls.db, &users); err != nil {
+		return nil, errs.BuildError(err, "could not get users of library %v", id.String())
+	}
+
+	return users, nil
+}
+
+// AddUser implements LibraryRepository. Adding a user that already has access does nothing.
+func (ls *libraryRepository) AddUser(id, userId uuid.UUID) error {
+	statement := table.LibraryUser.INSERT(table.LibraryUser.LibraryID, table.LibraryUser.UserID).
+		MODEL(model.LibraryUser{LibraryID: id, UserID: userId}).
+		ON_CONFLICT(table.LibraryUser.LibraryID, table.LibraryUser.UserID).
+		DO_NOTHING()
+
+	util.DebugCheck(ls.env, statement)
+
+	if _, err := statement.ExecContext(ls.ctx, ls.db); err != nil {
+		return errs.BuildError(err, "could not add user %v to library %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
+// RemoveUser implements LibraryRepository.
+func (ls *libraryRepository) RemoveUser(id, userId uuid.UUID) error {
+	statement := table.LibraryUser.DELETE().
+		WHERE(table.LibraryUser.LibraryID.EQ(postgres.UUID(id)).
+			AND(table.LibraryUser.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(ls.env, statement)
+
+	if _, err := statement.ExecContext(ls.ctx, ls.db); err != nil {
+		return errs.BuildError(err, "could not remove user %v from library %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
 // GetMedia implements LibraryRepository.
 func (ls *libraryRepository) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
 	relationFn := func(relationTable postgres.ReadableTable) postgres.ReadableTable {
@@ -115,9 +166,9 @@ func (ls *libraryRepository) GetByName(name string) (*model.Library, error) {
 	return library, nil
 }
 
-func (ls *libraryRepository) GetAll() ([]model.Library, error) {
+func (ls *libraryRepository) GetAll(userId uuid.UUID) ([]model.Library, error) {
 	var libraries []struct{ model.Library }
-	if err := ls.getLibrariesStatement().Query(&libraries); err != nil {
+	if err := ls.getLibrariesStatement(userId).Query(&libraries); err != nil {
 		return nil, errs.BuildError(err, "could not get libraries")
 	}
 	var libs []model.Library
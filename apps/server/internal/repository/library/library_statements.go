@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/helpers"
 	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
 )
 
@@ -40,9 +41,10 @@ func (i *libraryRepository) getLibraryByNameStatement(name string) *LibraryState
 	return &LibraryStatement{statement, i.db, i.ctx}
 }
 
-func (ls *libraryRepository) getLibrariesStatement() *LibraryStatement {
+func (ls *libraryRepository) getLibrariesStatement(userId uuid.UUID) *LibraryStatement {
 	statement := table.Library.SELECT(table.Library.AllColumns).
-		FROM(table.Library)
+		FROM(table.Library).
+		WHERE(helpers.LibraryAccess(userId))
 
 	util.DebugCheck(ls.env, statement)
 
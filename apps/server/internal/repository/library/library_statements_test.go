@@ -41,3 +41,13 @@ func Test_GetById(t *testing.T) {
 		t.Errorf("Expected %v but got %v", expectedSql, sql)
 	}
 }
+
+func Test_GetLibrariesStatement(t *testing.T) {
+	statement := lr.getLibrariesStatement(uuid.New())
+	sql := statement.Sql()
+
+	expectedSql := "\nSELECT library.id AS \"library.id\",\n     library.name AS \"library.name\",\n     library.library_type AS \"library.library_type\",\n     library.created AS \"library.created\",\n     library.modified AS \"library.modified\",\n     library.ghost_id AS \"library.ghost_id\"\nFROM public.library\nWHERE (EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $1::uuid) AND (\"user\".role = 'admin')\n      )) OR (library.id IN ((\n           SELECT library_user.library_id AS \"library_user.library_id\"\n           FROM public.library_user\n           WHERE library_user.user_id = $2::uuid\n      )));\n"
+	if sql != expectedSql {
+		t.Errorf("Expected %v but got %v", expectedSql, sql)
+	}
+}
@@ -34,6 +34,9 @@ type LibraryPathRepository interface {
 	GetById(id uuid.UUID) (*model.LibraryPath, error)
 	GetByLibraryId(libraryId uuid.UUID) ([]model.LibraryPath, error)
 	GetContainingPath(path string) ([]model.LibraryPath, error)
+	GetAllForUser(userId uuid.UUID) ([]model.LibraryPath, error)
+	GetByIdForUser(id, userId uuid.UUID) (*model.LibraryPath, error)
+	GetByLibraryIdForUser(libraryId, userId uuid.UUID) ([]model.LibraryPath, error)
 }
 
 func (i *libraryPathRepository) GetContainingPath(path string) ([]model.LibraryPath, error) {
@@ -112,3 +115,52 @@ func (lps *libraryPathRepository) GetById(id uuid.UUID) (*model.LibraryPath, err
 
 	return &libraryPaths[len(libraryPaths)-1].LibraryPath, nil
 }
+
+func (lps *libraryPathRepository) queryAccessible(userId uuid.UUID, condition postgres.BoolExpression) ([]model.LibraryPath, error) {
+	var libraryPaths []struct{ model.LibraryPath }
+	if err := lps.getAccessibleStatement(userId, condition).Query(&libraryPaths); err != nil {
+		return nil, err
+	}
+
+	libPathModels := []model.LibraryPath{}
+	for _, l := range libraryPaths {
+		libPathModels = append(libPathModels, l.LibraryPath)
+	}
+
+	return libPathModels, nil
+}
+
+// GetAllForUser implements LibraryPathRepository.
+func (lps *libraryPathRepository) GetAllForUser(userId uuid.UUID) ([]model.LibraryPath, error) {
+	libraryPaths, err := lps.queryAccessible(userId, nil)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get library paths for user %v", userId)
+	}
+
+	return libraryPaths, nil
+}
+
+// GetByIdForUser implements LibraryPathRepository.
+// Nothing is returned when the library path does not exist or the user has no access to it.
+func (lps *libraryPathRepository) GetByIdForUser(id, userId uuid.UUID) (*model.LibraryPath, error) {
+	libraryPaths, err := lps.queryAccessible(userId, table.LibraryPath.ID.EQ(postgres.UUID(id)))
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get library path %v for user %v", id, userId)
+	}
+
+	if len(libraryPaths) != 1 {
+		return nil, nil
+	}
+
+	return &libraryPaths[0], nil
+}
+
+// GetByLibraryIdForUser implements LibraryPathRepository.
+func (lps *libraryPathRepository) GetByLibraryIdForUser(libraryId, userId uuid.UUID) ([]model.LibraryPath, error) {
+	libraryPaths, err := lps.queryAccessible(userId, table.LibraryPath.LibraryID.EQ(postgres.UUID(libraryId)))
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get library paths for library %v and user %v", libraryId, userId)
+	}
+
+	return libraryPaths, nil
+}
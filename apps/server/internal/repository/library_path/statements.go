@@ -5,6 +5,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/helpers"
 	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
 )
 
@@ -51,3 +52,20 @@ func (lps *libraryPathRepository) getByIdStatement(id uuid.UUID) LibraryPathStat
 
 	return LibraryPathStatement{statement, lps.db, lps.ctx}
 }
+
+// getAccessibleStatement selects the library paths matching the condition that the user has access to.
+// A nil condition selects all of the library paths that the user has access to.
+func (lps *libraryPathRepository) getAccessibleStatement(userId uuid.UUID, condition postgres.BoolExpression) LibraryPathStatement {
+	where := helpers.LibraryPathAccess(userId)
+	if condition != nil {
+		where = condition.AND(where)
+	}
+
+	statement := table.LibraryPath.SELECT(table.LibraryPath.AllColumns).
+		FROM(table.LibraryPath).
+		WHERE(where)
+
+	util.DebugCheck(lps.env, statement)
+
+	return LibraryPathStatement{statement, lps.db, lps.ctx}
+}
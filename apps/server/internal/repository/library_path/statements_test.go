@@ -0,0 +1,29 @@
+package libraryPathRepository
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+)
+
+var lpr = libraryPathRepository{
+	env: &environment.EnvironmentVariables{DebugSql: false},
+}
+
+func Test_GetAccessibleStatement(t *testing.T) {
+	actual, _ := lpr.getAccessibleStatement(uuid.New(), nil).Sql()
+
+	expected := "\nSELECT library_path.id AS \"library_path.id\",\n     library_path.library_id AS \"library_path.library_id\",\n     library_path.path AS \"library_path.path\",\n     library_path.created AS \"library_path.created\",\n     library_path.modified AS \"library_path.modified\",\n     library_path.ghost_id AS \"library_path.ghost_id\"\nFROM public.library_path\nWHERE library_path.library_id IN ((\n           SELECT library.id AS \"library.id\"\n           FROM public.library\n           WHERE (EXISTS (\n                      SELECT \"user\".id AS \"user.id\"\n                      FROM public.\"user\"\n                      WHERE (\"user\".id = $1::uuid) AND (\"user\".role = 'admin')\n                 )) OR (library.id IN ((\n                      SELECT library_user.library_id AS \"library_user.library_id\"\n                      FROM public.library_user\n                      WHERE library_user.user_id = $2::uuid\n                 )))\n      ));\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_GetAccessibleStatement_WithCondition(t *testing.T) {
+	actual, _ := lpr.getAccessibleStatement(uuid.New(), table.LibraryPath.ID.EQ(postgres.UUID(uuid.New()))).Sql()
+
+	expected := "\nSELECT library_path.id AS \"library_path.id\",\n     library_path.library_id AS \"library_path.library_id\",\n     library_path.path AS \"library_path.path\",\n     library_path.created AS \"library_path.created\",\n     library_path.modified AS \"library_path.modified\",\n     library_path.ghost_id AS \"library_path.ghost_id\"\nFROM public.library_path\nWHERE (library_path.id = $1::uuid) AND (library_path.library_id IN ((\n           SELECT library.id AS \"library.id\"\n           FROM public.library\n           WHERE (EXISTS (\n                      SELECT \"user\".id AS \"user.id\"\n                      FROM public.\"user\"\n                      WHERE (\"user\".id = $2::uuid) AND (\"user\".role = 'admin')\n                 )) OR (library.id IN ((\n                      SELECT library_user.library_id AS \"library_user.library_id\"\n                      FROM public.library_user\n                      WHERE library_user.user_id = $3::uuid\n                 )))\n      )));\n"
+	assert.Eq(t, expected, actual)
+}
@@ -0,0 +1,132 @@
+package loginRepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+type LoginRepository interface {
+	GetThrottles(keys []string) ([]model.LoginThrottle, error)
+	SaveThrottle(m model.LoginThrottle) error
+	DeleteThrottles(keys []string) error
+	CreateFailure(m model.LoginFailure) error
+}
+
+type loginRepository struct {
+	env *environment.EnvironmentVariables
+	db  *sql.DB
+	ctx context.Context
+}
+
+// GetThrottles implements LoginRepository.
+func (r *loginRepository) GetThrottles(keys []string) ([]model.LoginThrottle, error) {
+	statement := table.LoginThrottle.SELECT(table.LoginThrottle.AllColumns).
+		FROM(table.LoginThrottle).
+		WHERE(table.LoginThrottle.Key.IN(stringExpressions(keys)...))
+
+	util.DebugCheck(r.env, statement)
+
+	var throttles []model.LoginThrottle
+	if err := statement.QueryContext(r.ctx, r.db, &throttles); err != nil {
+		return nil, errs.BuildError(err, "could not query login throttles: %v", keys)
+	}
+
+	return throttles, nil
+}
+
+// SaveThrottle implements LoginRepository. The throttle is created when there is none for its key yet.
+func (r *loginRepository) SaveThrottle(m model.LoginThrottle) error {
+	statement := r.saveThrottleStatement(m)
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not save login throttle: %v", m.Key)
+	}
+
+	return nil
+}
+
+func (r *loginRepository) saveThrottleStatement(m model.LoginThrottle) postgres.InsertStatement {
+	m.Modified = time.Now()
+
+	return table.LoginThrottle.INSERT(
+		table.LoginThrottle.Key,
+		table.LoginThrottle.Failures,
+		table.LoginThrottle.LockedUntil,
+		table.LoginThrottle.Modified,
+	).
+		MODEL(m).
+		ON_CONFLICT(table.LoginThrottle.Key).
+		DO_UPDATE(postgres.SET(
+			table.LoginThrottle.Failures.SET(table.LoginThrottle.EXCLUDED.Failures),
+			table.LoginThrottle.LockedUntil.SET(table.LoginThrottle.EXCLUDED.LockedUntil),
+			table.LoginThrottle.Modified.SET(table.LoginThrottle.EXCLUDED.Modified),
+		))
+}
+
+// DeleteThrottles implements LoginRepository.
+func (r *loginRepository) DeleteThrottles(keys []string) error {
+	statement := table.LoginThrottle.DELETE().
+		WHERE(table.LoginThrottle.Key.IN(stringExpressions(keys)...))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete login throttles: %v", keys)
+	}
+
+	return nil
+}
+
+// CreateFailure implements LoginRepository.
+func (r *loginRepository) CreateFailure(m model.LoginFailure) error {
+	statement := table.LoginFailure.INSERT(
+		table.LoginFailure.Username,
+		table.LoginFailure.IPAddress,
+		table.LoginFailure.UserAgent,
+		table.LoginFailure.Reason,
+	).
+		MODEL(m)
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not create login failure for: %v", m.Username)
+	}
+
+	return nil
+}
+
+func stringExpressions(values []string) []postgres.Expression {
+	expressions := make([]postgres.Expression, len(values))
+	for i, v := range values {
+		expressions[i] = postgres.String(v)
+	}
+
+	return expressions
+}
+
+var loginRepositoryInstance *loginRepository
+
+func New(env *environment.EnvironmentVariables, db *sql.DB, context context.Context) LoginRepository {
+	if loginRepositoryInstance != nil {
+		return loginRepositoryInstance
+	}
+
+	loginRepositoryInstance = &loginRepository{
+		env: env,
+		db:  db,
+		ctx: context,
+	}
+
+	return loginRepositoryInstance
+}
@@ -0,0 +1,20 @@
+package loginRepository
+
+import (
+	"testing"
+
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+)
+
+var lr = loginRepository{
+	env: &environment.EnvironmentVariables{DebugSql: false},
+}
+
+func Test_SaveThrottleStatement(t *testing.T) {
+	actual, _ := lr.saveThrottleStatement(model.LoginThrottle{Key: "user:admin", Failures: 1}).Sql()
+
+	expected := "\nINSERT INTO public.login_throttle (key, failures, locked_until, modified)\nVALUES ($1, $2, $3, $4)\nON CONFLICT (key) DO UPDATE\n       SET failures = excluded.failures,\n           locked_until = excluded.locked_until,\n           modified = excluded.modified;\n"
+	assert.Eq(t, expected, actual)
+}
@@ -0,0 +1,31 @@
+package mediaRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/helpers"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+func (r *mediaRepository) hasAccessStatement(id, userId uuid.UUID) postgres.SelectStatement {
+	return media.SELECT(media.ID).
+		FROM(media).
+		WHERE(media.ID.EQ(postgres.UUID(id)).
+			AND(helpers.MediaAccess(userId)))
+}
+
+// HasAccess implements MediaRepository.
+func (r *mediaRepository) HasAccess(id, userId uuid.UUID) (bool, error) {
+	statement := r.hasAccessStatement(id, userId)
+
+	util.DebugCheck(r.env, statement)
+
+	var result []model.Media
+	if err := statement.QueryContext(r.ctx, r.db, &result); err != nil {
+		return false, errs.BuildError(err, "could not determine if user %v has access to media %v", userId.String(), id.String())
+	}
+
+	return len(result) > 0, nil
+}
@@ -0,0 +1,15 @@
+package mediaRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+)
+
+func Test_HasAccessStatement(t *testing.T) {
+	actual, _ := mr.hasAccessStatement(uuid.New(), uuid.New()).Sql()
+
+	expected := "\nSELECT media.id AS \"media.id\"\nFROM public.media\nWHERE (media.id = $1::uuid) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $2::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $3::uuid\n      ))));\n"
+	assert.Eq(t, expected, actual)
+}
@@ -0,0 +1,136 @@
+package mediaRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/helpers"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+func duplicateCandidateExpression() postgres.BoolExpression {
+	return media.Deleted.IS_FALSE().
+		AND(media.Exists.IS_TRUE()).
+		AND(media.MediaType.EQ(postgres.NewEnumValue(model.MediaTypeEnum_Primary.String())))
+}
+
+// GetDuplicatesByChecksum implements MediaRepository.
+// Media with the same checksum are returned next to each other.
+func (r *mediaRepository) GetDuplicatesByChecksum(userId uuid.UUID) ([]models.DuplicateMedia, error) {
+	statement := r.getDuplicatesByChecksumStatement(userId)
+
+	util.DebugCheck(r.env, statement)
+
+	var duplicates []models.DuplicateMedia
+	if err := statement.QueryContext(r.ctx, r.db, &duplicates); err != nil {
+		return nil, errs.BuildError(err, "could not query media with duplicate checksums")
+	}
+
+	return duplicates, nil
+}
+
+// Only media the user can access are compared so a group never reveals media from other libraries
+func (r *mediaRepository) getDuplicatesByChecksumStatement(userId uuid.UUID) postgres.SelectStatement {
+	duplicateChecksums := media.SELECT(media.Checksum).
+		FROM(media).
+		WHERE(duplicateCandidateExpression().
+			AND(media.Checksum.IS_NOT_NULL()).
+			AND(helpers.MediaAccess(userId))).
+		GROUP_BY(media.Checksum).
+		HAVING(postgres.COUNT(media.ID).GT(postgres.Int(1)))
+
+	return media.SELECT(media.AllColumns, table.Video.AllColumns).
+		FROM(media.LEFT_JOIN(table.Video, table.Video.MediaID.EQ(media.ID))).
+		WHERE(duplicateCandidateExpression().
+			AND(media.Checksum.IN(duplicateChecksums)).
+			AND(helpers.MediaAccess(userId))).
+		ORDER_BY(media.Checksum.ASC(), media.Added.ASC())
+}
+
+// GetWithPerceptualHash implements MediaRepository.
+func (r *mediaRepository) GetWithPerceptualHash(userId uuid.UUID) ([]models.DuplicateMedia, error) {
+	statement := r.getWithPerceptualHashStatement(userId)
+
+	util.DebugCheck(r.env, statement)
+
+	var withHash []models.DuplicateMedia
+	if err := statement.QueryContext(r.ctx, r.db, &withHash); err != nil {
+		return nil, errs.BuildError(err, "could not query media with perceptual hashes")
+	}
+
+	return withHash, nil
+}
+
+func (r *mediaRepository) getWithPerceptualHashStatement(userId uuid.UUID) postgres.SelectStatement {
+	return media.SELECT(media.AllColumns, table.Video.AllColumns).
+		FROM(media.INNER_JOIN(table.Video, table.Video.MediaID.EQ(media.ID))).
+		WHERE(duplicateCandidateExpression().
+			AND(table.Video.Phash.IS_NOT_NULL()).
+			AND(helpers.MediaAccess(userId))).
+		ORDER_BY(media.Added.ASC())
+}
+
+// Merge implements MediaRepository.
+// Tags, people, favourites, progress, ratings, watch history and playlist entries of the merged media are moved to the kept media
+// and the merged media are soft deleted. Everything happens in one transaction.
+func (r *mediaRepository) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) error {
+	tx, err := r.db.BeginTx(r.ctx, nil)
+	if err != nil {
+		return errs.BuildError(err, "could not begin transaction to merge media into %v", keepId.String())
+	}
+	defer tx.Rollback()
+
+	for _, statement := range r.mergeStatements(keepId, mergeIds) {
+		util.DebugCheck(r.env, statement)
+
+		if _, err := statement.ExecContext(r.ctx, tx); err != nil {
+			return errs.BuildError(err, "could not merge media into %v", keepId.String())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errs.BuildError(err, "could not commit merging media into %v", keepId.String())
+	}
+
+	return nil
+}
+
+func (r *mediaRepository) mergeStatements(keepId uuid.UUID, mergeIds []uuid.UUID) []postgres.Statement {
+	ids := helpers.UUIDExpressions(mergeIds)
+
+	mediaTag := table.MediaTag
+	mediaPerson := table.MediaPerson
+	favouriteMedia := table.FavouriteMedia
+	playlistMedia := table.PlaylistMedia
+	mediaProgress := table.MediaProgress
+	mediaRating := table.MediaRating
+
+	statements := []postgres.Statement{}
+	for _, t := range []helpers.MergeTable{
+		{Table: mediaTag, ID: mediaTag.ID, Owner: mediaTag.MediaID, Key: mediaTag.TagID, Modified: mediaTag.Modified},
+		{Table: mediaPerson, ID: mediaPerson.ID, Owner: mediaPerson.MediaID, Key: mediaPerson.PersonID, Modified: mediaPerson.Modified},
+		{Table: favouriteMedia, ID: favouriteMedia.ID, Owner: favouriteMedia.MediaID, Key: favouriteMedia.UserID, Modified: favouriteMedia.Modified},
+		{Table: playlistMedia, ID: playlistMedia.ID, Owner: playlistMedia.MediaID, Key: playlistMedia.PlaylistID, Modified: playlistMedia.Modified},
+		{Table: mediaProgress, ID: mediaProgress.ID, Owner: mediaProgress.MediaID, Key: mediaProgress.UserID, Modified: mediaProgress.Modified},
+		{Table: mediaRating, ID: mediaRating.ID, Owner: mediaRating.MediaID, Key: mediaRating.UserID, Modified: mediaRating.Modified},
+	} {
+		statements = append(statements,
+			t.MoveStatement(keepId, ids),
+			t.Table.DELETE().WHERE(t.Owner.IN(ids...)),
+		)
+	}
+
+	// Every viewing is kept as the history of the kept media
+	statements = append(statements, table.WatchHistory.UPDATE(table.WatchHistory.MediaID).
+		SET(postgres.UUID(keepId)).
+		WHERE(table.WatchHistory.MediaID.IN(ids...)))
+
+	statements = append(statements, media.UPDATE(media.Deleted, media.Modified).
+		SET(postgres.Bool(true), postgres.LOCALTIMESTAMP()).
+		WHERE(media.ID.IN(ids...)))
+
+	return statements
+}
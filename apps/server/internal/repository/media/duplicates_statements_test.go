@@ -0,0 +1,73 @@
+package mediaRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+)
+
+var mr = mediaRepository{
+	env: &environment.EnvironmentVariables{DebugSql: false},
+}
+
+func Test_GetDuplicatesByChecksumStatement(t *testing.T) {
+	actual, _ := mr.getDuplicatesByChecksumStatement(uuid.New()).Sql()
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.library_path_id AS \"media.library_path_id\",\n     media.path AS \"media.path\",\n     media.title AS \"media.title\",\n     media.media_type AS \"media.media_type\",\n     media.size AS \"media.size\",\n     media.checksum AS \"media.checksum\",\n     media.added AS \"media.added\",\n     media.deleted AS \"media.deleted\",\n     media.exists AS \"media.exists\",\n     media.created AS \"media.created\",\n     media.modified AS \"media.modified\",\n     media.ghost_id AS \"media.ghost_id\",\n     video.id AS \"video.id\",\n     video.media_id AS \"video.media_id\",\n     video.height AS \"video.height\",\n     video.width AS \"video.width\",\n     video.runtime AS \"video.runtime\",\n     video.ghost_id AS \"video.ghost_id\",\n     video.video_codec AS \"video.video_codec\",\n     video.audio_codec AS \"video.audio_codec\",\n     video.container AS \"video.container\",\n     video.bitrate AS \"video.bitrate\",\n     video.video_bitrate AS \"video.video_bitrate\",\n     video.frame_rate AS \"video.frame_rate\",\n     video.pixel_format AS \"video.pixel_format\",\n     video.audio_streams AS \"video.audio_streams\",\n     video.subtitle_streams AS \"video.subtitle_streams\",\n     video.phash AS \"video.phash\"\nFROM public.media\n     LEFT JOIN public.video ON (video.media_id = media.id)\nWHERE (((media.deleted IS FALSE AND media.exists IS TRUE) AND (media.media_type = 'primary')) AND (media.checksum IN ((\n           SELECT media.checksum AS \"media.checksum\"\n           FROM public.media\n           WHERE (((media.deleted IS FALSE AND media.exists IS TRUE) AND (media.media_type = 'primary')) AND media.checksum IS NOT NULL) AND ((EXISTS (\n                      SELECT \"user\".id AS \"user.id\"\n                      FROM public.\"user\"\n                      WHERE (\"user\".id = $1::uuid) AND (\"user\".role = 'admin')\n                 )) OR (media.library_path_id IN ((\n                      SELECT library_path.id AS \"library_path.id\"\n                      FROM public.library_path\n                           INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n                      WHERE library_user.user_id = $2::uuid\n                 ))))\n           GROUP BY media.checksum\n           HAVING COUNT(media.id) > $3\n      )))) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $4::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $5::uuid\n      ))))\nORDER BY media.checksum ASC, media.added ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_GetWithPerceptualHashStatement(t *testing.T) {
+	actual, _ := mr.getWithPerceptualHashStatement(uuid.New()).Sql()
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.library_path_id AS \"media.library_path_id\",\n     media.path AS \"media.path\",\n     media.title AS \"media.title\",\n     media.media_type AS \"media.media_type\",\n     media.size AS \"media.size\",\n     media.checksum AS \"media.checksum\",\n     media.added AS \"media.added\",\n     media.deleted AS \"media.deleted\",\n     media.exists AS \"media.exists\",\n     media.created AS \"media.created\",\n     media.modified AS \"media.modified\",\n     media.ghost_id AS \"media.ghost_id\",\n     video.id AS \"video.id\",\n     video.media_id AS \"video.media_id\",\n     video.height AS \"video.height\",\n     video.width AS \"video.width\",\n     video.runtime AS \"video.runtime\",\n     video.ghost_id AS \"video.ghost_id\",\n     video.video_codec AS \"video.video_codec\",\n     video.audio_codec AS \"video.audio_codec\",\n     video.container AS \"video.container\",\n     video.bitrate AS \"video.bitrate\",\n     video.video_bitrate AS \"video.video_bitrate\",\n     video.frame_rate AS \"video.frame_rate\",\n     video.pixel_format AS \"video.pixel_format\",\n     video.audio_streams AS \"video.audio_streams\",\n     video.subtitle_streams AS \"video.subtitle_streams\",\n     video.phash AS \"video.phash\"\nFROM public.media\n     INNER JOIN public.video ON (video.media_id = media.id)\nWHERE (((media.deleted IS FALSE AND media.exists IS TRUE) AND (media.media_type = 'primary')) AND video.phash IS NOT NULL) AND ((EXISTS (\n           SELECT \"user\".id AS \"user.id\"\n           FROM public.\"user\"\n           WHERE (\"user\".id = $1::uuid) AND (\"user\".role = 'admin')\n      )) OR (media.library_path_id IN ((\n           SELECT library_path.id AS \"library_path.id\"\n           FROM public.library_path\n                INNER JOIN public.library_user ON (library_user.library_id = library_path.library_id)\n           WHERE library_user.user_id = $2::uuid\n      ))))\nORDER BY media.added ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_MovesLatestRowPerKey(t *testing.T) {
+	statements := mr.mergeStatements(uuid.New(), []uuid.UUID{uuid.New(), uuid.New()})
+
+	actual, _ := statements[0].Sql()
+
+	expected := "\nUPDATE public.media_tag\nSET (media_id, modified) = ($1::uuid, LOCALTIMESTAMP)\nWHERE (media_tag.id IN ((\n           SELECT DISTINCT ON (media_tag.tag_id) media_tag.id AS \"media_tag.id\"\n           FROM public.media_tag\n           WHERE media_tag.media_id IN ($2::uuid, $3::uuid)\n           ORDER BY media_tag.tag_id, media_tag.modified DESC\n      ))) AND (media_tag.tag_id NOT IN ((\n           SELECT media_tag.tag_id AS \"media_tag.tag_id\"\n           FROM public.media_tag\n           WHERE media_tag.media_id = $4::uuid\n      )));\n"
+	assert.Eq(t, expected, actual)
+
+	actual, _ = statements[1].Sql()
+
+	expected = "\nDELETE FROM public.media_tag\nWHERE media_tag.media_id IN ($1::uuid, $2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_SoftDeletesMergedMedia(t *testing.T) {
+	statements := mr.mergeStatements(uuid.New(), []uuid.UUID{uuid.New()})
+
+	actual, _ := statements[len(statements)-1].Sql()
+
+	expected := "\nUPDATE public.media\nSET (deleted, modified) = ($1::boolean, LOCALTIMESTAMP)\nWHERE media.id IN ($2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_MovesAllWatchHistory(t *testing.T) {
+	statements := mr.mergeStatements(uuid.New(), []uuid.UUID{uuid.New()})
+
+	actual, _ := statements[len(statements)-2].Sql()
+
+	expected := "\nUPDATE public.watch_history\nSET media_id = $1::uuid\nWHERE watch_history.media_id IN ($2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_MovesLatestRatingPerUser(t *testing.T) {
+	statements := mr.mergeStatements(uuid.New(), []uuid.UUID{uuid.New(), uuid.New()})
+
+	actual, _ := statements[10].Sql()
+
+	expected := "\nUPDATE public.media_rating\nSET (media_id, modified) = ($1::uuid, LOCALTIMESTAMP)\nWHERE (media_rating.id IN ((\n           SELECT DISTINCT ON (media_rating.user_id) media_rating.id AS \"media_rating.id\"\n           FROM public.media_rating\n           WHERE media_rating.media_id IN ($2::uuid, $3::uuid)\n           ORDER BY media_rating.user_id, media_rating.modified DESC\n      ))) AND (media_rating.user_id NOT IN ((\n           SELECT media_rating.user_id AS \"media_rating.user_id\"\n           FROM public.media_rating\n           WHERE media_rating.media_id = $4::uuid\n      )));\n"
+	assert.Eq(t, expected, actual)
+
+	actual, _ = statements[11].Sql()
+
+	expected = "\nDELETE FROM public.media_rating\nWHERE media_rating.media_id IN ($1::uuid, $2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
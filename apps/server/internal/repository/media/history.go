@@ -0,0 +1,120 @@
+package mediaRepository
+
+import (
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+// GetLatestHistory implements MediaRepository.
+func (r *mediaRepository) GetLatestHistory(id, userId uuid.UUID) (*model.WatchHistory, error) {
+	statement := table.WatchHistory.SELECT(table.WatchHistory.AllColumns).
+		FROM(table.WatchHistory).
+		WHERE(table.WatchHistory.MediaID.EQ(postgres.UUID(id)).
+			AND(table.WatchHistory.UserID.EQ(postgres.UUID(userId)))).
+		ORDER_BY(table.WatchHistory.Modified.DESC()).
+		LIMIT(1)
+
+	util.DebugCheck(r.env, statement)
+
+	var history []model.WatchHistory
+	if err := statement.QueryContext(r.ctx, r.db, &history); err != nil {
+		return nil, errs.BuildError(err, "could not get latest watch history of user %v for media %v", userId.String(), id.String())
+	}
+
+	if len(history) == 0 {
+		return nil, nil
+	}
+
+	return &history[0], nil
+}
+
+// CreateHistory implements MediaRepository.
+func (r *mediaRepository) CreateHistory(h model.WatchHistory) error {
+	h.Modified = time.Now()
+	statement := table.WatchHistory.INSERT(
+		table.WatchHistory.UserID,
+		table.WatchHistory.MediaID,
+		table.WatchHistory.Timestamp,
+		table.WatchHistory.Completed,
+		table.WatchHistory.Modified).
+		MODEL(h)
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not create watch history of user %v for media %v", h.UserID.String(), h.MediaID.String())
+	}
+
+	return nil
+}
+
+// UpdateHistory implements MediaRepository.
+func (r *mediaRepository) UpdateHistory(h model.WatchHistory) error {
+	h.Modified = time.Now()
+	statement := table.WatchHistory.UPDATE(table.WatchHistory.Timestamp, table.WatchHistory.Completed, table.WatchHistory.Modified).
+		MODEL(h).
+		WHERE(table.WatchHistory.ID.EQ(postgres.UUID(h.ID)))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not update watch history %v", h.ID.String())
+	}
+
+	return nil
+}
+
+// UpsertWatched implements MediaRepository. Every time that media is watched its play count goes up.
+func (r *mediaRepository) UpsertWatched(prog model.MediaProgress) (*model.MediaProgress, error) {
+	statement := r.upsertWatchedStatement(prog)
+
+	util.DebugCheck(r.env, statement)
+
+	var updatedProg model.MediaProgress
+	if err := statement.QueryContext(r.ctx, r.db, &updatedProg); err != nil {
+		return nil, errs.BuildError(err, "could not mark media %v as watched for user %v", prog.MediaID.String(), prog.UserID.String())
+	}
+
+	return &updatedProg, nil
+}
+
+// ResetProgress implements MediaRepository. The play count is kept so that earlier viewings still count.
+func (r *mediaRepository) ResetProgress(id, userId uuid.UUID) error {
+	statement := r.resetProgressStatement(id, userId)
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not reset progress of user %v for media %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
+func (r *mediaRepository) upsertWatchedStatement(prog model.MediaProgress) postgres.InsertStatement {
+	mediaProgress := table.MediaProgress
+	return mediaProgress.INSERT(mediaProgress.MediaID, mediaProgress.UserID, mediaProgress.Timestamp, mediaProgress.PlayCount, mediaProgress.Watched).
+		VALUES(postgres.UUID(prog.MediaID), postgres.UUID(prog.UserID), postgres.Float(prog.Timestamp), postgres.Int32(1), postgres.LOCALTIMESTAMP()).
+		ON_CONFLICT(mediaProgress.MediaID, mediaProgress.UserID).
+		DO_UPDATE(postgres.SET(
+			mediaProgress.Timestamp.SET(mediaProgress.EXCLUDED.Timestamp),
+			mediaProgress.PlayCount.SET(mediaProgress.PlayCount.ADD(postgres.Int32(1))),
+			mediaProgress.Watched.SET(postgres.LOCALTIMESTAMP()),
+			mediaProgress.Modified.SET(postgres.LOCALTIMESTAMP()),
+		)).
+		RETURNING(mediaProgress.AllColumns)
+}
+
+func (r *mediaRepository) resetProgressStatement(id, userId uuid.UUID) postgres.UpdateStatement {
+	mediaProgress := table.MediaProgress
+	return mediaProgress.UPDATE(mediaProgress.Timestamp, mediaProgress.Watched, mediaProgress.Modified).
+		SET(postgres.Float(0), postgres.NULL, postgres.LOCALTIMESTAMP()).
+		WHERE(mediaProgress.MediaID.EQ(postgres.UUID(id)).
+			AND(mediaProgress.UserID.EQ(postgres.UUID(userId))))
+}
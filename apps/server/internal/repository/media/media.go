@@ -35,8 +35,21 @@ type MediaRepository interface {
 	GetAssetsFor(id uuid.UUID) ([]models.MediaRelation, error)
 	GetProgressForUser(id, userId uuid.UUID) (*model.MediaProgress, error)
 	GetThumbnailFor(id uuid.UUID) (*model.Media, error)
+	GetSubtitlesFor(id uuid.UUID) ([]models.MediaRelation, error)
+	GetSubtitleFor(id, trackId uuid.UUID) (*model.Media, error)
+	HasAccess(id, userId uuid.UUID) (bool, error)
 
 	UpsertProgress(prog model.MediaProgress) (*model.MediaProgress, error)
+	UpsertWatched(prog model.MediaProgress) (*model.MediaProgress, error)
+	ResetProgress(id, userId uuid.UUID) error
+
+	UpsertRating(m model.MediaRating) (*model.MediaRating, error)
+	DeleteRating(id, userId uuid.UUID) error
+
+	GetLatestHistory(id, userId uuid.UUID) (*model.WatchHistory, error)
+	CreateHistory(h model.WatchHistory) error
+	UpdateHistory(h model.WatchHistory) error
+
 	Update(m model.Media, columns postgres.ColumnList) (*model.Media, error)
 	UpdateExists(model.Media) error
 	UpdateChecksum(m models.Media) error
@@ -44,6 +57,10 @@ type MediaRepository interface {
 	RemoveRelation(id, relatedTo uuid.UUID) error
 	Delete(m model.Media) error
 	DeleteRelations(id uuid.UUID, deleteDto dto.DeleteMediaRelationsDto) error
+
+	GetDuplicatesByChecksum(userId uuid.UUID) ([]models.DuplicateMedia, error)
+	GetWithPerceptualHash(userId uuid.UUID) ([]models.DuplicateMedia, error)
+	Merge(keepId uuid.UUID, mergeIds []uuid.UUID) error
 }
 
 type mediaRepository struct {
@@ -250,7 +267,9 @@ func (r *mediaRepository) GetProgressForUser(id uuid.UUID, userId uuid.UUID) (*m
 		mediaProgress.ID,
 		mediaProgress.MediaID,
 		mediaProgress.UserID,
-		mediaProgress.Timestamp).
+		mediaProgress.Timestamp,
+		mediaProgress.PlayCount,
+		mediaProgress.Watched).
 		WHERE(mediaProgress.MediaID.EQ(postgres.UUID(id)).
 			AND(mediaProgress.UserID.EQ(postgres.UUID(userId))))
 
@@ -428,7 +447,11 @@ func (r *mediaRepository) GetByIdAndUserId(id, userId uuid.UUID) (*models.Media,
 		person.AllColumns,
 		tag.AllColumns,
 		table.MediaProgress.Timestamp,
+		table.MediaProgress.PlayCount,
+		table.MediaProgress.Watched,
 		table.FavouriteMedia.ID,
+		table.MediaRating.ID,
+		table.MediaRating.Rating,
 		existingRelations.AllColumns(),
 	).FROM(media.
 		LEFT_JOIN(image, image.MediaID.EQ(media.ID)).
@@ -439,7 +462,8 @@ func (r *mediaRepository) GetByIdAndUserId(id, userId uuid.UUID) (*models.Media,
 		LEFT_JOIN(mediaTag, mediaTag.MediaID.EQ(media.ID)).
 		LEFT_JOIN(tag, tag.ID.EQ(mediaTag.TagID)).
 		LEFT_JOIN(table.MediaProgress, table.MediaProgress.MediaID.EQ(media.ID).AND(table.MediaProgress.UserID.EQ(postgres.UUID(userId)))).
-		LEFT_JOIN(table.FavouriteMedia, table.FavouriteMedia.MediaID.EQ(media.ID).AND(table.FavouriteMedia.UserID.EQ(postgres.UUID(userId)))),
+		LEFT_JOIN(table.FavouriteMedia, table.FavouriteMedia.MediaID.EQ(media.ID).AND(table.FavouriteMedia.UserID.EQ(postgres.UUID(userId)))).
+		LEFT_JOIN(table.MediaRating, table.MediaRating.MediaID.EQ(media.ID).AND(table.MediaRating.UserID.EQ(postgres.UUID(userId)))),
 	).
 		WHERE(media.ID.EQ(postgres.UUID(id))),
 	)
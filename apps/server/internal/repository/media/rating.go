@@ -0,0 +1,52 @@
+package mediaRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+// UpsertRating implements MediaRepository. Rating media again replaces the previous rating.
+func (r *mediaRepository) UpsertRating(m model.MediaRating) (*model.MediaRating, error) {
+	statement := r.upsertRatingStatement(m)
+
+	util.DebugCheck(r.env, statement)
+
+	var rating model.MediaRating
+	if err := statement.QueryContext(r.ctx, r.db, &rating); err != nil {
+		return nil, errs.BuildError(err, "could not rate media %v for user %v", m.MediaID.String(), m.UserID.String())
+	}
+
+	return &rating, nil
+}
+
+// DeleteRating implements MediaRepository.
+func (r *mediaRepository) DeleteRating(id, userId uuid.UUID) error {
+	statement := table.MediaRating.DELETE().
+		WHERE(table.MediaRating.MediaID.EQ(postgres.UUID(id)).
+			AND(table.MediaRating.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete rating of media %v for user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+func (r *mediaRepository) upsertRatingStatement(m model.MediaRating) postgres.Statement {
+	mediaRating := table.MediaRating
+
+	return mediaRating.INSERT(mediaRating.UserID, mediaRating.MediaID, mediaRating.Rating).
+		MODEL(m).
+		ON_CONFLICT(mediaRating.UserID, mediaRating.MediaID).
+		DO_UPDATE(postgres.SET(
+			mediaRating.Rating.SET(mediaRating.EXCLUDED.Rating),
+			mediaRating.Modified.SET(postgres.LOCALTIMESTAMP()),
+		)).
+		RETURNING(mediaRating.AllColumns)
+}
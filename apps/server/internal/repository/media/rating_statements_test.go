@@ -0,0 +1,15 @@
+package mediaRepository
+
+import (
+	"testing"
+
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+func Test_UpsertRatingStatement_ReplacesRating(t *testing.T) {
+	actual, _ := mr.upsertRatingStatement(model.MediaRating{Rating: 3}).Sql()
+
+	expected := "\nINSERT INTO public.media_rating (user_id, media_id, rating)\nVALUES ($1, $2, $3)\nON CONFLICT (user_id, media_id) DO UPDATE\n       SET rating = excluded.rating,\n           modified = LOCALTIMESTAMP\nRETURNING media_rating.id AS \"media_rating.id\",\n          media_rating.user_id AS \"media_rating.user_id\",\n          media_rating.media_id AS \"media_rating.media_id\",\n          media_rating.rating AS \"media_rating.rating\",\n          media_rating.created AS \"media_rating.created\",\n          media_rating.modified AS \"media_rating.modified\";\n"
+	assert.Eq(t, expected, actual)
+}
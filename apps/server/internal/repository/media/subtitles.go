@@ -0,0 +1,63 @@
+package mediaRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+func (r *mediaRepository) subtitleRelation(id uuid.UUID) postgres.BoolExpression {
+	return table.MediaRelation.RelatedTo.EQ(media.ID).
+		AND(table.MediaRelation.MediaID.EQ(postgres.UUID(id))).
+		AND(table.MediaRelation.RelationType.EQ(postgres.NewEnumValue(model.MediaRelationTypeEnum_Subtitle.String())))
+}
+
+func (r *mediaRepository) subtitlesStatement(id uuid.UUID) postgres.SelectStatement {
+	return table.MediaRelation.SELECT(table.MediaRelation.AllColumns).
+		FROM(table.MediaRelation.INNER_JOIN(media, r.subtitleRelation(id))).
+		WHERE(media.Deleted.IS_FALSE()).
+		ORDER_BY(table.MediaRelation.Created.ASC())
+}
+
+func (r *mediaRepository) subtitleStatement(id, trackId uuid.UUID) postgres.SelectStatement {
+	return media.SELECT(media.AllColumns).
+		FROM(media.INNER_JOIN(table.MediaRelation, r.subtitleRelation(id))).
+		WHERE(media.ID.EQ(postgres.UUID(trackId)).
+			AND(media.Deleted.IS_FALSE()))
+}
+
+// GetSubtitlesFor implements MediaRepository.
+func (r *mediaRepository) GetSubtitlesFor(id uuid.UUID) ([]models.MediaRelation, error) {
+	statement := r.subtitlesStatement(id)
+
+	util.DebugCheck(r.env, statement)
+
+	var entities []models.MediaRelation
+	if err := statement.QueryContext(r.ctx, r.db, &entities); err != nil {
+		return nil, errs.BuildError(err, "could not fetch subtitles for: %v", id.String())
+	}
+
+	return entities, nil
+}
+
+// GetSubtitleFor implements MediaRepository. Returns nil when the track is not a subtitle of the media
+func (r *mediaRepository) GetSubtitleFor(id, trackId uuid.UUID) (*model.Media, error) {
+	statement := r.subtitleStatement(id, trackId)
+
+	util.DebugCheck(r.env, statement)
+
+	var entities []model.Media
+	if err := statement.QueryContext(r.ctx, r.db, &entities); err != nil {
+		return nil, errs.BuildError(err, "could not fetch subtitle %v for: %v", trackId.String(), id.String())
+	}
+
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	return &entities[0], nil
+}
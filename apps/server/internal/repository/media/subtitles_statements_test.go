@@ -0,0 +1,22 @@
+package mediaRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+)
+
+func Test_SubtitlesStatement(t *testing.T) {
+	actual, _ := mr.subtitlesStatement(uuid.New()).Sql()
+
+	expected := "\nSELECT media_relation.id AS \"media_relation.id\",\n     media_relation.media_id AS \"media_relation.media_id\",\n     media_relation.related_to AS \"media_relation.related_to\",\n     media_relation.relation_type AS \"media_relation.relation_type\",\n     media_relation.created AS \"media_relation.created\",\n     media_relation.modified AS \"media_relation.modified\",\n     media_relation.ghost_id AS \"media_relation.ghost_id\",\n     media_relation.metadata AS \"media_relation.metadata\"\nFROM public.media_relation\n     INNER JOIN public.media ON (((media_relation.related_to = media.id) AND (media_relation.media_id = $1::uuid)) AND (media_relation.relation_type = 'subtitle'))\nWHERE media.deleted IS FALSE\nORDER BY media_relation.created ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_SubtitleStatement_OnlyMatchesSubtitlesOfTheMedia(t *testing.T) {
+	actual, _ := mr.subtitleStatement(uuid.New(), uuid.New()).Sql()
+
+	expected := "\nSELECT media.id AS \"media.id\",\n     media.library_path_id AS \"media.library_path_id\",\n     media.path AS \"media.path\",\n     media.title AS \"media.title\",\n     media.media_type AS \"media.media_type\",\n     media.size AS \"media.size\",\n     media.checksum AS \"media.checksum\",\n     media.added AS \"media.added\",\n     media.deleted AS \"media.deleted\",\n     media.exists AS \"media.exists\",\n     media.created AS \"media.created\",\n     media.modified AS \"media.modified\",\n     media.ghost_id AS \"media.ghost_id\"\nFROM public.media\n     INNER JOIN public.media_relation ON (((media_relation.related_to = media.id) AND (media_relation.media_id = $1::uuid)) AND (media_relation.relation_type = 'subtitle'))\nWHERE (media.id = $2::uuid) AND media.deleted IS FALSE;\n"
+	assert.Eq(t, expected, actual)
+}
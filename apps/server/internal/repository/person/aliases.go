@@ -0,0 +1,97 @@
+package personRepository
+
+import (
+	"strings"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+var personAlias = table.PersonAlias
+
+// GetByAlias implements PersonRepository.
+func (r *personRepository) GetByAlias(alias string) (*model.Person, error) {
+	statement := r.getByAliasStatement(alias)
+
+	util.DebugCheck(r.env, statement)
+
+	var people []model.Person
+	if err := statement.QueryContext(r.ctx, r.db, &people); err != nil {
+		return nil, errs.BuildError(err, "could not query person by alias %v", alias)
+	}
+
+	if len(people) == 0 {
+		return nil, nil
+	}
+
+	return &people[0], nil
+}
+
+// GetAliases implements PersonRepository.
+func (r *personRepository) GetAliases(id uuid.UUID) ([]model.PersonAlias, error) {
+	statement := personAlias.SELECT(personAlias.AllColumns).
+		FROM(personAlias).
+		WHERE(personAlias.PersonID.EQ(postgres.UUID(id))).
+		ORDER_BY(personAlias.Alias_.ASC())
+
+	util.DebugCheck(r.env, statement)
+
+	var aliases []model.PersonAlias
+	if err := statement.QueryContext(r.ctx, r.db, &aliases); err != nil {
+		return nil, errs.BuildError(err, "could not query aliases of person %v", id.String())
+	}
+
+	return aliases, nil
+}
+
+// CreateAlias implements PersonRepository.
+func (r *personRepository) CreateAlias(m model.PersonAlias) (*model.PersonAlias, error) {
+	statement := personAlias.INSERT(personAlias.PersonID, personAlias.Alias_).
+		MODEL(m).
+		RETURNING(personAlias.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var created model.PersonAlias
+	if err := statement.QueryContext(r.ctx, r.db, &created); err != nil {
+		return nil, errs.BuildError(err, "could not create alias %v for person %v", m.Alias, m.PersonID.String())
+	}
+
+	return &created, nil
+}
+
+// DeleteAlias implements PersonRepository. Returns false when the person has no alias with the id.
+func (r *personRepository) DeleteAlias(id, aliasId uuid.UUID) (bool, error) {
+	statement := r.deleteAliasStatement(id, aliasId)
+
+	util.DebugCheck(r.env, statement)
+
+	res, err := statement.ExecContext(r.ctx, r.db)
+	if err != nil {
+		return false, errs.BuildError(err, "could not delete alias %v of person %v", aliasId.String(), id.String())
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.BuildError(err, "could not get affected rows of alias delete")
+	}
+
+	return affected != 0, nil
+}
+
+func (r *personRepository) getByAliasStatement(alias string) postgres.SelectStatement {
+	return person.SELECT(person.AllColumns).
+		FROM(person.INNER_JOIN(personAlias, personAlias.PersonID.EQ(person.ID))).
+		WHERE(postgres.LOWER(personAlias.Alias_).EQ(postgres.String(strings.ToLower(alias)))).
+		LIMIT(1)
+}
+
+func (r *personRepository) deleteAliasStatement(id, aliasId uuid.UUID) postgres.DeleteStatement {
+	return personAlias.DELETE().
+		WHERE(personAlias.ID.EQ(postgres.UUID(aliasId)).
+			AND(personAlias.PersonID.EQ(postgres.UUID(id))))
+}
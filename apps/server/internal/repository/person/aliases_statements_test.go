@@ -0,0 +1,25 @@
+package personRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+)
+
+var r = personRepository{}
+
+func Test_GetByAliasStatement(t *testing.T) {
+	actual, args := r.getByAliasStatement("Alias").Sql()
+
+	expected := "\nSELECT person.id AS \"person.id\",\n     person.name AS \"person.name\",\n     person.created AS \"person.created\",\n     person.modified AS \"person.modified\",\n     person.ghost_id AS \"person.ghost_id\"\nFROM public.person\n     INNER JOIN public.person_alias ON (person_alias.person_id = person.id)\nWHERE LOWER(person_alias.alias) = $1::text\nLIMIT $2;\n"
+	assert.Eq(t, expected, actual)
+	assert.Eq(t, "alias", args[0].(string))
+}
+
+func Test_DeleteAliasStatement(t *testing.T) {
+	actual, _ := r.deleteAliasStatement(uuid.New(), uuid.New()).Sql()
+
+	expected := "\nDELETE FROM public.person_alias\nWHERE (person_alias.id = $1::uuid) AND (person_alias.person_id = $2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
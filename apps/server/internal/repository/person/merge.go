@@ -0,0 +1,103 @@
+package personRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/helpers"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+// Merge implements PersonRepository.
+// Media, favourites and ratings of the merged people are moved to the kept person, their names and aliases become aliases of the kept person
+// and the merged people are deleted. Everything happens in one transaction.
+// Returns the ids of the media that had any of the merged people.
+func (r *personRepository) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	tx, err := r.db.BeginTx(r.ctx, nil)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not begin transaction to merge people into %v", keepId.String())
+	}
+	defer tx.Rollback()
+
+	affectedStatement := r.mergedMediaStatement(mergeIds)
+
+	util.DebugCheck(r.env, affectedStatement)
+
+	var affected []struct {
+		MediaID uuid.UUID `alias:"media_person.media_id"`
+	}
+	if err := affectedStatement.QueryContext(r.ctx, tx, &affected); err != nil {
+		return nil, errs.BuildError(err, "could not query media of people merged into %v", keepId.String())
+	}
+
+	for _, statement := range r.mergeStatements(keepId, mergeIds) {
+		util.DebugCheck(r.env, statement)
+
+		if _, err := statement.ExecContext(r.ctx, tx); err != nil {
+			return nil, errs.BuildError(err, "could not merge people into %v", keepId.String())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errs.BuildError(err, "could not commit merging people into %v", keepId.String())
+	}
+
+	mediaIds := make([]uuid.UUID, len(affected))
+	for i, a := range affected {
+		mediaIds[i] = a.MediaID
+	}
+
+	return mediaIds, nil
+}
+
+func (r *personRepository) mergedMediaStatement(mergeIds []uuid.UUID) postgres.SelectStatement {
+	return mediaPerson.SELECT(mediaPerson.MediaID).
+		DISTINCT().
+		FROM(mediaPerson).
+		WHERE(mediaPerson.PersonID.IN(helpers.UUIDExpressions(mergeIds)...))
+}
+
+func (r *personRepository) mergeStatements(keepId uuid.UUID, mergeIds []uuid.UUID) []postgres.Statement {
+	keep := postgres.UUID(keepId)
+	ids := helpers.UUIDExpressions(mergeIds)
+
+	media := helpers.MergeTable{
+		Table:    mediaPerson,
+		ID:       mediaPerson.ID,
+		Owner:    mediaPerson.PersonID,
+		Key:      mediaPerson.MediaID,
+		Modified: mediaPerson.Modified,
+	}
+
+	favourites := helpers.MergeTable{
+		Table:    table.FavouritePerson,
+		ID:       table.FavouritePerson.ID,
+		Owner:    table.FavouritePerson.PersonID,
+		Key:      table.FavouritePerson.UserID,
+		Modified: table.FavouritePerson.Modified,
+	}
+
+	ratings := helpers.MergeTable{
+		Table:    table.PersonRating,
+		ID:       table.PersonRating.ID,
+		Owner:    table.PersonRating.PersonID,
+		Key:      table.PersonRating.UserID,
+		Modified: table.PersonRating.Modified,
+	}
+
+	return []postgres.Statement{
+		media.MoveStatement(keepId, ids),
+		favourites.MoveStatement(keepId, ids),
+		ratings.MoveStatement(keepId, ids),
+		personAlias.UPDATE(personAlias.PersonID, personAlias.Modified).
+			SET(keep, postgres.LOCALTIMESTAMP()).
+			WHERE(personAlias.PersonID.IN(ids...)),
+		personAlias.INSERT(personAlias.PersonID, personAlias.Alias_).
+			QUERY(person.SELECT(keep, person.Name).
+				FROM(person).
+				WHERE(person.ID.IN(ids...))),
+		// media and users that already had the kept person are left behind and removed along with the merged people
+		person.DELETE().WHERE(person.ID.IN(ids...)),
+	}
+}
@@ -0,0 +1,26 @@
+package personRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+)
+
+func Test_MergeStatements_MovesFavouritesWithoutDuplicates(t *testing.T) {
+	statements := r.mergeStatements(uuid.New(), []uuid.UUID{uuid.New()})
+
+	actual, _ := statements[1].Sql()
+
+	expected := "\nUPDATE public.favourite_person\nSET (person_id, modified) = ($1::uuid, LOCALTIMESTAMP)\nWHERE (favourite_person.id IN ((\n           SELECT DISTINCT ON (favourite_person.user_id) favourite_person.id AS \"favourite_person.id\"\n           FROM public.favourite_person\n           WHERE favourite_person.person_id IN ($2::uuid)\n           ORDER BY favourite_person.user_id, favourite_person.modified DESC\n      ))) AND (favourite_person.user_id NOT IN ((\n           SELECT favourite_person.user_id AS \"favourite_person.user_id\"\n           FROM public.favourite_person\n           WHERE favourite_person.person_id = $3::uuid\n      )));\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_DeletesMergedPeople(t *testing.T) {
+	statements := r.mergeStatements(uuid.New(), []uuid.UUID{uuid.New()})
+
+	actual, _ := statements[len(statements)-1].Sql()
+
+	expected := "\nDELETE FROM public.person\nWHERE person.id IN ($1::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
@@ -29,10 +29,19 @@ type PersonRepository interface {
 	Create(names []string) ([]model.Person, error)
 	AddToMedia(mediaPeople []model.MediaPerson) ([]model.MediaPerson, error)
 	RemoveFromMedia(mediaPerson model.MediaPerson) error
-	GetAll(search dto.PersonSearchDTO) ([]model.Person, error)
+	GetAll(userId uuid.UUID, search dto.PersonSearchDTO) ([]models.Person, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
 	Update(m model.Person) (*model.Person, error)
 	Delete(id uuid.UUID) error
+	GetByAlias(alias string) (*model.Person, error)
+	GetAliases(id uuid.UUID) ([]model.PersonAlias, error)
+	CreateAlias(m model.PersonAlias) (*model.PersonAlias, error)
+	DeleteAlias(id, aliasId uuid.UUID) (bool, error)
+	Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error)
+	AddFavourite(id, userId uuid.UUID) error
+	RemoveFavourite(id, userId uuid.UUID) error
+	UpsertRating(m model.PersonRating) (*model.PersonRating, error)
+	DeleteRating(id, userId uuid.UUID) error
 }
 
 type personRepository struct {
@@ -113,26 +122,51 @@ func (p *personRepository) GetById(id uuid.UUID) (*model.Person, error) {
 	return &peopleModels[0], nil
 }
 
-// GetAll implements PersonRepository.
-func (p *personRepository) GetAll(search dto.PersonSearchDTO) ([]model.Person, error) {
-	statement := person.SELECT(person.AllColumns).
+// GetAll implements PersonRepository. People come with the rating and favourite of the user.
+func (p *personRepository) GetAll(userId uuid.UUID, search dto.PersonSearchDTO) ([]models.Person, error) {
+	statement := p.getAllStatement(userId, search)
+
+	util.DebugCheck(p.env, statement)
+
+	var people []models.Person
+	if err := statement.QueryContext(p.ctx, p.db, &people); err != nil {
+		return nil, errs.BuildError(err, "could not fetch people from database")
+	}
+
+	return people, nil
+}
+
+func (p *personRepository) getAllStatement(userId uuid.UUID, search dto.PersonSearchDTO) postgres.SelectStatement {
+	statement := person.SELECT(person.AllColumns, personRating.ID, personRating.Rating, favouritePerson.ID).
 		FROM(
-			person.LEFT_JOIN(table.MediaPerson, person.ID.EQ(table.MediaPerson.PersonID)),
+			person.LEFT_JOIN(table.MediaPerson, person.ID.EQ(table.MediaPerson.PersonID)).
+				LEFT_JOIN(personRating, personRating.PersonID.EQ(person.ID).
+					AND(personRating.UserID.EQ(postgres.UUID(userId)))).
+				LEFT_JOIN(favouritePerson, favouritePerson.PersonID.EQ(person.ID).
+					AND(favouritePerson.UserID.EQ(postgres.UUID(userId)))),
 		).
-		GROUP_BY(person.ID).
+		GROUP_BY(person.ID, personRating.ID, favouritePerson.ID).
 		ORDER_BY(search.ToOrderByClause()...)
 
+	conditions := []postgres.BoolExpression{}
 	if search.Search != "" {
 		caseInsensitive := strings.ToLower(search.Search)
-		statement = statement.WHERE(postgres.LOWER(person.Name).LIKE(postgres.String(fmt.Sprintf("%%%v%%", caseInsensitive))))
+		conditions = append(conditions, postgres.LOWER(person.Name).LIKE(postgres.String(fmt.Sprintf("%%%v%%", caseInsensitive))))
 	}
 
-	var people []model.Person
-	if err := statement.QueryContext(p.ctx, p.db, &people); err != nil {
-		return nil, errs.BuildError(err, "could not fetch people from database")
+	if search.Favourites {
+		conditions = append(conditions, favouritePerson.ID.IS_NOT_NULL())
 	}
 
-	return people, nil
+	if search.MinRating != nil {
+		conditions = append(conditions, personRating.Rating.GT_EQ(postgres.Int16(*search.MinRating)))
+	}
+
+	if len(conditions) > 0 {
+		statement = statement.WHERE(postgres.AND(conditions...))
+	}
+
+	return statement
 }
 
 // RemoveFromMedia implements IPersonRepository.
@@ -0,0 +1,88 @@
+package personRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+var favouritePerson = table.FavouritePerson
+var personRating = table.PersonRating
+
+// AddFavourite implements PersonRepository. Adding a favourite again does nothing.
+func (r *personRepository) AddFavourite(id, userId uuid.UUID) error {
+	statement := r.addFavouriteStatement(id, userId)
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not add person %v to favourites of user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+// RemoveFavourite implements PersonRepository.
+func (r *personRepository) RemoveFavourite(id, userId uuid.UUID) error {
+	statement := favouritePerson.DELETE().
+		WHERE(favouritePerson.PersonID.EQ(postgres.UUID(id)).
+			AND(favouritePerson.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not remove person %v from favourites of user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+// UpsertRating implements PersonRepository. Rating a person again replaces the previous rating.
+func (r *personRepository) UpsertRating(m model.PersonRating) (*model.PersonRating, error) {
+	statement := r.upsertRatingStatement(m)
+
+	util.DebugCheck(r.env, statement)
+
+	var rating model.PersonRating
+	if err := statement.QueryContext(r.ctx, r.db, &rating); err != nil {
+		return nil, errs.BuildError(err, "could not rate person %v for user %v", m.PersonID.String(), m.UserID.String())
+	}
+
+	return &rating, nil
+}
+
+// DeleteRating implements PersonRepository.
+func (r *personRepository) DeleteRating(id, userId uuid.UUID) error {
+	statement := personRating.DELETE().
+		WHERE(personRating.PersonID.EQ(postgres.UUID(id)).
+			AND(personRating.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete rating of person %v for user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+func (r *personRepository) addFavouriteStatement(id, userId uuid.UUID) postgres.Statement {
+	return favouritePerson.INSERT(favouritePerson.UserID, favouritePerson.PersonID).
+		MODEL(model.FavouritePerson{UserID: userId, PersonID: id}).
+		ON_CONFLICT(favouritePerson.UserID, favouritePerson.PersonID).
+		DO_NOTHING()
+}
+
+func (r *personRepository) upsertRatingStatement(m model.PersonRating) postgres.Statement {
+	return personRating.INSERT(personRating.UserID, personRating.PersonID, personRating.Rating).
+		MODEL(m).
+		ON_CONFLICT(personRating.UserID, personRating.PersonID).
+		DO_UPDATE(postgres.SET(
+			personRating.Rating.SET(personRating.EXCLUDED.Rating),
+			personRating.Modified.SET(postgres.LOCALTIMESTAMP()),
+		)).
+		RETURNING(personRating.AllColumns)
+}
@@ -0,0 +1,32 @@
+package personRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+)
+
+func Test_AddFavouriteStatement_IgnoresExisting(t *testing.T) {
+	actual, _ := r.addFavouriteStatement(uuid.New(), uuid.New()).Sql()
+
+	expected := "\nINSERT INTO public.favourite_person (user_id, person_id)\nVALUES ($1, $2)\nON CONFLICT (user_id, person_id) DO NOTHING;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_UpsertRatingStatement_ReplacesRating(t *testing.T) {
+	actual, _ := r.upsertRatingStatement(model.PersonRating{Rating: 3}).Sql()
+
+	expected := "\nINSERT INTO public.person_rating (user_id, person_id, rating)\nVALUES ($1, $2, $3)\nON CONFLICT (user_id, person_id) DO UPDATE\n       SET rating = excluded.rating,\n           modified = LOCALTIMESTAMP\nRETURNING person_rating.id AS \"person_rating.id\",\n          person_rating.user_id AS \"person_rating.user_id\",\n          person_rating.person_id AS \"person_rating.person_id\",\n          person_rating.rating AS \"person_rating.rating\",\n          person_rating.created AS \"person_rating.created\",\n          person_rating.modified AS \"person_rating.modified\";\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_GetAllStatement_FavouritesWithMinRating(t *testing.T) {
+	min := int16(3)
+	actual, _ := r.getAllStatement(uuid.New(), dto.PersonSearchDTO{Favourites: true, MinRating: &min}).Sql()
+
+	expected := "\nSELECT person.id AS \"person.id\",\n     person.name AS \"person.name\",\n     person.created AS \"person.created\",\n     person.modified AS \"person.modified\",\n     person.ghost_id AS \"person.ghost_id\",\n     person_rating.id AS \"person_rating.id\",\n     person_rating.rating AS \"person_rating.rating\",\n     favourite_person.id AS \"favourite_person.id\"\nFROM public.person\n     LEFT JOIN public.media_person ON (person.id = media_person.person_id)\n     LEFT JOIN public.person_rating ON ((person_rating.person_id = person.id) AND (person_rating.user_id = $1::uuid))\n     LEFT JOIN public.favourite_person ON ((favourite_person.person_id = person.id) AND (favourite_person.user_id = $2::uuid))\nWHERE (\n          favourite_person.id IS NOT NULL\n              AND (person_rating.rating >= $3::smallint)\n      )\nGROUP BY person.id, person_rating.id, favourite_person.id\nORDER BY person.name DESC;\n"
+	assert.Eq(t, expected, actual)
+}
@@ -0,0 +1,196 @@
+package playlistRepository
+
+import (
+	"database/sql"
+	"slices"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+var playlistMedia = table.PlaylistMedia
+
+// AddMedia implements PlaylistRepository.
+// Media is appended to the end of the playlist. Media that is already in the playlist is skipped.
+func (p *playlistRepository) AddMedia(id uuid.UUID, mediaIds []uuid.UUID) ([]model.PlaylistMedia, error) {
+	if len(mediaIds) == 0 {
+		return nil, nil
+	}
+
+	tx, err := p.db.BeginTx(p.ctx, nil)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not begin transaction to add media to playlist %v", id.String())
+	}
+	defer tx.Rollback()
+
+	items, err := p.lockItems(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	newItems := []model.PlaylistMedia{}
+	position := nextPosition(items)
+	for _, mediaId := range mediaIds {
+		if slices.ContainsFunc(items, func(i model.PlaylistMedia) bool { return i.MediaID == mediaId }) {
+			continue
+		}
+
+		item := model.PlaylistMedia{PlaylistID: id, MediaID: mediaId, Position: position}
+		items = append(items, item)
+		newItems = append(newItems, item)
+		position++
+	}
+
+	if len(newItems) == 0 {
+		return []model.PlaylistMedia{}, nil
+	}
+
+	statement := playlistMedia.INSERT(playlistMedia.PlaylistID, playlistMedia.MediaID, playlistMedia.Position).
+		MODELS(newItems).
+		RETURNING(playlistMedia.AllColumns)
+
+	util.DebugCheck(p.env, statement)
+
+	var created []model.PlaylistMedia
+	if err := statement.QueryContext(p.ctx, tx, &created); err != nil {
+		return nil, errs.BuildError(err, "could not create playlist media")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errs.BuildError(err, "could not commit adding media to playlist %v", id.String())
+	}
+
+	return created, nil
+}
+
+// RemoveMedia implements PlaylistRepository. Returns false when the media is not in the playlist.
+func (p *playlistRepository) RemoveMedia(id, mediaId uuid.UUID) (bool, error) {
+	return p.reorder(id, func(tx *sql.Tx, items []model.PlaylistMedia) ([]model.PlaylistMedia, bool, error) {
+		index := slices.IndexFunc(items, func(i model.PlaylistMedia) bool { return i.MediaID == mediaId })
+		if index < 0 {
+			return items, false, nil
+		}
+
+		statement := playlistMedia.DELETE().
+			WHERE(playlistMedia.ID.EQ(postgres.UUID(items[index].ID)))
+
+		return slices.Delete(items, index, index+1), true, p.exec(tx, statement)
+	})
+}
+
+// MoveMedia implements PlaylistRepository.
+// Positions past the end of the playlist move the media to the end. Returns false when the media is not in the playlist.
+func (p *playlistRepository) MoveMedia(id, mediaId uuid.UUID, position int) (bool, error) {
+	return p.reorder(id, func(tx *sql.Tx, items []model.PlaylistMedia) ([]model.PlaylistMedia, bool, error) {
+		moved, found := move(items, mediaId, position)
+		return moved, found, nil
+	})
+}
+
+// reorder changes the items of a playlist in a transaction and numbers the remaining items from 0 in their new order
+func (p *playlistRepository) reorder(id uuid.UUID, change func(tx *sql.Tx, items []model.PlaylistMedia) ([]model.PlaylistMedia, bool, error)) (bool, error) {
+	tx, err := p.db.BeginTx(p.ctx, nil)
+	if err != nil {
+		return false, errs.BuildError(err, "could not begin transaction to reorder playlist %v", id.String())
+	}
+	defer tx.Rollback()
+
+	items, err := p.lockItems(tx, id)
+	if err != nil {
+		return false, err
+	}
+
+	items, found, err := change(tx, items)
+	if err != nil || !found {
+		return false, err
+	}
+
+	for i, item := range items {
+		if item.Position == int32(i) {
+			continue
+		}
+
+		if err := p.exec(tx, positionStatement(item.ID, int32(i))); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, errs.BuildError(err, "could not commit reordering playlist %v", id.String())
+	}
+
+	return true, nil
+}
+
+// lockItems gets the items of the playlist in order and keeps others from changing the playlist until the transaction ends
+func (p *playlistRepository) lockItems(tx *sql.Tx, id uuid.UUID) ([]model.PlaylistMedia, error) {
+	lock := table.Playlist.SELECT(table.Playlist.ID).
+		WHERE(table.Playlist.ID.EQ(postgres.UUID(id))).
+		FOR(postgres.UPDATE())
+
+	util.DebugCheck(p.env, lock)
+
+	if _, err := lock.ExecContext(p.ctx, tx); err != nil {
+		return nil, errs.BuildError(err, "could not lock playlist %v", id.String())
+	}
+
+	statement := itemsStatement(id)
+
+	util.DebugCheck(p.env, statement)
+
+	var items []model.PlaylistMedia
+	if err := statement.QueryContext(p.ctx, tx, &items); err != nil {
+		return nil, errs.BuildError(err, "could not query items of playlist %v", id.String())
+	}
+
+	return items, nil
+}
+
+func (p *playlistRepository) exec(tx *sql.Tx, statement postgres.Statement) error {
+	util.DebugCheck(p.env, statement)
+
+	if _, err := statement.ExecContext(p.ctx, tx); err != nil {
+		return errs.BuildError(err, "could not change playlist media")
+	}
+
+	return nil
+}
+
+func itemsStatement(id uuid.UUID) postgres.SelectStatement {
+	return playlistMedia.SELECT(playlistMedia.AllColumns).
+		FROM(playlistMedia).
+		WHERE(playlistMedia.PlaylistID.EQ(postgres.UUID(id))).
+		ORDER_BY(playlistMedia.Position.ASC())
+}
+
+func positionStatement(itemId uuid.UUID, position int32) postgres.UpdateStatement {
+	return playlistMedia.UPDATE(playlistMedia.Position, playlistMedia.Modified).
+		SET(postgres.Int32(position), postgres.LOCALTIMESTAMP()).
+		WHERE(playlistMedia.ID.EQ(postgres.UUID(itemId)))
+}
+
+func nextPosition(items []model.PlaylistMedia) int32 {
+	if len(items) == 0 {
+		return 0
+	}
+
+	return items[len(items)-1].Position + 1
+}
+
+// move puts the media at the position in the ordered items. Positions outside of the items are clamped.
+func move(items []model.PlaylistMedia, mediaId uuid.UUID, position int) ([]model.PlaylistMedia, bool) {
+	index := slices.IndexFunc(items, func(i model.PlaylistMedia) bool { return i.MediaID == mediaId })
+	if index < 0 {
+		return items, false
+	}
+
+	item := items[index]
+	items = slices.Delete(items, index, index+1)
+	position = max(0, min(position, len(items)))
+
+	return slices.Insert(items, position, item), true
+}
@@ -0,0 +1,81 @@
+package playlistRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+func playlistItems(count int) []model.PlaylistMedia {
+	items := make([]model.PlaylistMedia, count)
+	for i := range items {
+		items[i] = model.PlaylistMedia{ID: uuid.New(), MediaID: uuid.New(), Position: int32(i)}
+	}
+
+	return items
+}
+
+func mediaOrder(items []model.PlaylistMedia) []uuid.UUID {
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.MediaID
+	}
+
+	return ids
+}
+
+func Test_Move_ToFront(t *testing.T) {
+	items := playlistItems(3)
+	expected := []uuid.UUID{items[2].MediaID, items[0].MediaID, items[1].MediaID}
+
+	moved, found := move(items, items[2].MediaID, 0)
+
+	assert.Eq(t, true, found)
+	for i, id := range mediaOrder(moved) {
+		assert.Eq(t, expected[i], id)
+	}
+}
+
+func Test_Move_PastTheEnd(t *testing.T) {
+	items := playlistItems(3)
+	expected := []uuid.UUID{items[1].MediaID, items[2].MediaID, items[0].MediaID}
+
+	moved, found := move(items, items[0].MediaID, 10)
+
+	assert.Eq(t, true, found)
+	for i, id := range mediaOrder(moved) {
+		assert.Eq(t, expected[i], id)
+	}
+}
+
+func Test_Move_NotInPlaylist(t *testing.T) {
+	items := playlistItems(2)
+
+	moved, found := move(items, uuid.New(), 0)
+
+	assert.Eq(t, false, found)
+	assert.Eq(t, 2, len(moved))
+}
+
+func Test_NextPosition_AfterGaps(t *testing.T) {
+	items := []model.PlaylistMedia{{Position: 0}, {Position: 4}}
+
+	assert.Eq(t, int32(5), nextPosition(items))
+	assert.Eq(t, int32(0), nextPosition(nil))
+}
+
+func Test_ItemsStatement(t *testing.T) {
+	actual, _ := itemsStatement(uuid.New()).Sql()
+
+	expected := "\nSELECT playlist_media.id AS \"playlist_media.id\",\n     playlist_media.playlist_id AS \"playlist_media.playlist_id\",\n     playlist_media.media_id AS \"playlist_media.media_id\",\n     playlist_media.created AS \"playlist_media.created\",\n     playlist_media.modified AS \"playlist_media.modified\",\n     playlist_media.ghost_id AS \"playlist_media.ghost_id\",\n     playlist_media.position AS \"playlist_media.position\"\nFROM public.playlist_media\nWHERE playlist_media.playlist_id = $1::uuid\nORDER BY playlist_media.position ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_PositionStatement(t *testing.T) {
+	actual, _ := positionStatement(uuid.New(), 2).Sql()
+
+	expected := "\nUPDATE public.playlist_media\nSET (position, modified) = ($1::integer, LOCALTIMESTAMP)\nWHERE playlist_media.id = $2::uuid;\n"
+	assert.Eq(t, expected, actual)
+}
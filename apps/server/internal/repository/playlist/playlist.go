@@ -19,14 +19,22 @@ import (
 
 type PlaylistRepository interface {
 	GetById(id uuid.UUID) (*model.Playlist, error)
-	GetAll() ([]model.Playlist, error)
+	GetAll(userId uuid.UUID) ([]model.Playlist, error)
+	GetShared(userId uuid.UUID) ([]models.SharedPlaylist, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
 
 	CreateAll(playlists []model.Playlist) ([]model.Playlist, error)
 
-	AddMedia(playlistMedia []model.PlaylistMedia) ([]model.PlaylistMedia, error)
+	AddMedia(id uuid.UUID, mediaIds []uuid.UUID) ([]model.PlaylistMedia, error)
+	RemoveMedia(id, mediaId uuid.UUID) (bool, error)
+	MoveMedia(id, mediaId uuid.UUID, position int) (bool, error)
 	Update(m model.Playlist) (*model.Playlist, error)
 
+	GetUser(id, userId uuid.UUID) (*model.PlaylistUser, error)
+	GetUsers(id uuid.UUID) ([]model.PlaylistUser, error)
+	AddUser(id, userId uuid.UUID, access model.PlaylistAccessEnum) error
+	RemoveUser(id, userId uuid.UUID) error
+
 	Delete(id uuid.UUID) error
 }
 
@@ -66,40 +74,9 @@ func (p *playlistRepository) Update(m model.Playlist) (*model.Playlist, error) {
 	return &updatedModel, nil
 }
 
-// AddMedia implements PlaylistRepository.
-func (p *playlistRepository) AddMedia(playlistMedia []model.PlaylistMedia) ([]model.PlaylistMedia, error) {
-	if len(playlistMedia) == 0 {
-		return nil, nil
-	}
-
-	statement := table.PlaylistMedia.INSERT(table.PlaylistMedia.PlaylistID, table.PlaylistMedia.MediaID).
-		MODELS(playlistMedia).
-		RETURNING(table.PlaylistMedia.AllColumns)
-
-	util.DebugCheck(p.env, statement)
-
-	var playlistMediaEntities []model.PlaylistMedia
-	if err := statement.QueryContext(p.ctx, p.db, &playlistMediaEntities); err != nil {
-		return nil, errs.BuildError(err, "could not create playlist media")
-	}
-
-	return playlistMediaEntities, nil
-}
-
-// GetMedia implements PlaylistRepository.
+// GetMedia implements PlaylistRepository. Media is in playlist order unless the search orders it otherwise.
 func (p *playlistRepository) GetMedia(id uuid.UUID, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
-	relationFn := func(rel postgres.ReadableTable) postgres.ReadableTable {
-		return rel.INNER_JOIN(
-			table.PlaylistMedia,
-			table.PlaylistMedia.MediaID.EQ(table.Media.ID),
-		)
-	}
-
-	wherFn := func(whr postgres.BoolExpression) postgres.BoolExpression {
-		return whr.AND(table.PlaylistMedia.PlaylistID.EQ(postgres.UUID(id)))
-	}
-
-	return helpers.QueryMediaOverview(userId, search, relationFn, wherFn, p.ctx, p.db, p.env)
+	return helpers.QueryPlaylistMediaOverview(id, userId, search, p.ctx, p.db, p.env)
 }
 
 // GetById implements PlaylistRepository.
@@ -123,7 +100,7 @@ func (p *playlistRepository) GetById(id uuid.UUID) (*model.Playlist, error) {
 
 // CreateAll implements PlaylistRepository.
 func (p *playlistRepository) CreateAll(playlists []model.Playlist) ([]model.Playlist, error) {
-	statement := table.Playlist.INSERT(table.Playlist.UserID, table.Playlist.Name).
+	statement := table.Playlist.INSERT(table.Playlist.UserID, table.Playlist.Name, table.Playlist.Search).
 		MODELS(playlists).
 		RETURNING(table.Playlist.AllColumns)
 
@@ -137,9 +114,10 @@ func (p *playlistRepository) CreateAll(playlists []model.Playlist) ([]model.Play
 	return playlistEntities, nil
 }
 
-// GetAll implements PlaylistRepository.
-func (p *playlistRepository) GetAll() ([]model.Playlist, error) {
-	statement := table.Playlist.SELECT(table.Playlist.AllColumns)
+// GetAll implements PlaylistRepository. Only the playlists that the user owns are returned.
+func (p *playlistRepository) GetAll(userId uuid.UUID) ([]model.Playlist, error) {
+	statement := table.Playlist.SELECT(table.Playlist.AllColumns).
+		WHERE(table.Playlist.UserID.EQ(postgres.UUID(userId)))
 
 	util.DebugCheck(p.env, statement)
 
@@ -0,0 +1,109 @@
+package playlistRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+// GetShared implements PlaylistRepository.
+func (p *playlistRepository) GetShared(userId uuid.UUID) ([]models.SharedPlaylist, error) {
+	statement := sharedStatement(userId)
+
+	util.DebugCheck(p.env, statement)
+
+	var playlists []models.SharedPlaylist
+	if err := statement.QueryContext(p.ctx, p.db, &playlists); err != nil {
+		return nil, errs.BuildError(err, "could not get playlists shared with user %v", userId.String())
+	}
+
+	return playlists, nil
+}
+
+// GetUser implements PlaylistRepository.
+func (p *playlistRepository) GetUser(id, userId uuid.UUID) (*model.PlaylistUser, error) {
+	statement := table.PlaylistUser.SELECT(table.PlaylistUser.AllColumns).
+		FROM(table.PlaylistUser).
+		WHERE(table.PlaylistUser.PlaylistID.EQ(postgres.UUID(id)).
+			AND(table.PlaylistUser.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(p.env, statement)
+
+	var users []model.PlaylistUser
+	if err := statement.QueryContext(p.ctx, p.db, &users); err != nil {
+		return nil, errs.BuildError(err, "could not get user %v of playlist %v", userId.String(), id.String())
+	}
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	return &users[0], nil
+}
+
+// GetUsers implements PlaylistRepository.
+func (p *playlistRepository) GetUsers(id uuid.UUID) ([]model.PlaylistUser, error) {
+	statement := table.PlaylistUser.SELECT(table.PlaylistUser.AllColumns).
+		FROM(table.PlaylistUser).
+		WHERE(table.PlaylistUser.PlaylistID.EQ(postgres.UUID(id))).
+		ORDER_BY(table.PlaylistUser.Created.ASC())
+
+	util.DebugCheck(p.env, statement)
+
+	var users []model.PlaylistUser
+	if err := statement.QueryContext(p.ctx, p.db, &users); err != nil {
+		return nil, errs.BuildError(err, "could not get users of playlist %v", id.String())
+	}
+
+	return users, nil
+}
+
+// AddUser implements PlaylistRepository. Adding a user that already has access changes their access.
+func (p *playlistRepository) AddUser(id, userId uuid.UUID, access model.PlaylistAccessEnum) error {
+	statement := addUserStatement(id, userId, access)
+
+	util.DebugCheck(p.env, statement)
+
+	if _, err := statement.ExecContext(p.ctx, p.db); err != nil {
+		return errs.BuildError(err, "could not add user %v to playlist %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
+// RemoveUser implements PlaylistRepository.
+func (p *playlistRepository) RemoveUser(id, userId uuid.UUID) error {
+	statement := table.PlaylistUser.DELETE().
+		WHERE(table.PlaylistUser.PlaylistID.EQ(postgres.UUID(id)).
+			AND(table.PlaylistUser.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(p.env, statement)
+
+	if _, err := statement.ExecContext(p.ctx, p.db); err != nil {
+		return errs.BuildError(err, "could not remove user %v from playlist %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
+func sharedStatement(userId uuid.UUID) postgres.SelectStatement {
+	return table.Playlist.SELECT(table.Playlist.AllColumns, table.PlaylistUser.AllColumns).
+		FROM(table.Playlist.
+			INNER_JOIN(table.PlaylistUser, table.PlaylistUser.PlaylistID.EQ(table.Playlist.ID))).
+		WHERE(table.PlaylistUser.UserID.EQ(postgres.UUID(userId))).
+		ORDER_BY(table.Playlist.Name.ASC())
+}
+
+func addUserStatement(id, userId uuid.UUID, access model.PlaylistAccessEnum) postgres.InsertStatement {
+	return table.PlaylistUser.INSERT(table.PlaylistUser.PlaylistID, table.PlaylistUser.UserID, table.PlaylistUser.Access).
+		MODEL(model.PlaylistUser{PlaylistID: id, UserID: userId, Access: access}).
+		ON_CONFLICT(table.PlaylistUser.PlaylistID, table.PlaylistUser.UserID).
+		DO_UPDATE(postgres.SET(
+			table.PlaylistUser.Access.SET(table.PlaylistUser.EXCLUDED.Access),
+			table.PlaylistUser.Modified.SET(postgres.LOCALTIMESTAMP()),
+		))
+}
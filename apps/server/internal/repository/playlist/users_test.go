@@ -0,0 +1,23 @@
+package playlistRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+)
+
+func Test_SharedStatement(t *testing.T) {
+	actual, _ := sharedStatement(uuid.New()).Sql()
+
+	expected := "\nSELECT playlist.id AS \"playlist.id\",\n     playlist.name AS \"playlist.name\",\n     playlist.user_id AS \"playlist.user_id\",\n     playlist.created AS \"playlist.created\",\n     playlist.modified AS \"playlist.modified\",\n     playlist.ghost_id AS \"playlist.ghost_id\",\n     playlist.search AS \"playlist.search\",\n     playlist_user.id AS \"playlist_user.id\",\n     playlist_user.playlist_id AS \"playlist_user.playlist_id\",\n     playlist_user.user_id AS \"playlist_user.user_id\",\n     playlist_user.access AS \"playlist_user.access\",\n     playlist_user.created AS \"playlist_user.created\",\n     playlist_user.modified AS \"playlist_user.modified\"\nFROM public.playlist\n     INNER JOIN public.playlist_user ON (playlist_user.playlist_id = playlist.id)\nWHERE playlist_user.user_id = $1::uuid\nORDER BY playlist.name ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_AddUserStatement(t *testing.T) {
+	actual, _ := addUserStatement(uuid.New(), uuid.New(), model.PlaylistAccessEnum_Collaborate).Sql()
+
+	expected := "\nINSERT INTO public.playlist_user (playlist_id, user_id, access)\nVALUES ($1, $2, $3)\nON CONFLICT (playlist_id, user_id) DO UPDATE\n       SET access = excluded.access,\n           modified = LOCALTIMESTAMP;\n"
+	assert.Eq(t, expected, actual)
+}
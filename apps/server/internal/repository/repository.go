@@ -15,13 +15,17 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	apiTokenRepository "github.com/slugger7/exorcist/apps/server/internal/repository/api_token"
 	imageRepository "github.com/slugger7/exorcist/apps/server/internal/repository/image"
 	jobRepository "github.com/slugger7/exorcist/apps/server/internal/repository/job"
+	jobScheduleRepository "github.com/slugger7/exorcist/apps/server/internal/repository/job_schedule"
 	libraryRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library"
 	libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library_path"
+	loginRepository "github.com/slugger7/exorcist/apps/server/internal/repository/login"
 	mediaRepository "github.com/slugger7/exorcist/apps/server/internal/repository/media"
 	personRepository "github.com/slugger7/exorcist/apps/server/internal/repository/person"
 	playlistRepository "github.com/slugger7/exorcist/apps/server/internal/repository/playlist"
+	sessionRepository "github.com/slugger7/exorcist/apps/server/internal/repository/session"
 	tagRepository "github.com/slugger7/exorcist/apps/server/internal/repository/tag"
 	userRepository "github.com/slugger7/exorcist/apps/server/internal/repository/user"
 	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
@@ -32,7 +36,9 @@ type Repository interface {
 
 	Close() error
 
+	ApiToken() apiTokenRepository.ApiTokenRepository
 	Job() jobRepository.JobRepository
+	JobSchedule() jobScheduleRepository.JobScheduleRepository
 	Library() libraryRepository.LibraryRepository
 	LibraryPath() libraryPathRepository.LibraryPathRepository
 	Video() videoRepository.VideoRepository
@@ -42,13 +48,17 @@ type Repository interface {
 	Person() personRepository.PersonRepository
 	Tag() tagRepository.TagRepository
 	Playlist() playlistRepository.PlaylistRepository
+	Session() sessionRepository.SessionRepository
+	Login() loginRepository.LoginRepository
 }
 
 type repository struct {
 	db              *sql.DB
 	logger          logger.Logger
 	env             *environment.EnvironmentVariables
+	apiTokenRepo    apiTokenRepository.ApiTokenRepository
 	jobRepo         jobRepository.JobRepository
+	jobScheduleRepo jobScheduleRepository.JobScheduleRepository
 	libraryRepo     libraryRepository.LibraryRepository
 	libraryPathRepo libraryPathRepository.LibraryPathRepository
 	videoRepo       videoRepository.VideoRepository
@@ -58,6 +68,8 @@ type repository struct {
 	personRepo      personRepository.PersonRepository
 	tagRepo         tagRepository.TagRepository
 	playlistRepo    playlistRepository.PlaylistRepository
+	sessionRepo     sessionRepository.SessionRepository
+	loginRepo       loginRepository.LoginRepository
 }
 
 var dbInstance *repository
@@ -80,7 +92,9 @@ func New(env *environment.EnvironmentVariables, context context.Context) Reposit
 			db:              db,
 			env:             env,
 			logger:          logger.New(env),
+			apiTokenRepo:    apiTokenRepository.New(env, db, context),
 			jobRepo:         jobRepository.New(db, env, context),
+			jobScheduleRepo: jobScheduleRepository.New(env, db, context),
 			libraryRepo:     libraryRepository.New(db, env, context),
 			libraryPathRepo: libraryPathRepository.New(db, env, context),
 			videoRepo:       videoRepository.New(db, env, context),
@@ -90,6 +104,8 @@ func New(env *environment.EnvironmentVariables, context context.Context) Reposit
 			personRepo:      personRepository.New(env, db, context),
 			tagRepo:         tagRepository.New(env, db, context),
 			playlistRepo:    playlistRepository.New(env, db, context),
+			sessionRepo:     sessionRepository.New(env, db, context),
+			loginRepo:       loginRepository.New(env, db, context),
 		}
 
 		err = dbInstance.runMigrations()
@@ -102,11 +118,21 @@ func New(env *environment.EnvironmentVariables, context context.Context) Reposit
 	return dbInstance
 }
 
+func (s *repository) ApiToken() apiTokenRepository.ApiTokenRepository {
+	s.logger.Debug("Getting api token repo")
+	return s.apiTokenRepo
+}
+
 func (s *repository) Job() jobRepository.JobRepository {
 	s.logger.Debug("Getting job repo")
 	return s.jobRepo
 }
 
+func (s *repository) JobSchedule() jobScheduleRepository.JobScheduleRepository {
+	s.logger.Debug("Getting job schedule repo")
+	return s.jobScheduleRepo
+}
+
 func (s *repository) Library() libraryRepository.LibraryRepository {
 	s.logger.Debug("Getting library repo")
 	return s.libraryRepo
@@ -153,6 +179,18 @@ func (s *repository) Playlist() playlistRepository.PlaylistRepository {
 	return dbInstance.playlistRepo
 }
 
+// Session implements IRepository.
+func (s *repository) Session() sessionRepository.SessionRepository {
+	s.logger.Debug("Getting session repo")
+	return dbInstance.sessionRepo
+}
+
+// Login implements IRepository.
+func (s *repository) Login() loginRepository.LoginRepository {
+	s.logger.Debug("Getting login repo")
+	return dbInstance.loginRepo
+}
+
 // Health checks the health of the database connection by pinging the database.
 // It returns a map with keys indicating various health statistics.
 func (s *repository) Health() map[string]string {
@@ -0,0 +1,230 @@
+package sessionRepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+type SessionRepository interface {
+	GetById(id uuid.UUID) (*model.UserSession, error)
+	GetByUserId(userId uuid.UUID) ([]model.UserSession, error)
+	Create(m model.UserSession) (*model.UserSession, error)
+	Update(m model.UserSession) (bool, error)
+	UpdateLastSeen(m model.UserSession) error
+	Delete(id uuid.UUID) error
+	DeleteForUser(id, userId uuid.UUID) (bool, error)
+	DeleteAllForUser(userId uuid.UUID, except *uuid.UUID) error
+	DeleteExpired() error
+}
+
+type sessionRepository struct {
+	env *environment.EnvironmentVariables
+	db  *sql.DB
+	ctx context.Context
+}
+
+// GetById implements SessionRepository. Expired sessions are not returned.
+func (r *sessionRepository) GetById(id uuid.UUID) (*model.UserSession, error) {
+	statement := table.UserSession.SELECT(table.UserSession.AllColumns).
+		FROM(table.UserSession).
+		WHERE(table.UserSession.ID.EQ(postgres.UUID(id)).
+			AND(table.UserSession.Expires.GT(postgres.LOCALTIMESTAMP())))
+
+	util.DebugCheck(r.env, statement)
+
+	var sessions []model.UserSession
+	if err := statement.QueryContext(r.ctx, r.db, &sessions); err != nil {
+		return nil, errs.BuildError(err, "could not query session by id: %v", id.String())
+	}
+
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+
+	return &sessions[0], nil
+}
+
+// GetByUserId implements SessionRepository. Expired sessions are not returned.
+func (r *sessionRepository) GetByUserId(userId uuid.UUID) ([]model.UserSession, error) {
+	statement := table.UserSession.SELECT(table.UserSession.AllColumns).
+		FROM(table.UserSession).
+		WHERE(table.UserSession.UserID.EQ(postgres.UUID(userId)).
+			AND(table.UserSession.Expires.GT(postgres.LOCALTIMESTAMP()))).
+		ORDER_BY(table.UserSession.LastSeen.DESC())
+
+	util.DebugCheck(r.env, statement)
+
+	var sessions []model.UserSession
+	if err := statement.QueryContext(r.ctx, r.db, &sessions); err != nil {
+		return nil, errs.BuildError(err, "could not query sessions of user: %v", userId.String())
+	}
+
+	return sessions, nil
+}
+
+// Create implements SessionRepository.
+func (r *sessionRepository) Create(m model.UserSession) (*model.UserSession, error) {
+	statement := table.UserSession.INSERT(
+		table.UserSession.UserID,
+		table.UserSession.Data,
+		table.UserSession.UserAgent,
+		table.UserSession.IPAddress,
+		table.UserSession.Expires,
+	).
+		MODEL(m).
+		RETURNING(table.UserSession.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var session model.UserSession
+	if err := statement.QueryContext(r.ctx, r.db, &session); err != nil {
+		return nil, errs.BuildError(err, "could not create session for user: %v", m.UserID.String())
+	}
+
+	return &session, nil
+}
+
+// Update implements SessionRepository. Nothing is updated when the session has been revoked in the meantime.
+// The user of a session never changes, logging in creates a new session instead.
+func (r *sessionRepository) Update(m model.UserSession) (bool, error) {
+	m.Modified = time.Now()
+	m.LastSeen = m.Modified
+
+	statement := table.UserSession.UPDATE(
+		table.UserSession.Data,
+		table.UserSession.Expires,
+		table.UserSession.LastSeen,
+		table.UserSession.Modified,
+	).
+		MODEL(m).
+		WHERE(table.UserSession.ID.EQ(postgres.UUID(m.ID)))
+
+	util.DebugCheck(r.env, statement)
+
+	res, err := statement.ExecContext(r.ctx, r.db)
+	if err != nil {
+		return false, errs.BuildError(err, "could not update session: %v", m.ID.String())
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.BuildError(err, "could not determine if session was updated: %v", m.ID.String())
+	}
+
+	return affected == 1, nil
+}
+
+// UpdateLastSeen implements SessionRepository.
+func (r *sessionRepository) UpdateLastSeen(m model.UserSession) error {
+	statement := table.UserSession.UPDATE(
+		table.UserSession.LastSeen,
+		table.UserSession.UserAgent,
+		table.UserSession.IPAddress,
+	).
+		MODEL(m).
+		WHERE(table.UserSession.ID.EQ(postgres.UUID(m.ID)))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not update last seen of session: %v", m.ID.String())
+	}
+
+	return nil
+}
+
+// Delete implements SessionRepository.
+func (r *sessionRepository) Delete(id uuid.UUID) error {
+	statement := table.UserSession.DELETE().
+		WHERE(table.UserSession.ID.EQ(postgres.UUID(id)))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete session: %v", id.String())
+	}
+
+	return nil
+}
+
+// DeleteForUser implements SessionRepository. Sessions can only be deleted by the user that they belong to.
+func (r *sessionRepository) DeleteForUser(id, userId uuid.UUID) (bool, error) {
+	statement := table.UserSession.DELETE().
+		WHERE(table.UserSession.ID.EQ(postgres.UUID(id)).
+			AND(table.UserSession.UserID.EQ(postgres.UUID(userId))))
+
+	util.DebugCheck(r.env, statement)
+
+	res, err := statement.ExecContext(r.ctx, r.db)
+	if err != nil {
+		return false, errs.BuildError(err, "could not delete session %v of user %v", id.String(), userId.String())
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.BuildError(err, "could not determine if session was deleted: %v", id.String())
+	}
+
+	return affected == 1, nil
+}
+
+// DeleteAllForUser implements SessionRepository.
+func (r *sessionRepository) DeleteAllForUser(userId uuid.UUID, except *uuid.UUID) error {
+	statement := r.deleteAllForUserStatement(userId, except)
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete sessions of user: %v", userId.String())
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) deleteAllForUserStatement(userId uuid.UUID, except *uuid.UUID) postgres.DeleteStatement {
+	whr := table.UserSession.UserID.EQ(postgres.UUID(userId))
+	if except != nil {
+		whr = whr.AND(table.UserSession.ID.NOT_EQ(postgres.UUID(*except)))
+	}
+
+	return table.UserSession.DELETE().WHERE(whr)
+}
+
+// DeleteExpired implements SessionRepository.
+func (r *sessionRepository) DeleteExpired() error {
+	statement := table.UserSession.DELETE().
+		WHERE(table.UserSession.Expires.LT_EQ(postgres.LOCALTIMESTAMP()))
+
+	util.DebugCheck(r.env, statement)
+
+	if _, err := statement.ExecContext(r.ctx, r.db); err != nil {
+		return errs.BuildError(err, "could not delete expired sessions")
+	}
+
+	return nil
+}
+
+var sessionRepositoryInstance *sessionRepository
+
+func New(env *environment.EnvironmentVariables, db *sql.DB, context context.Context) SessionRepository {
+	if sessionRepositoryInstance != nil {
+		return sessionRepositoryInstance
+	}
+
+	sessionRepositoryInstance = &sessionRepository{
+		env: env,
+		db:  db,
+		ctx: context,
+	}
+
+	return sessionRepositoryInstance
+}
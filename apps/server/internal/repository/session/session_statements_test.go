@@ -0,0 +1,28 @@
+package sessionRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+)
+
+var sr = sessionRepository{
+	env: &environment.EnvironmentVariables{DebugSql: false},
+}
+
+func Test_DeleteAllForUserStatement(t *testing.T) {
+	actual, _ := sr.deleteAllForUserStatement(uuid.New(), nil).Sql()
+
+	expected := "\nDELETE FROM public.user_session\nWHERE user_session.user_id = $1::uuid;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_DeleteAllForUserStatement_ExceptCurrent(t *testing.T) {
+	except := uuid.New()
+	actual, _ := sr.deleteAllForUserStatement(uuid.New(), &except).Sql()
+
+	expected := "\nDELETE FROM public.user_session\nWHERE (user_session.user_id = $1::uuid) AND (user_session.id != $2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
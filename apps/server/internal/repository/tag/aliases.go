@@ -0,0 +1,97 @@
+package tagRepository
+
+import (
+	"strings"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+var tagAlias = table.TagAlias
+
+// GetByAlias implements TagRepository.
+func (r *tagRepository) GetByAlias(alias string) (*model.Tag, error) {
+	statement := r.getByAliasStatement(alias)
+
+	util.DebugCheck(r.env, statement)
+
+	var tags []model.Tag
+	if err := statement.QueryContext(r.ctx, r.db, &tags); err != nil {
+		return nil, errs.BuildError(err, "could not query tag by alias %v", alias)
+	}
+
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	return &tags[0], nil
+}
+
+// GetAliases implements TagRepository.
+func (r *tagRepository) GetAliases(id uuid.UUID) ([]model.TagAlias, error) {
+	statement := tagAlias.SELECT(tagAlias.AllColumns).
+		FROM(tagAlias).
+		WHERE(tagAlias.TagID.EQ(postgres.UUID(id))).
+		ORDER_BY(tagAlias.Alias_.ASC())
+
+	util.DebugCheck(r.env, statement)
+
+	var aliases []model.TagAlias
+	if err := statement.QueryContext(r.ctx, r.db, &aliases); err != nil {
+		return nil, errs.BuildError(err, "could not query aliases of tag %v", id.String())
+	}
+
+	return aliases, nil
+}
+
+// CreateAlias implements TagRepository.
+func (r *tagRepository) CreateAlias(m model.TagAlias) (*model.TagAlias, error) {
+	statement := tagAlias.INSERT(tagAlias.TagID, tagAlias.Alias_).
+		MODEL(m).
+		RETURNING(tagAlias.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var created model.TagAlias
+	if err := statement.QueryContext(r.ctx, r.db, &created); err != nil {
+		return nil, errs.BuildError(err, "could not create alias %v for tag %v", m.Alias, m.TagID.String())
+	}
+
+	return &created, nil
+}
+
+// DeleteAlias implements TagRepository. Returns false when the tag has no alias with the id.
+func (r *tagRepository) DeleteAlias(id, aliasId uuid.UUID) (bool, error) {
+	statement := r.deleteAliasStatement(id, aliasId)
+
+	util.DebugCheck(r.env, statement)
+
+	res, err := statement.ExecContext(r.ctx, r.db)
+	if err != nil {
+		return false, errs.BuildError(err, "could not delete alias %v of tag %v", aliasId.String(), id.String())
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.BuildError(err, "could not get affected rows of alias delete")
+	}
+
+	return affected != 0, nil
+}
+
+func (r *tagRepository) getByAliasStatement(alias string) postgres.SelectStatement {
+	return tag.SELECT(tag.AllColumns).
+		FROM(tag.INNER_JOIN(tagAlias, tagAlias.TagID.EQ(tag.ID))).
+		WHERE(postgres.LOWER(tagAlias.Alias_).EQ(postgres.String(strings.ToLower(alias)))).
+		LIMIT(1)
+}
+
+func (r *tagRepository) deleteAliasStatement(id, aliasId uuid.UUID) postgres.DeleteStatement {
+	return tagAlias.DELETE().
+		WHERE(tagAlias.ID.EQ(postgres.UUID(aliasId)).
+			AND(tagAlias.TagID.EQ(postgres.UUID(id))))
+}
@@ -0,0 +1,25 @@
+package tagRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+)
+
+var r = tagRepository{}
+
+func Test_GetByAliasStatement(t *testing.T) {
+	actual, args := r.getByAliasStatement("Alias").Sql()
+
+	expected := "\nSELECT tag.id AS \"tag.id\",\n     tag.name AS \"tag.name\",\n     tag.created AS \"tag.created\",\n     tag.modified AS \"tag.modified\",\n     tag.ghost_id AS \"tag.ghost_id\"\nFROM public.tag\n     INNER JOIN public.tag_alias ON (tag_alias.tag_id = tag.id)\nWHERE LOWER(tag_alias.alias) = $1::text\nLIMIT $2;\n"
+	assert.Eq(t, expected, actual)
+	assert.Eq(t, "alias", args[0].(string))
+}
+
+func Test_DeleteAliasStatement(t *testing.T) {
+	actual, _ := r.deleteAliasStatement(uuid.New(), uuid.New()).Sql()
+
+	expected := "\nDELETE FROM public.tag_alias\nWHERE (tag_alias.id = $1::uuid) AND (tag_alias.tag_id = $2::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
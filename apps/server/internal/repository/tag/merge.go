@@ -0,0 +1,84 @@
+package tagRepository
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/helpers"
+	"github.com/slugger7/exorcist/apps/server/internal/repository/util"
+)
+
+// Merge implements TagRepository.
+// Media of the merged tags is moved to the kept tag, their names and aliases become aliases of the kept tag
+// and the merged tags are deleted. Everything happens in one transaction.
+// Returns the ids of the media that had any of the merged tags.
+func (r *tagRepository) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	tx, err := r.db.BeginTx(r.ctx, nil)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not begin transaction to merge tags into %v", keepId.String())
+	}
+	defer tx.Rollback()
+
+	affectedStatement := r.mergedMediaStatement(mergeIds)
+
+	util.DebugCheck(r.env, affectedStatement)
+
+	var affected []struct {
+		MediaID uuid.UUID `alias:"media_tag.media_id"`
+	}
+	if err := affectedStatement.QueryContext(r.ctx, tx, &affected); err != nil {
+		return nil, errs.BuildError(err, "could not query media of tags merged into %v", keepId.String())
+	}
+
+	for _, statement := range r.mergeStatements(keepId, mergeIds) {
+		util.DebugCheck(r.env, statement)
+
+		if _, err := statement.ExecContext(r.ctx, tx); err != nil {
+			return nil, errs.BuildError(err, "could not merge tags into %v", keepId.String())
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errs.BuildError(err, "could not commit merging tags into %v", keepId.String())
+	}
+
+	mediaIds := make([]uuid.UUID, len(affected))
+	for i, a := range affected {
+		mediaIds[i] = a.MediaID
+	}
+
+	return mediaIds, nil
+}
+
+func (r *tagRepository) mergedMediaStatement(mergeIds []uuid.UUID) postgres.SelectStatement {
+	return mediaTag.SELECT(mediaTag.MediaID).
+		DISTINCT().
+		FROM(mediaTag).
+		WHERE(mediaTag.TagID.IN(helpers.UUIDExpressions(mergeIds)...))
+}
+
+func (r *tagRepository) mergeStatements(keepId uuid.UUID, mergeIds []uuid.UUID) []postgres.Statement {
+	keep := postgres.UUID(keepId)
+	ids := helpers.UUIDExpressions(mergeIds)
+
+	media := helpers.MergeTable{
+		Table:    mediaTag,
+		ID:       mediaTag.ID,
+		Owner:    mediaTag.TagID,
+		Key:      mediaTag.MediaID,
+		Modified: mediaTag.Modified,
+	}
+
+	return []postgres.Statement{
+		media.MoveStatement(keepId, ids),
+		tagAlias.UPDATE(tagAlias.TagID, tagAlias.Modified).
+			SET(keep, postgres.LOCALTIMESTAMP()).
+			WHERE(tagAlias.TagID.IN(ids...)),
+		tagAlias.INSERT(tagAlias.TagID, tagAlias.Alias_).
+			QUERY(tag.SELECT(keep, tag.Name).
+				FROM(tag).
+				WHERE(tag.ID.IN(ids...))),
+		// media that already had the kept tag is left behind and removed along with the merged tags
+		tag.DELETE().WHERE(tag.ID.IN(ids...)),
+	}
+}
@@ -0,0 +1,47 @@
+package tagRepository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+)
+
+func Test_MergedMediaStatement(t *testing.T) {
+	actual, _ := r.mergedMediaStatement([]uuid.UUID{uuid.New()}).Sql()
+
+	expected := "\nSELECT DISTINCT media_tag.media_id AS \"media_tag.media_id\"\nFROM public.media_tag\nWHERE media_tag.tag_id IN ($1::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_MovesMediaWithoutDuplicates(t *testing.T) {
+	statements := r.mergeStatements(uuid.New(), []uuid.UUID{uuid.New(), uuid.New()})
+
+	actual, _ := statements[0].Sql()
+
+	expected := "\nUPDATE public.media_tag\nSET (tag_id, modified) = ($1::uuid, LOCALTIMESTAMP)\nWHERE (media_tag.id IN ((\n           SELECT DISTINCT ON (media_tag.media_id) media_tag.id AS \"media_tag.id\"\n           FROM public.media_tag\n           WHERE media_tag.tag_id IN ($2::uuid, $3::uuid)\n           ORDER BY media_tag.media_id, media_tag.modified DESC\n      ))) AND (media_tag.media_id NOT IN ((\n           SELECT media_tag.media_id AS \"media_tag.media_id\"\n           FROM public.media_tag\n           WHERE media_tag.tag_id = $4::uuid\n      )));\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_TurnsNamesIntoAliases(t *testing.T) {
+	statements := r.mergeStatements(uuid.New(), []uuid.UUID{uuid.New()})
+
+	actual, _ := statements[1].Sql()
+
+	expected := "\nUPDATE public.tag_alias\nSET (tag_id, modified) = ($1::uuid, LOCALTIMESTAMP)\nWHERE tag_alias.tag_id IN ($2::uuid);\n"
+	assert.Eq(t, expected, actual)
+
+	actual, _ = statements[2].Sql()
+
+	expected = "\nINSERT INTO public.tag_alias (tag_id, alias) (\n     SELECT $1::uuid,\n          tag.name AS \"tag.name\"\n     FROM public.tag\n     WHERE tag.id IN ($2::uuid)\n);\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_MergeStatements_DeletesMergedTags(t *testing.T) {
+	statements := r.mergeStatements(uuid.New(), []uuid.UUID{uuid.New()})
+
+	actual, _ := statements[len(statements)-1].Sql()
+
+	expected := "\nDELETE FROM public.tag\nWHERE tag.id IN ($1::uuid);\n"
+	assert.Eq(t, expected, actual)
+}
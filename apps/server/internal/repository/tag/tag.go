@@ -33,6 +33,11 @@ type TagRepository interface {
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
 	Update(m model.Tag) (*model.Tag, error)
 	Delete(id uuid.UUID) error
+	GetByAlias(alias string) (*model.Tag, error)
+	GetAliases(id uuid.UUID) ([]model.TagAlias, error)
+	CreateAlias(m model.TagAlias) (*model.TagAlias, error)
+	DeleteAlias(id, aliasId uuid.UUID) (bool, error)
+	Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error)
 }
 
 type tagRepository struct {
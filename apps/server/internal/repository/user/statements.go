@@ -36,9 +36,9 @@ func (ur *userRepository) getUserByUsernameStatement(username string, columns ..
 }
 
 func (ur *userRepository) createStatement(user model.User) *UserStatement {
-	statement := table.User.INSERT(table.User.Username, table.User.Password).
+	statement := table.User.INSERT(table.User.Username, table.User.Password, table.User.Role).
 		MODEL(user).
-		RETURNING(table.User.ID, table.User.Username, table.User.Active, table.User.Created, table.User.Modified)
+		RETURNING(table.User.ID, table.User.Username, table.User.Active, table.User.Role, table.User.Created, table.User.Modified)
 
 	util.DebugCheck(ur.env, statement)
 	return &UserStatement{statement, ur.db, ur.ctx}
@@ -57,7 +57,7 @@ func (ur *userRepository) getByIdStatement(id uuid.UUID) *UserStatement {
 
 func (ur *userRepository) updatePasswordStatement(user *model.User) *UserStatement {
 	user.Modified = time.Now()
-	statement := table.User.UPDATE(table.User.Password, table.User.Modified).
+	statement := table.User.UPDATE(table.User.Password, table.User.MustChangePassword, table.User.Modified).
 		MODEL(user).
 		WHERE(table.User.ID.EQ(postgres.UUID(user.ID)))
 
@@ -65,3 +65,36 @@ func (ur *userRepository) updatePasswordStatement(user *model.User) *UserStateme
 
 	return &UserStatement{statement, ur.db, ur.ctx}
 }
+
+func (ur *userRepository) getAllStatement() *UserStatement {
+	statement := table.User.SELECT(table.User.AllColumns.Except(table.User.Password, table.User.GhostID)).
+		FROM(table.User).
+		ORDER_BY(postgres.LOWER(table.User.Username).ASC())
+
+	util.DebugCheck(ur.env, statement)
+
+	return &UserStatement{statement, ur.db, ur.ctx}
+}
+
+func (ur *userRepository) updateStatement(user model.User) *UserStatement {
+	user.Modified = time.Now()
+	statement := table.User.UPDATE(table.User.Username, table.User.Role, table.User.Active, table.User.Modified).
+		MODEL(user).
+		WHERE(table.User.ID.EQ(postgres.UUID(user.ID)))
+
+	util.DebugCheck(ur.env, statement)
+
+	return &UserStatement{statement, ur.db, ur.ctx}
+}
+
+func (ur *userRepository) isUsernameTakenStatement(username string, except uuid.UUID) *UserStatement {
+	statement := table.User.SELECT(table.User.ID).
+		FROM(table.User).
+		WHERE(postgres.LOWER(table.User.Username).EQ(postgres.String(strings.ToLower(username))).
+			AND(table.User.ID.NOT_EQ(postgres.UUID(except)))).
+		LIMIT(1)
+
+	util.DebugCheck(ur.env, statement)
+
+	return &UserStatement{statement, ur.db, ur.ctx}
+}
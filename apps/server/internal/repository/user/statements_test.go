@@ -3,6 +3,7 @@ package userRepository
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/assert"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
@@ -28,7 +29,7 @@ func Test_Create(t *testing.T) {
 	}
 	actual, _ := s.createStatement(user).Sql()
 
-	exected := "\nINSERT INTO public.\"user\" (username, password)\nVALUES ($1, $2)\nRETURNING \"user\".id AS \"user.id\",\n          \"user\".username AS \"user.username\",\n          \"user\".active AS \"user.active\",\n          \"user\".created AS \"user.created\",\n          \"user\".modified AS \"user.modified\";\n"
+	exected := "\nINSERT INTO public.\"user\" (username, password, role)\nVALUES ($1, $2, $3)\nRETURNING \"user\".id AS \"user.id\",\n          \"user\".username AS \"user.username\",\n          \"user\".active AS \"user.active\",\n          \"user\".role AS \"user.role\",\n          \"user\".created AS \"user.created\",\n          \"user\".modified AS \"user.modified\";\n"
 	if exected != actual {
 		t.Errorf("Expected %v but got %v", exected, actual)
 	}
@@ -38,6 +39,27 @@ func Test_UpdatePassword(t *testing.T) {
 	u := model.User{}
 	actual, _ := s.updatePasswordStatement(&u).Sql()
 
-	expected := "\nUPDATE public.\"user\"\nSET (password, modified) = ($1, $2)\nWHERE \"user\".id = $3::uuid;\n"
+	expected := "\nUPDATE public.\"user\"\nSET (password, must_change_password, modified) = ($1, $2, $3)\nWHERE \"user\".id = $4::uuid;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_GetAllStatement(t *testing.T) {
+	actual, _ := s.getAllStatement().Sql()
+
+	expected := "\nSELECT \"user\".id AS \"user.id\",\n     \"user\".username AS \"user.username\",\n     \"user\".active AS \"user.active\",\n     \"user\".created AS \"user.created\",\n     \"user\".modified AS \"user.modified\",\n     \"user\".role AS \"user.role\",\n     \"user\".must_change_password AS \"user.must_change_password\"\nFROM public.\"user\"\nORDER BY LOWER(\"user\".username) ASC;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_UpdateStatement(t *testing.T) {
+	actual, _ := s.updateStatement(model.User{}).Sql()
+
+	expected := "\nUPDATE public.\"user\"\nSET (username, role, active, modified) = ($1, $2, $3, $4)\nWHERE \"user\".id = $5::uuid;\n"
+	assert.Eq(t, expected, actual)
+}
+
+func Test_IsUsernameTakenStatement(t *testing.T) {
+	actual, _ := s.isUsernameTakenStatement("Someone", uuid.Nil).Sql()
+
+	expected := "\nSELECT \"user\".id AS \"user.id\"\nFROM public.\"user\"\nWHERE (LOWER(\"user\".username) = $1::text) AND (\"user\".id != $2::uuid)\nLIMIT $3;\n"
 	assert.Eq(t, expected, actual)
 }
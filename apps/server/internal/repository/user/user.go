@@ -3,7 +3,6 @@ package userRepository
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"log"
 
 	"github.com/go-jet/jet/v2/postgres"
@@ -30,6 +29,10 @@ type UserRepository interface {
 	AddMediaToFavourites(userId uuid.UUID, mediaId uuid.UUID) error
 	GetFavourite(id, mediaId uuid.UUID) (*model.FavouriteMedia, error)
 	RemoveFavourite(id, mediaId uuid.UUID) error
+	GetAll() ([]model.User, error)
+	Update(user model.User) error
+	Delete(id uuid.UUID) (bool, error)
+	IsUsernameTaken(username string, except uuid.UUID) (bool, error)
 }
 
 type userRepository struct {
@@ -149,7 +152,7 @@ func (ur *userRepository) GetById(id uuid.UUID) (*model.User, error) {
 	}
 
 	if len(users) == 0 {
-		return nil, fmt.Errorf("no user found by id: %v", id)
+		return nil, nil
 	}
 
 	return &users[len(users)-1].User, nil
@@ -162,3 +165,52 @@ func (ur *userRepository) UpdatePassword(user *model.User) error {
 
 	return nil
 }
+
+// GetAll implements UserRepository. Inactive users are included and passwords are left out.
+func (ur *userRepository) GetAll() ([]model.User, error) {
+	var users []model.User
+	if err := ur.getAllStatement().Query(&users); err != nil {
+		return nil, errs.BuildError(err, "could not get users")
+	}
+
+	return users, nil
+}
+
+// Update implements UserRepository. Only the username, role and active state are updated.
+func (ur *userRepository) Update(user model.User) error {
+	if _, err := ur.updateStatement(user).Exec(); err != nil {
+		return errs.BuildError(err, "could not update user: %v", user.ID)
+	}
+
+	return nil
+}
+
+// Delete implements UserRepository. Favourites, progress, playlists and everything else of the user is deleted along with it.
+func (ur *userRepository) Delete(id uuid.UUID) (bool, error) {
+	statement := table.User.DELETE().
+		WHERE(table.User.ID.EQ(postgres.UUID(id)))
+
+	util.DebugCheck(ur.env, statement)
+
+	res, err := statement.ExecContext(ur.ctx, ur.db)
+	if err != nil {
+		return false, errs.BuildError(err, "could not delete user: %v", id)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errs.BuildError(err, "could not determine if user was deleted: %v", id)
+	}
+
+	return affected == 1, nil
+}
+
+// IsUsernameTaken implements UserRepository. Inactive users still hold on to their username.
+func (ur *userRepository) IsUsernameTaken(username string, except uuid.UUID) (bool, error) {
+	var users []struct{ model.User }
+	if err := ur.isUsernameTakenStatement(username, except).Query(&users); err != nil {
+		return false, errs.BuildError(err, "could not determine if username is taken: %v", username)
+	}
+
+	return len(users) != 0, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
@@ -29,6 +30,8 @@ type VideoRepository interface {
 	Insert(models []model.Video) ([]model.Video, error)
 	GetByIdWithMedia(id uuid.UUID) (*MediaVideoModel, error)
 	GetByMediaId(id uuid.UUID) (*MediaVideoModel, error)
+	GetByLibraryId(libraryId uuid.UUID, pageRequest *dto.PageRequestDTO) (*dto.PageDTO[model.Video], error)
+	Update(m model.Video, columns postgres.ColumnList) (*model.Video, error)
 }
 
 type videoRepository struct {
@@ -59,6 +62,62 @@ func (vr *videoRepository) GetByMediaId(id uuid.UUID) (*MediaVideoModel, error)
 	return &result, nil
 }
 
+// GetByLibraryId implements VideoRepository.
+// Only videos of media that exist and have not been deleted are returned.
+func (vr *videoRepository) GetByLibraryId(libraryId uuid.UUID, pageRequest *dto.PageRequestDTO) (*dto.PageDTO[model.Video], error) {
+	video := table.Video
+	media := table.Media
+
+	statement := video.SELECT(
+		video.AllColumns,
+		postgres.COUNT(postgres.STAR).OVER().AS("total"),
+	).
+		FROM(video.
+			INNER_JOIN(media, video.MediaID.EQ(media.ID)).
+			INNER_JOIN(table.LibraryPath, media.LibraryPathID.EQ(table.LibraryPath.ID)),
+		).
+		WHERE(table.LibraryPath.LibraryID.EQ(postgres.UUID(libraryId)).
+			AND(media.Deleted.IS_FALSE()).
+			AND(media.Exists.IS_TRUE())).
+		ORDER_BY(video.ID.ASC())
+
+	limit := -1
+	skip := -1
+	if pageRequest != nil {
+		limit = pageRequest.Limit
+		skip = pageRequest.Skip
+		statement = statement.LIMIT(int64(pageRequest.Limit)).
+			OFFSET(int64(pageRequest.Skip))
+	}
+
+	util.DebugCheck(vr.env, statement)
+
+	var videoResult []struct {
+		Total int
+		model.Video
+	}
+	if err := statement.QueryContext(vr.ctx, vr.db, &videoResult); err != nil {
+		return nil, errs.BuildError(err, "querying videos for library: %v", libraryId.String())
+	}
+
+	var data []model.Video
+	total := 0
+	if len(videoResult) > 0 {
+		data = make([]model.Video, len(videoResult))
+		total = videoResult[0].Total
+		for i, o := range videoResult {
+			data[i] = o.Video
+		}
+	}
+
+	return &dto.PageDTO[model.Video]{
+		Data:  data,
+		Limit: limit,
+		Skip:  skip,
+		Total: total,
+	}, nil
+}
+
 var videoRepoInstance *videoRepository
 
 func New(db *sql.DB, env *environment.EnvironmentVariables, context context.Context) VideoRepository {
@@ -105,6 +164,15 @@ func (r *videoRepository) Insert(models []model.Video) ([]model.Video, error) {
 		table.Video.Height,
 		table.Video.Width,
 		table.Video.Runtime,
+		table.Video.VideoCodec,
+		table.Video.AudioCodec,
+		table.Video.Container,
+		table.Video.Bitrate,
+		table.Video.VideoBitrate,
+		table.Video.FrameRate,
+		table.Video.PixelFormat,
+		table.Video.AudioStreams,
+		table.Video.SubtitleStreams,
 	).
 		MODELS(models).
 		RETURNING(table.Video.AllColumns)
@@ -137,3 +205,24 @@ func (r *videoRepository) GetByIdWithMedia(id uuid.UUID) (*MediaVideoModel, erro
 
 	return &result, nil
 }
+
+// Update implements VideoRepository.
+func (r *videoRepository) Update(m model.Video, columns postgres.ColumnList) (*model.Video, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	statement := table.Video.UPDATE(columns).
+		MODEL(m).
+		WHERE(table.Video.ID.EQ(postgres.UUID(m.ID))).
+		RETURNING(table.Video.AllColumns)
+
+	util.DebugCheck(r.env, statement)
+
+	var updatedModel model.Video
+	if err := statement.QueryContext(r.ctx, r.db, &updatedModel); err != nil {
+		return nil, errs.BuildError(err, "could not update video: %v", m.ID)
+	}
+
+	return &updatedModel, nil
+}
@@ -1,17 +1,31 @@
 package server
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-contrib/sessions"
-	"github.com/gin-contrib/sessions/cookie"
 	"github.com/gin-gonic/gin"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	userService "github.com/slugger7/exorcist/apps/server/internal/service/user"
 )
 
 const userKey string = "userId"
 
+// tokenScopeKey is where the scope of the api token that authenticated the request is kept in the gin context
+const tokenScopeKey string = "tokenScope"
+
+// currentUserKey is where the user of the request is kept in the gin context once it has been loaded
+const currentUserKey string = "currentUser"
+
 func (s *server) withCookieStore(r *gin.Engine) *server {
-	r.Use(sessions.Sessions("exorcist", cookie.NewStore([]byte(s.env.Secret))))
+	r.Use(sessions.Sessions("exorcist", newSessionStore(s.repo, s.logger, []byte(s.env.Secret))))
 	return s
 }
 
@@ -27,24 +41,131 @@ func (s *server) withAuthLogout(r *gin.RouterGroup, route Route) *server {
 
 const ErrUnauthorized ApiError = "unauthorized"
 
+// AuthRequired accepts requests with a session cookie or with an api token as a bearer token
 func (s *server) AuthRequired(c *gin.Context) {
 	session := sessions.Default(c)
 	user := session.Get(userKey)
 
-	if user == nil || user == "" {
+	if user != nil && user != "" {
+		c.Next()
+		return
+	}
+
+	if token, ok := bearerToken(c); ok {
+		apiToken, err := s.service.User().ValidateApiToken(token)
+		if err == nil {
+			c.Set(userKey, apiToken.UserID.String())
+			if apiToken.Scope != nil {
+				c.Set(tokenScopeKey, *apiToken.Scope)
+			}
+
+			c.Next()
+			return
+		}
+
+		s.logger.Debugf("api token was not accepted: %v", err.Error())
+	}
+
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized})
+}
+
+func bearerToken(c *gin.Context) (string, bool) {
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	token = strings.TrimSpace(token)
+
+	return token, ok && token != ""
+}
+
+const (
+	ErrForbidden              ApiError = "forbidden"
+	ErrPasswordChangeRequired ApiError = "password change required"
+)
+
+// RequirePasswordChanged blocks users that still have to change their password, like the seeded admin
+func (s *server) RequirePasswordChanged(c *gin.Context) {
+	user, err := s.getCurrentUser(c)
+	if err != nil {
+		s.logger.Errorf("could not get current user: %v", err.Error())
 		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized})
 		return
 	}
 
+	if user.MustChangePassword {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": ErrPasswordChangeRequired})
+		return
+	}
+
 	c.Next()
 }
 
+// RequirePermission only lets requests through from users whose role grants the permission
+func (s *server) RequirePermission(permission dto.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := s.getCurrentUser(c)
+		if err != nil {
+			s.logger.Errorf("could not get current user: %v", err.Error())
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized})
+			return
+		}
+
+		if !dto.HasPermission(user.Role, permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": ErrForbidden})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// hasPermission reports whether the user of the request has the permission
+func (s *server) hasPermission(c *gin.Context, permission dto.Permission) (bool, error) {
+	user, err := s.getCurrentUser(c)
+	if err != nil {
+		return false, err
+	}
+
+	return dto.HasPermission(user.Role, permission), nil
+}
+
+func (s *server) getCurrentUser(c *gin.Context) (*model.User, error) {
+	if user, ok := c.Get(currentUserKey); ok {
+		return user.(*model.User), nil
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.service.User().GetById(*userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil || !user.Active {
+		return nil, fmt.Errorf("user %v does not exist or is inactive", userId.String())
+	}
+
+	if scope, ok := c.Get(tokenScopeKey); ok {
+		scoped := *user
+		scoped.Role = dto.LeastPrivileged(user.Role, scope.(model.UserRoleEnum))
+		user = &scoped
+	}
+
+	c.Set(currentUserKey, user)
+
+	return user, nil
+}
+
 type LoginModel struct {
 	Username string `json:"username" binding:"required"`
 	Password string `json:"password" binding:"required"`
 }
 
-const MsgAuthSuccess string = "successfully authenticated user"
+const (
+	MsgAuthSuccess string   = "successfully authenticated user"
+	ErrLoginLocked ApiError = "too many failed login attempts, try again later"
+)
 
 func (s *server) Login(c *gin.Context) {
 	session := sessions.Default(c)
@@ -60,19 +181,40 @@ func (s *server) Login(c *gin.Context) {
 		return
 	}
 
-	user, err := s.service.User().Validate(userBody.Username, userBody.Password)
+	user, err := s.service.User().Authenticate(userService.LoginAttempt{
+		Username:  userBody.Username,
+		Password:  userBody.Password,
+		IpAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	})
 	if err != nil || user == nil {
+		var locked *userService.LoginLockedError
+		if errors.As(err, &locked) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(locked.Until).Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": ErrLoginLocked})
+			return
+		}
+
+		if err != nil && !errors.Is(err, userService.ErrInvalidCredentials) {
+			s.logger.Errorf("could not authenticate %v: %v", userBody.Username, err.Error())
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized})
 		return
 	}
 
+	if err := s.renewSession(session); err != nil {
+		s.logger.Errorf("could not renew session for %v: %v", userBody.Username, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save session"})
+		return
+	}
+
 	session.Set(userKey, user.ID.String())
 	if err := session.Save(); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save session"})
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"userId": user.ID, "username": userBody.Username})
+	c.JSON(http.StatusCreated, gin.H{"userId": user.ID, "username": userBody.Username, "mustChangePassword": user.MustChangePassword})
 }
 
 const (
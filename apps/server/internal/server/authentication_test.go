@@ -5,11 +5,14 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/assert"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	userService "github.com/slugger7/exorcist/apps/server/internal/service/user"
 	"go.uber.org/mock/gomock"
 )
 
@@ -48,6 +51,159 @@ func Test_AuthRequiredMiddleware_Success(t *testing.T) {
 	assert.Body(t, `{"message":"success"}`, rr.Body.String())
 }
 
+func Test_AuthRequiredMiddleware_InvalidApiToken(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	s.mockUserService.EXPECT().
+		ValidateApiToken(gomock.Eq("exo_invalid")).
+		Return(nil, userService.ErrInvalidApiToken).
+		Times(1)
+
+	s.authGroup.GET("/", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	rr := s.withAuthGetRequest("").
+		withBearer("exo_invalid").
+		exec()
+
+	assert.StatusCode(t, http.StatusUnauthorized, rr.Code)
+	assert.Body(t, errBody(ErrUnauthorized), rr.Body.String())
+}
+
+func Test_AuthRequiredMiddleware_ApiToken(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	userId, _ := uuid.NewRandom()
+	s.mockUserService.EXPECT().
+		ValidateApiToken(gomock.Eq("exo_valid")).
+		Return(&model.APIToken{UserID: userId}, nil).
+		Times(1)
+
+	s.authGroup.GET("/", func(ctx *gin.Context) {
+		id, err := s.server.getUserId(ctx)
+		if err != nil {
+			ctx.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		ctx.JSON(http.StatusOK, gin.H{"userId": id})
+	})
+
+	rr := s.withAuthGetRequest("").
+		withBearer("exo_valid").
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, fmt.Sprintf(`{"userId":"%v"}`, userId.String()), rr.Body.String())
+}
+
+func Test_RequirePermission_ApiTokenScopeLimitsRole(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	userId, _ := uuid.NewRandom()
+	scope := model.UserRoleEnum_Viewer
+	s.mockUserService.EXPECT().
+		ValidateApiToken(gomock.Eq("exo_valid")).
+		Return(&model.APIToken{UserID: userId, Scope: &scope}, nil).
+		Times(1)
+	s.mockUserService.EXPECT().
+		GetById(gomock.Eq(userId)).
+		Return(&model.User{ID: userId, Active: true, Role: model.UserRoleEnum_Admin}, nil).
+		Times(1)
+
+	s.authGroup.GET("/", s.server.RequirePermission(dto.Permission_EditMedia), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	rr := s.withAuthGetRequest("").
+		withBearer("exo_valid").
+		exec()
+
+	assert.StatusCode(t, http.StatusForbidden, rr.Code)
+	assert.Body(t, errBody(ErrForbidden), rr.Body.String())
+}
+
+func Test_RequirePermission_RoleWithoutPermission_Forbidden(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id, _ := uuid.NewRandom()
+	s.mockUserService.EXPECT().
+		GetById(gomock.Eq(id)).
+		DoAndReturn(func(uuid.UUID) (*model.User, error) {
+			return &model.User{ID: id, Active: true, Role: model.UserRoleEnum_Viewer}, nil
+		}).
+		Times(1)
+
+	s.authGroup.GET("/", s.server.RequirePermission(dto.Permission_ManageUsers), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusForbidden, rr.Code)
+	assert.Body(t, errBody(ErrForbidden), rr.Body.String())
+}
+
+func Test_RequirePermission_InactiveUser_Unauthorized(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id, _ := uuid.NewRandom()
+	s.mockUserService.EXPECT().
+		GetById(gomock.Eq(id)).
+		DoAndReturn(func(uuid.UUID) (*model.User, error) {
+			return &model.User{ID: id, Active: false, Role: model.UserRoleEnum_Admin}, nil
+		}).
+		Times(1)
+
+	s.authGroup.GET("/", s.server.RequirePermission(dto.Permission_ManageUsers), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusUnauthorized, rr.Code)
+	assert.Body(t, errBody(ErrUnauthorized), rr.Body.String())
+}
+
+func Test_RequirePermission_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id, _ := uuid.NewRandom()
+	s.mockUserService.EXPECT().
+		GetById(gomock.Eq(id)).
+		DoAndReturn(func(uuid.UUID) (*model.User, error) {
+			return &model.User{ID: id, Active: true, Role: model.UserRoleEnum_Editor}, nil
+		}).
+		Times(1)
+
+	s.authGroup.GET("/", s.server.RequirePermission(dto.Permission_EditMedia), s.server.RequirePermission(dto.Permission_RunJobs), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, `{"message":"success"}`, rr.Body.String())
+}
+
 func Test_Login_InvalidBody(t *testing.T) {
 	s := setupServer(t)
 
@@ -66,9 +222,11 @@ func Test_Login_ErrFromValidateUser(t *testing.T) {
 		Password: "somePassword",
 	}
 	s.mockUserService.EXPECT().
-		Validate(gomock.Eq(m.Username), gomock.Eq(m.Password)).
-		DoAndReturn(func(string, string) (*model.User, error) {
-			return nil, fmt.Errorf("some error")
+		Authenticate(gomock.Any()).
+		DoAndReturn(func(attempt userService.LoginAttempt) (*model.User, error) {
+			assert.Eq(t, m.Username, attempt.Username)
+			assert.Eq(t, m.Password, attempt.Password)
+			return nil, userService.ErrInvalidCredentials
 		}).
 		Times(1)
 
@@ -91,16 +249,14 @@ func Test_Login_Success(t *testing.T) {
 	}
 
 	s.mockUserService.EXPECT().
-		Validate(gomock.Eq(l.Username), gomock.Eq(l.Password)).
-		DoAndReturn(func(string, string) (*model.User, error) {
-			return u, nil
-		})
+		Authenticate(gomock.Any()).
+		Return(u, nil)
 
 	s.server.withAuthLogin(&s.engine.RouterGroup, "/")
 	rr := s.withPostRequest(bodyM(l)).exec()
 
 	assert.StatusCode(t, http.StatusCreated, rr.Code)
-	assert.Body(t, fmt.Sprintf(`{"userId":"%v","username":"%v"}`, id, u.Username), rr.Body.String())
+	assert.Body(t, fmt.Sprintf(`{"mustChangePassword":false,"userId":"%v","username":"%v"}`, id, u.Username), rr.Body.String())
 
 	cookie := strings.Trim(rr.Header().Get("Set-Cookie"), " ")
 
@@ -112,6 +268,44 @@ func Test_Login_Success(t *testing.T) {
 	}
 }
 
+func Test_Login_Locked(t *testing.T) {
+	s := setupServer(t).withUserService()
+
+	s.mockUserService.EXPECT().
+		Authenticate(gomock.Any()).
+		Return(nil, &userService.LoginLockedError{Until: time.Now().Add(time.Minute)}).
+		Times(1)
+
+	s.server.withAuthLogin(&s.engine.RouterGroup, "/")
+	rr := s.withPostRequest(bodyM(LoginModel{Username: "admin", Password: "guess"})).exec()
+
+	assert.StatusCode(t, http.StatusTooManyRequests, rr.Code)
+	assert.Body(t, errBody(ErrLoginLocked), rr.Body.String())
+	assert.Eq(t, "60", rr.Header().Get("Retry-After"))
+}
+
+func Test_RequirePasswordChanged_BlocksUntilPasswordChanged(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id := uuid.New()
+	s.mockUserService.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.User{ID: id, Active: true, Role: model.UserRoleEnum_Admin, MustChangePassword: true}, nil).
+		Times(1)
+
+	s.authGroup.GET("/", s.server.RequirePasswordChanged, func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusForbidden, rr.Code)
+	assert.Body(t, errBody(ErrPasswordChangeRequired), rr.Body.String())
+}
+
 func Test_Logout_InvalidSessionToken(t *testing.T) {
 	s := setupServer(t)
 
@@ -22,6 +22,10 @@ func (s *server) getImage(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
 	img, err := s.repo.Image().GetByMediaId(id)
 	if err != nil {
 		s.logger.Errorf("Error getting image by id: %v", err.Error())
@@ -1,11 +1,14 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	jobService "github.com/slugger7/exorcist/apps/server/internal/service/job"
 )
 
 // https://medium.com/@abhishekranjandev/building-a-production-grade-websocket-for-notifications-with-golang-and-gin-a-detailed-guide-5b676dcfbd5a
@@ -25,6 +28,36 @@ func (s *server) withJobGetAll(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withJobCancel(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/:%v/cancel", route, idKey), s.cancelJob)
+	return s
+}
+
+func (s *server) withJobRetry(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/:%v/retry", route, idKey), s.retryJob)
+	return s
+}
+
+func (s *server) withJobTree(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/tree", route, idKey), s.getJobTree)
+	return s
+}
+
+func (s *server) withJobDelete(r *gin.RouterGroup, route Route) *server {
+	r.DELETE(route, s.deleteJobs)
+	return s
+}
+
+func (s *server) withJobSchedules(r *gin.RouterGroup, route Route) *server {
+	schedules := fmt.Sprintf("%v/schedules", route)
+	r.GET(schedules, s.getAllJobSchedules)
+	r.POST(schedules, s.createJobSchedule)
+	r.GET(fmt.Sprintf("%v/:%v", schedules, idKey), s.getJobSchedule)
+	r.PUT(fmt.Sprintf("%v/:%v", schedules, idKey), s.putJobSchedule)
+	r.DELETE(fmt.Sprintf("%v/:%v", schedules, idKey), s.deleteJobSchedule)
+	return s
+}
+
 func (s *server) startJobRunner(c *gin.Context) {
 	s.jobCh <- true
 	c.JSON(http.StatusOK, nil)
@@ -78,3 +111,228 @@ func (s *server) getAllJobs(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.DataToPage(jobDtos, *jobsPage))
 }
+
+const (
+	ErrJobNotFound       ApiError = "could not find job"
+	ErrCancelJob         ApiError = "could not cancel job"
+	ErrJobNotCancellable ApiError = "job has already finished"
+	ErrRetryJob          ApiError = "could not retry job"
+	ErrJobNotRetryable   ApiError = "only failed or cancelled jobs can be retried"
+	ErrDeleteJobs        ApiError = "could not delete jobs"
+	ErrGetJobTree        ApiError = "could not get job tree"
+)
+
+func (s *server) getJobTree(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	jobs, err := s.repo.Job().GetTree(id)
+	if err != nil {
+		s.logger.Errorf("could not get job tree %v: %v", id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetJobTree})
+		return
+	}
+
+	if len(jobs) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrJobNotFound})
+		return
+	}
+
+	c.JSON(http.StatusOK, (&dto.JobTreeDTO{}).FromModels(jobs[0], jobs[1:]))
+}
+
+func (s *server) cancelJob(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	job, err := s.service.Job().Cancel(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobService.ErrJobNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrJobNotFound})
+		case errors.Is(err, jobService.ErrJobNotCancellable):
+			c.JSON(http.StatusConflict, gin.H{"error": ErrJobNotCancellable})
+		default:
+			s.logger.Errorf("could not cancel job %v: %v", id.String(), err.Error())
+			c.JSON(http.StatusInternalServerError, gin.H{"error": ErrCancelJob})
+		}
+		return
+	}
+
+	s.wsService.JobUpdate(*job)
+
+	c.JSON(http.StatusOK, (&dto.JobDTO{}).FromModel(*job))
+}
+
+func (s *server) retryJob(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	job, err := s.service.Job().Retry(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobService.ErrJobNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrJobNotFound})
+		case errors.Is(err, jobService.ErrJobNotRetryable):
+			c.JSON(http.StatusConflict, gin.H{"error": ErrJobNotRetryable})
+		default:
+			s.logger.Errorf("could not retry job %v: %v", id.String(), err.Error())
+			c.JSON(http.StatusInternalServerError, gin.H{"error": ErrRetryJob})
+		}
+		return
+	}
+
+	s.wsService.JobUpdate(*job)
+
+	c.JSON(http.StatusOK, (&dto.JobDTO{}).FromModel(*job))
+}
+
+func (s *server) deleteJobs(c *gin.Context) {
+	var jobSearch dto.JobSearchDTO
+	if err := c.ShouldBindQuery(&jobSearch); err != nil {
+		s.logger.Errorf("could not bind query to entity %v", err.Error())
+		c.AbortWithStatus(http.StatusUnprocessableEntity)
+		return
+	}
+
+	jobs, err := s.service.Job().Delete(jobSearch)
+	if err != nil {
+		s.logger.Errorf("could not delete jobs: %v", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrDeleteJobs})
+		return
+	}
+
+	jobDtos := make([]dto.JobDTO, len(jobs))
+	for i, j := range jobs {
+		s.wsService.JobDelete(j)
+		jobDtos[i] = *(&dto.JobDTO{}).FromModel(j)
+	}
+
+	c.JSON(http.StatusOK, jobDtos)
+}
+
+const (
+	ErrJobScheduleNotFound ApiError = "could not find job schedule"
+	ErrGetJobSchedules     ApiError = "could not get job schedules"
+	ErrCreateJobSchedule   ApiError = "could not create job schedule"
+	ErrUpdateJobSchedule   ApiError = "could not update job schedule"
+	ErrDeleteJobSchedule   ApiError = "could not delete job schedule"
+)
+
+func (s *server) getAllJobSchedules(c *gin.Context) {
+	schedules, err := s.repo.JobSchedule().GetAll()
+	if err != nil {
+		s.logger.Errorf("could not get job schedules: %v", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetJobSchedules})
+		return
+	}
+
+	scheduleDtos := make([]dto.JobScheduleDTO, len(schedules))
+	for i, m := range schedules {
+		scheduleDtos[i] = *(&dto.JobScheduleDTO{}).FromModel(m)
+	}
+
+	c.JSON(http.StatusOK, scheduleDtos)
+}
+
+func (s *server) getJobSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	schedule, err := s.repo.JobSchedule().GetById(id)
+	if err != nil {
+		s.logger.Errorf("could not get job schedule %v: %v", id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetJobSchedules})
+		return
+	}
+
+	if schedule == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrJobScheduleNotFound})
+		return
+	}
+
+	c.JSON(http.StatusOK, (&dto.JobScheduleDTO{}).FromModel(*schedule))
+}
+
+func (s *server) createJobSchedule(c *gin.Context) {
+	var cm dto.CreateJobScheduleDTO
+	if err := c.ShouldBindBodyWithJSON(&cm); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := s.service.Job().CreateSchedule(cm)
+	if err != nil {
+		if errors.Is(err, jobService.ErrInvalidJobSchedule) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		s.logger.Errorf("could not create job schedule: %v", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrCreateJobSchedule})
+		return
+	}
+
+	c.JSON(http.StatusCreated, (&dto.JobScheduleDTO{}).FromModel(*schedule))
+}
+
+func (s *server) putJobSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var cm dto.CreateJobScheduleDTO
+	if err := c.ShouldBindBodyWithJSON(&cm); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := s.service.Job().UpdateSchedule(id, cm)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobService.ErrJobScheduleNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrJobScheduleNotFound})
+		case errors.Is(err, jobService.ErrInvalidJobSchedule):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			s.logger.Errorf("could not update job schedule %v: %v", id.String(), err.Error())
+			c.JSON(http.StatusInternalServerError, gin.H{"error": ErrUpdateJobSchedule})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, (&dto.JobScheduleDTO{}).FromModel(*schedule))
+}
+
+func (s *server) deleteJobSchedule(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if err := s.service.Job().DeleteSchedule(id); err != nil {
+		if errors.Is(err, jobService.ErrJobScheduleNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrJobScheduleNotFound})
+			return
+		}
+		s.logger.Errorf("could not delete job schedule %v: %v", id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrDeleteJobSchedule})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	libraryService "github.com/slugger7/exorcist/apps/server/internal/service/library"
 )
 
 func (s *server) withLibraryPost(r *gin.RouterGroup, route Route) *server {
@@ -35,6 +37,13 @@ func (s *server) withLibraryPut(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withLibraryUsers(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/users", route, idKey), s.getLibraryUsers)
+	r.PUT(fmt.Sprintf("%v/:%v/users/:%v", route, idKey, userIdKey), s.grantLibraryAccess)
+	r.DELETE(fmt.Sprintf("%v/:%v/users/:%v", route, idKey, userIdKey), s.revokeLibraryAccess)
+	return s
+}
+
 const (
 	ErrLibraryPathsForLibrary ApiError = "could not get library paths for library %v"
 	ErrIdParse                ApiError = "could not parse id: %v"
@@ -116,7 +125,13 @@ func (s *server) LibraryGetPaths(c *gin.Context) {
 		return
 	}
 
-	libraryPaths, err := s.repo.LibraryPath().GetByLibraryId(id)
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	libraryPaths, err := s.repo.LibraryPath().GetByLibraryIdForUser(id, *userId)
 	if err != nil {
 		s.logger.Errorf(ErrLibraryPathsForLibrary, id)
 		c.JSON(http.StatusInternalServerError, createError("could not get library paths for library"))
@@ -159,7 +174,13 @@ func (s *server) CreateLibrary(c *gin.Context) {
 const ErrGetLibraries ApiError = "could not fetch libraries"
 
 func (s *server) GetLibraries(c *gin.Context) {
-	libs, err := s.service.Library().GetAll()
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	libs, err := s.service.Library().GetAll(*userId)
 	if err != nil {
 		s.logger.Errorf("could not get libraries: %v", err)
 		c.JSON(http.StatusInternalServerError, createError(ErrGetLibraries))
@@ -173,3 +194,94 @@ func (s *server) GetLibraries(c *gin.Context) {
 
 	c.JSON(http.StatusOK, ms)
 }
+
+const (
+	ErrLibraryNotFound     ApiError = "library not found"
+	ErrUserNotFound        ApiError = "user not found"
+	ErrGetLibraryUsers     ApiError = "could not get users of library"
+	ErrGrantLibraryAccess  ApiError = "could not grant access to library"
+	ErrRevokeLibraryAccess ApiError = "could not revoke access to library"
+)
+
+func (s *server) getLibraryUsers(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	users, err := s.service.Library().GetUsers(id)
+	if err != nil {
+		if errors.Is(err, libraryService.ErrLibraryNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrLibraryNotFound})
+			return
+		}
+		s.logger.Errorf("could not get users of library %v: %v", id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetLibraryUsers})
+		return
+	}
+
+	dtos := make([]dto.LibraryUserDTO, len(users))
+	for i, u := range users {
+		dtos[i] = *(&dto.LibraryUserDTO{}).FromModel(u)
+	}
+
+	c.JSON(http.StatusOK, dtos)
+}
+
+func (s *server) grantLibraryAccess(c *gin.Context) {
+	id, userId, ok := s.parseLibraryUserParams(c)
+	if !ok {
+		return
+	}
+
+	if err := s.service.Library().GrantAccess(id, userId); err != nil {
+		switch {
+		case errors.Is(err, libraryService.ErrLibraryNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrLibraryNotFound})
+		case errors.Is(err, libraryService.ErrUserNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrUserNotFound})
+		default:
+			s.logger.Errorf("could not grant user %v access to library %v: %v", userId.String(), id.String(), err.Error())
+			c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGrantLibraryAccess})
+		}
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) revokeLibraryAccess(c *gin.Context) {
+	id, userId, ok := s.parseLibraryUserParams(c)
+	if !ok {
+		return
+	}
+
+	if err := s.service.Library().RevokeAccess(id, userId); err != nil {
+		if errors.Is(err, libraryService.ErrLibraryNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrLibraryNotFound})
+			return
+		}
+		s.logger.Errorf("could not revoke access of user %v to library %v: %v", userId.String(), id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrRevokeLibraryAccess})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) parseLibraryUserParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userId, err := uuid.Parse(c.Param(userIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return id, userId, true
+}
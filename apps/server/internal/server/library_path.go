@@ -24,6 +24,8 @@ func (s *server) withLibraryPathGet(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+const ErrLibraryPathNotFound ApiError = "library path not found"
+
 func (s *server) GetLibraryPath(c *gin.Context) {
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -33,13 +35,24 @@ func (s *server) GetLibraryPath(c *gin.Context) {
 		return
 	}
 
-	libraryPath, err := s.repo.LibraryPath().GetById(id)
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	libraryPath, err := s.repo.LibraryPath().GetByIdForUser(id, *userId)
 	if err != nil {
 		s.logger.Errorf("error fetching library path by id: %v", id)
 		c.JSON(http.StatusInternalServerError, createError("could not get libray path by id"))
 		return
 	}
 
+	if libraryPath == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrLibraryPathNotFound})
+		return
+	}
+
 	libPathDto := *(&dto.LibraryPathDTO{}).FromModel(*libraryPath)
 
 	c.JSON(http.StatusOK, libPathDto)
@@ -73,7 +86,13 @@ func (s *server) CreateLibraryPath(c *gin.Context) {
 const ErrGetAllLibraryPathsService = "could not get all library paths"
 
 func (s *server) GetAllLibraryPaths(c *gin.Context) {
-	libraryPaths, err := s.service.LibraryPath().GetAll()
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	libraryPaths, err := s.service.LibraryPath().GetAll(*userId)
 	if err != nil {
 		s.logger.Errorf("Error getting all libraries\n%v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetAllLibraryPathsService})
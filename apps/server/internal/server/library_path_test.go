@@ -91,16 +91,20 @@ func Test_CreateLibraryPath_Success(t *testing.T) {
 
 func Test_GetAllLibraryPaths_WithServiceThrowingError(t *testing.T) {
 	s := setupServer(t).
-		withLibraryPathService()
+		withLibraryPathService().
+		withAuth()
+
+	userId, _ := uuid.NewRandom()
 
 	s.mockLibraryPathService.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.LibraryPath, error) {
+		GetAll(gomock.Eq(userId)).
+		DoAndReturn(func(uuid.UUID) ([]model.LibraryPath, error) {
 			return nil, fmt.Errorf("some error")
 		})
 
-	s.server.withLibraryPathGetAll(&s.engine.RouterGroup, "/")
-	rr := s.withGetRequest("").
+	s.server.withLibraryPathGetAll(s.authGroup, "/")
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: userId}).
 		exec()
 	assert.StatusCode(t, http.StatusInternalServerError, rr.Code)
 	assert.Body(t, errBody(ErrGetAllLibraryPathsService), rr.Body.String())
@@ -108,7 +112,10 @@ func Test_GetAllLibraryPaths_WithServiceThrowingError(t *testing.T) {
 
 func Test_GetAllLibraryPaths_Success(t *testing.T) {
 	s := setupServer(t).
-		withLibraryPathService()
+		withLibraryPathService().
+		withAuth()
+
+	userId, _ := uuid.NewRandom()
 
 	id, _ := uuid.NewRandom()
 	libId, _ := uuid.NewRandom()
@@ -119,13 +126,14 @@ func Test_GetAllLibraryPaths_Success(t *testing.T) {
 	}
 
 	s.mockLibraryPathService.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.LibraryPath, error) {
+		GetAll(gomock.Eq(userId)).
+		DoAndReturn(func(uuid.UUID) ([]model.LibraryPath, error) {
 			return []model.LibraryPath{libPath}, nil
 		})
 
-	s.server.withLibraryPathGetAll(&s.engine.RouterGroup, "/")
-	rr := s.withGetRequest("").
+	s.server.withLibraryPathGetAll(s.authGroup, "/")
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: userId}).
 		exec()
 
 	body, _ := json.Marshal([]dto.LibraryPathDTO{{Id: libPath.ID, LibraryId: libPath.LibraryID, Path: libPath.Path}})
@@ -134,3 +142,71 @@ func Test_GetAllLibraryPaths_Success(t *testing.T) {
 	assert.Body(t, string(body), rr.Body.String())
 
 }
+
+func Test_GetLibraryPath_WithoutAccess_ShouldReturnNotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo()
+
+	id, _ := uuid.NewRandom()
+	userId, _ := uuid.NewRandom()
+
+	s.mockLibraryPathRepo.EXPECT().
+		GetByIdForUser(gomock.Eq(id), gomock.Eq(userId)).
+		Return(nil, nil).
+		Times(1)
+
+	s.server.withLibraryPathGet(s.authGroup, "/libraryPaths")
+	rr := s.withAuthGetRequest(fmt.Sprintf("libraryPaths/%v", id)).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusNotFound, rr.Code)
+	assert.Body(t, errBody(ErrLibraryPathNotFound), rr.Body.String())
+}
+
+func Test_GetLibraryPath_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo()
+
+	userId, _ := uuid.NewRandom()
+	libPath := model.LibraryPath{ID: uuid.New(), LibraryID: uuid.New(), Path: "some path"}
+
+	s.mockLibraryPathRepo.EXPECT().
+		GetByIdForUser(gomock.Eq(libPath.ID), gomock.Eq(userId)).
+		Return(&libPath, nil).
+		Times(1)
+
+	s.server.withLibraryPathGet(s.authGroup, "/libraryPaths")
+	rr := s.withAuthGetRequest(fmt.Sprintf("libraryPaths/%v", libPath.ID)).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	body, _ := json.Marshal(dto.LibraryPathDTO{Id: libPath.ID, LibraryId: libPath.LibraryID, Path: libPath.Path})
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, string(body), rr.Body.String())
+}
+
+func Test_LibraryGetPaths_ShouldOnlyReturnAccessiblePaths(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo()
+
+	libId, _ := uuid.NewRandom()
+	userId, _ := uuid.NewRandom()
+
+	s.mockLibraryPathRepo.EXPECT().
+		GetByLibraryIdForUser(gomock.Eq(libId), gomock.Eq(userId)).
+		Return([]model.LibraryPath{}, nil).
+		Times(1)
+
+	s.server.withLibraryGetPaths(s.authGroup, "/libraries")
+	rr := s.withAuthGetRequest(fmt.Sprintf("libraries/%v/libraryPaths", libId)).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, "[]", rr.Body.String())
+}
@@ -80,16 +80,20 @@ func Test_CreateLibrary_Success(t *testing.T) {
 
 func Test_GetLibraries_ServiceReturnsError(t *testing.T) {
 	s := setupServer(t).
-		withLibraryService()
+		withLibraryService().
+		withAuth()
+
+	userId, _ := uuid.NewRandom()
 
 	s.mockLibraryService.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.Library, error) {
+		GetAll(gomock.Eq(userId)).
+		DoAndReturn(func(uuid.UUID) ([]model.Library, error) {
 			return nil, fmt.Errorf("some error")
 		})
 
-	s.server.withLibraryGet(&s.engine.RouterGroup, "/")
-	rr := s.withGetRequest("").
+	s.server.withLibraryGet(s.authGroup, "/")
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: userId}).
 		exec()
 
 	assert.StatusCode(t, http.StatusInternalServerError, rr.Code)
@@ -98,20 +102,24 @@ func Test_GetLibraries_ServiceReturnsError(t *testing.T) {
 
 func Test_GetLibraries_Succeeds(t *testing.T) {
 	s := setupServer(t).
-		withLibraryService()
+		withLibraryService().
+		withAuth()
+
+	userId, _ := uuid.NewRandom()
 
 	lib := model.Library{Name: "lib"}
 	libs := []model.Library{lib}
 
 	s.mockLibraryService.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.Library, error) {
+		GetAll(gomock.Eq(userId)).
+		DoAndReturn(func(uuid.UUID) ([]model.Library, error) {
 			return libs, nil
 		}).
 		Times(1)
 
-	s.server.withLibraryGet(&s.engine.RouterGroup, "/")
-	rr := s.withGetRequest("").
+	s.server.withLibraryGet(s.authGroup, "/")
+	rr := s.withAuthGetRequest("").
+		withCookie(TestCookie{Value: userId}).
 		exec()
 
 	bm := []dto.LibraryDTO{{Name: lib.Name}}
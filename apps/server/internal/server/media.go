@@ -1,6 +1,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
+	mediaService "github.com/slugger7/exorcist/apps/server/internal/service/media"
 )
 
 func (s *server) withMediaSearch(r *gin.RouterGroup, route Route) *server {
@@ -70,6 +72,100 @@ func (s *server) withMediaRelateDelete(r *gin.RouterGroup, route Route) *server
 	return s
 }
 
+func (s *server) withMediaRating(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/:%v/rating", route, idKey), s.putMediaRating)
+	r.DELETE(fmt.Sprintf("%v/:%v/rating", route, idKey), s.deleteMediaRating)
+	return s
+}
+
+func (s *server) withMediaDuplicates(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/duplicates", route), s.getMediaDuplicates)
+	r.POST(fmt.Sprintf("%v/duplicates/merge", route), s.mergeMediaDuplicates)
+	return s
+}
+
+func (s *server) getMediaDuplicates(c *gin.Context) {
+	var search dto.DuplicateSearchDTO
+	if err := c.ShouldBindQuery(&search); err != nil {
+		c.AbortWithError(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if len(search.Kinds) == 0 {
+		search.Kinds = dto.DuplicateKindAllValues
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	threshold := dto.DefaultPerceptualHashThreshold
+	if search.Threshold != nil {
+		threshold = *search.Threshold
+	}
+
+	groupDtos := []dto.DuplicateGroupDTO{}
+	for _, kind := range search.Kinds {
+		var groups [][]models.DuplicateMedia
+		var err error
+		switch kind {
+		case dto.DuplicateKind_Checksum:
+			groups, err = s.service.Media().DuplicatesByChecksum(*userId)
+		case dto.DuplicateKind_PerceptualHash:
+			groups, err = s.service.Media().DuplicatesByPerceptualHash(*userId, threshold)
+		default:
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown duplicate kind: %v", kind)})
+			return
+		}
+		if err != nil {
+			s.logger.Errorf("error getting %v duplicates: %v", kind, err.Error())
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		for _, g := range groups {
+			groupDtos = append(groupDtos, *(&dto.DuplicateGroupDTO{}).FromModels(kind, g))
+		}
+	}
+
+	c.JSON(http.StatusOK, groupDtos)
+}
+
+func (s *server) mergeMediaDuplicates(c *gin.Context) {
+	var mergeDto dto.MergeDuplicatesDTO
+	if err := c.ShouldBindBodyWithJSON(&mergeDto); err != nil {
+		c.AbortWithError(http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	for _, id := range append([]uuid.UUID{mergeDto.KeepId}, mergeDto.MergeIds...) {
+		if !s.canAccessMedia(c, id) {
+			return
+		}
+	}
+
+	if err := s.service.Media().Merge(mergeDto.KeepId, mergeDto.MergeIds); err != nil {
+		switch {
+		case errors.Is(err, mediaService.ErrMergeMediaNotFound):
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": mediaService.ErrMergeMediaNotFound.Error()})
+		case errors.Is(err, mediaService.ErrMergeIntoItself), errors.Is(err, mediaService.ErrMergeIntoDeleted):
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			s.logger.Errorf("error merging media into %v: %v", mergeDto.KeepId.String(), err.Error())
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	for _, id := range mergeDto.MergeIds {
+		s.wsService.MediaDelete(dto.MediaOverviewDTO{Id: id, Deleted: true})
+	}
+
+	c.Status(http.StatusOK)
+}
+
 func (s *server) deleteMediaRelate(c *gin.Context) {
 	id, err := uuid.Parse(c.Param(idKey))
 	if err != nil {
@@ -98,6 +194,10 @@ func (s *server) getMediaThumbnail(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
 	thumb, err := s.repo.Media().GetThumbnailFor(id)
 	if err != nil {
 		s.logger.Errorf("could not get thumbnail media for: %v\n%v", id, err.Error())
@@ -164,6 +264,20 @@ func (s *server) deleteMedia(c *gin.Context) {
 		query.Physical = &b
 	}
 
+	if *query.Physical {
+		allowed, err := s.hasPermission(c, dto.Permission_DeleteMediaPhysically)
+		if err != nil {
+			s.logger.Errorf("could not determine permissions of current user: %v", err.Error())
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized})
+			return
+		}
+
+		if !allowed {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": ErrForbidden})
+			return
+		}
+	}
+
 	err = s.service.Media().Delete(id, *query.Physical)
 	if err != nil {
 		s.logger.Errorf("error deleting video (%v): %v", id, err.Error())
@@ -282,6 +396,10 @@ func (s *server) getMediaById(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
 	m, err := s.repo.Media().GetByIdAndUserId(id, *userId)
 	if err != nil {
 		s.logger.Errorf("could not get media by id: %v", err.Error())
@@ -356,3 +474,67 @@ func (s *server) putMediaRelate(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, relationDtos)
 }
+
+const (
+	ErrRateMedia   ApiError = "could not rate media"
+	ErrUnrateMedia ApiError = "could not remove rating of media"
+)
+
+func (s *server) putMediaRating(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var body dto.RatingDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	rating, err := s.service.Media().Rate(id, *userId, body.Rating)
+	if err != nil {
+		s.logger.Errorf("could not rate media %v for user %v: %v", id.String(), userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrRateMedia})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RatingDTO{Rating: rating.Rating})
+}
+
+func (s *server) deleteMediaRating(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Media().Unrate(id, *userId); err != nil {
+		s.logger.Errorf("could not remove rating of media %v for user %v: %v", id.String(), userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrUnrateMedia})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
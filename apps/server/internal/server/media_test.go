@@ -0,0 +1,32 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+)
+
+func Test_MergeMediaDuplicates_WithInaccessibleMedia(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo()
+
+	s.server.withMediaDuplicates(s.authGroup, "/media")
+
+	keepId, mergeId, userId := uuid.New(), uuid.New(), uuid.New()
+	s.mockMediaRepo.EXPECT().HasAccess(keepId, userId).Return(true, nil).Times(1)
+	s.mockMediaRepo.EXPECT().HasAccess(mergeId, userId).Return(false, nil).Times(1)
+
+	rr := s.withAuthPostRequest(bodyM(dto.MergeDuplicatesDTO{KeepId: keepId, MergeIds: []uuid.UUID{mergeId}}), "media/duplicates/merge").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %v but got %v", http.StatusNotFound, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrMediaNotFound) {
+		t.Errorf("expected body %v but got %v", errBody(ErrMediaNotFound), body)
+	}
+}
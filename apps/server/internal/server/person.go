@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	personService "github.com/slugger7/exorcist/apps/server/internal/service/person"
 )
 
 func (s *server) withPersonGetAll(r *gin.RouterGroup, route Route) *server {
@@ -150,7 +151,13 @@ func (s *server) getAllPeople(c *gin.Context) {
 		return
 	}
 
-	people, err := s.repo.Person().GetAll(search)
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	people, err := s.repo.Person().GetAll(*userId, search)
 	if err != nil {
 		s.logger.Errorf("could not fetch people from repo: %v", err.Error())
 		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "could not fetch people"})
@@ -159,8 +166,259 @@ func (s *server) getAllPeople(c *gin.Context) {
 
 	peopleDtos := make([]dto.PersonDTO, len(people))
 	for i, p := range people {
-		peopleDtos[i] = *(&dto.PersonDTO{}).FromModel(&p)
+		peopleDtos[i] = *(&dto.PersonDTO{}).FromUserModel(p)
 	}
 
 	c.JSON(http.StatusOK, peopleDtos)
 }
+func (s *server) withPersonGetAliases(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/aliases", route, personIdKey), s.getPersonAliases)
+	return s
+}
+
+func (s *server) withPersonCreateAlias(r *gin.RouterGroup, route Route) *server {
+	r.POST(fmt.Sprintf("%v/:%v/aliases", route, idKey), s.createPersonAlias)
+	return s
+}
+
+func (s *server) withPersonDeleteAlias(r *gin.RouterGroup, route Route) *server {
+	r.DELETE(fmt.Sprintf("%v/:%v/aliases/:%v", route, idKey, aliasIdKey), s.deletePersonAlias)
+	return s
+}
+
+func (s *server) withPersonMerge(r *gin.RouterGroup, route Route) *server {
+	r.POST(fmt.Sprintf("%v/:%v/merge", route, idKey), s.mergePerson)
+	return s
+}
+
+func (s *server) withPersonRating(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/:%v/rating", route, idKey), s.putPersonRating)
+	r.DELETE(fmt.Sprintf("%v/:%v/rating", route, idKey), s.deletePersonRating)
+	return s
+}
+
+func (s *server) withPersonFavourite(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/:%v/favourite", route, idKey), s.putPersonFavourite)
+	r.DELETE(fmt.Sprintf("%v/:%v/favourite", route, idKey), s.deletePersonFavourite)
+	return s
+}
+
+const (
+	ErrPersonNotFound        ApiError = "person not found"
+	ErrGetPersonAliases      ApiError = "could not get person aliases"
+	ErrCreatePersonAlias     ApiError = "could not create person alias"
+	ErrDeletePersonAlias     ApiError = "could not delete person alias"
+	ErrMergePerson           ApiError = "could not merge people"
+	ErrPersonMergeIntoItself ApiError = "person cannot be merged into itself"
+	ErrRatePerson            ApiError = "could not rate person"
+	ErrUnratePerson          ApiError = "could not remove rating of person"
+	ErrFavouritePerson       ApiError = "could not add person to favourites"
+	ErrUnfavouritePerson     ApiError = "could not remove person from favourites"
+)
+
+func (s *server) getPersonAliases(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(personIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	aliases, err := s.service.Person().GetAliases(id)
+	if err != nil {
+		s.personServiceError(c, err, ErrGetPersonAliases)
+		return
+	}
+
+	aliasDtos := make([]dto.AliasDTO, len(aliases))
+	for i, a := range aliases {
+		aliasDtos[i] = *(&dto.AliasDTO{}).FromPersonAlias(a)
+	}
+
+	c.JSON(http.StatusOK, aliasDtos)
+}
+
+func (s *server) createPersonAlias(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var body dto.CreateAliasDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	alias, err := s.service.Person().AddAlias(id, body.Alias)
+	if err != nil {
+		s.personServiceError(c, err, ErrCreatePersonAlias)
+		return
+	}
+
+	c.JSON(http.StatusCreated, (&dto.AliasDTO{}).FromPersonAlias(*alias))
+}
+
+func (s *server) deletePersonAlias(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	aliasId, err := uuid.Parse(c.Param(aliasIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if err := s.service.Person().RemoveAlias(id, aliasId); err != nil {
+		s.personServiceError(c, err, ErrDeletePersonAlias)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) mergePerson(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var mergeDto dto.MergeDTO
+	if err := c.ShouldBindBodyWithJSON(&mergeDto); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	mediaIds, err := s.service.Person().Merge(id, mergeDto.MergeIds)
+	if err != nil {
+		s.personServiceError(c, err, ErrMergePerson)
+		return
+	}
+
+	for _, mediaId := range mediaIds {
+		m, err := s.repo.Media().GetById(mediaId)
+		if err != nil {
+			s.logger.Errorf("could not get media %v to send people update: %v", mediaId.String(), err.Error())
+			continue
+		}
+
+		mediaDto := new(dto.MediaDTO).FromModel(*m)
+		s.wsService.MediaUpdate(dto.MediaDTO{ID: mediaId, People: mediaDto.People})
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) putPersonRating(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var body dto.RatingDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	rating, err := s.service.Person().Rate(id, *userId, body.Rating)
+	if err != nil {
+		s.personServiceError(c, err, ErrRatePerson)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RatingDTO{Rating: rating.Rating})
+}
+
+func (s *server) deletePersonRating(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Person().Unrate(id, *userId); err != nil {
+		s.personServiceError(c, err, ErrUnratePerson)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) putPersonFavourite(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Person().AddFavourite(id, *userId); err != nil {
+		s.personServiceError(c, err, ErrFavouritePerson)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) deletePersonFavourite(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Person().RemoveFavourite(id, *userId); err != nil {
+		s.personServiceError(c, err, ErrUnfavouritePerson)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// personServiceError responds with the status that matches the error of the person service
+func (s *server) personServiceError(c *gin.Context, err error, fallback ApiError) {
+	switch {
+	case errors.Is(err, personService.ErrPersonNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrPersonNotFound})
+	case errors.Is(err, personService.ErrAliasNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrAliasNotFound})
+	case errors.Is(err, personService.ErrAliasTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": ErrAliasTaken})
+	case errors.Is(err, personService.ErrAliasEmpty):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrAliasEmpty})
+	case errors.Is(err, personService.ErrMergeIntoItself):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrPersonMergeIntoItself})
+	default:
+		s.logger.Errorf("%v: %v", fallback, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	personService "github.com/slugger7/exorcist/apps/server/internal/service/person"
+)
+
+// Person media is routed by personIdKey so the other person routes that share its methods have to use the same wildcard
+func Test_PersonRoutes_DoNotConflict(t *testing.T) {
+	s := setupServer(t).
+		withAuth()
+
+	s.server.withPersonGetAll(s.authGroup, "/people").
+		withPersonCreate(s.authGroup, "/people").
+		withPersonGetMedia(s.authGroup, "/people").
+		withPersonPut(s.authGroup, "/people").
+		withPersonDelete(s.authGroup, "/people").
+		withPersonGetAliases(s.authGroup, "/people").
+		withPersonCreateAlias(s.authGroup, "/people").
+		withPersonDeleteAlias(s.authGroup, "/people").
+		withPersonMerge(s.authGroup, "/people").
+		withPersonRating(s.authGroup, "/people").
+		withPersonFavourite(s.authGroup, "/people")
+}
+
+func Test_PutPersonRating_OutOfRange(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPersonService()
+
+	s.server.withPersonRating(s.authGroup, "/people")
+
+	rr := s.withAuthPutRequest(bodyM(dto.RatingDTO{Rating: 6}), "people/"+uuid.NewString()+"/rating").
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected status %v but got %v", http.StatusUnprocessableEntity, rr.Code)
+	}
+}
+
+func Test_PutPersonRating_PersonNotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPersonService()
+
+	s.server.withPersonRating(s.authGroup, "/people")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockPersonService.EXPECT().
+		Rate(id, userId, int16(4)).
+		Return(nil, personService.ErrPersonNotFound).
+		Times(1)
+
+	rr := s.withAuthPutRequest(bodyM(dto.RatingDTO{Rating: 4}), "people/"+id.String()+"/rating").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %v but got %v", http.StatusNotFound, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrPersonNotFound) {
+		t.Errorf("expected body %v but got %v", errBody(ErrPersonNotFound), body)
+	}
+}
+
+func Test_PutPersonRating_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPersonService()
+
+	s.server.withPersonRating(s.authGroup, "/people")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockPersonService.EXPECT().
+		Rate(id, userId, int16(4)).
+		Return(&model.PersonRating{PersonID: id, UserID: userId, Rating: 4}, nil).
+		Times(1)
+
+	rr := s.withAuthPutRequest(bodyM(dto.RatingDTO{Rating: 4}), "people/"+id.String()+"/rating").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected status %v but got %v", http.StatusOK, rr.Code)
+	}
+	if body := rr.Body.String(); body != `{"rating":4}` {
+		t.Errorf("expected rating in body but got %v", body)
+	}
+}
+
+func Test_PutPersonFavourite_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPersonService()
+
+	s.server.withPersonFavourite(s.authGroup, "/people")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockPersonService.EXPECT().
+		AddFavourite(id, userId).
+		Return(nil).
+		Times(1)
+
+	rr := s.withAuthPutRequest(nil, "people/"+id.String()+"/favourite").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected status %v but got %v", http.StatusOK, rr.Code)
+	}
+}
+
+func Test_CreatePersonAlias_BlankAlias(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPersonService()
+
+	s.server.withPersonCreateAlias(s.authGroup, "/people")
+
+	id := uuid.New()
+	s.mockPersonService.EXPECT().
+		AddAlias(id, "   ").
+		Return(nil, personService.ErrAliasEmpty).
+		Times(1)
+
+	rr := s.withAuthPostRequest(bodyM(dto.CreateAliasDTO{Alias: "   "}), "people/"+id.String()+"/aliases").
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status %v but got %v", http.StatusBadRequest, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrAliasEmpty) {
+		t.Errorf("expected body %v but got %v", errBody(ErrAliasEmpty), body)
+	}
+}
@@ -1,13 +1,14 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	playlistService "github.com/slugger7/exorcist/apps/server/internal/service/playlist"
 )
 
 func (s *server) withPlaylistsGetAll(r *gin.RouterGroup, route Route) *server {
@@ -15,6 +16,11 @@ func (s *server) withPlaylistsGetAll(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withPlaylistsShared(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/shared", route), s.getSharedPlaylists)
+	return s
+}
+
 func (s *server) withPlaylistsCreate(r *gin.RouterGroup, route Route) *server {
 	r.POST(route, s.createPlaylists)
 	return s
@@ -30,6 +36,16 @@ func (s *server) withPlaylistMediaAdd(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withPlaylistMediaRemove(r *gin.RouterGroup, route Route) *server {
+	r.DELETE(fmt.Sprintf("%v/:%v/media/:%v", route, idKey, mediaIdKey), s.deletePlaylistMedia)
+	return s
+}
+
+func (s *server) withPlaylistMediaMove(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/:%v/media/:%v/position", route, idKey, mediaIdKey), s.movePlaylistMedia)
+	return s
+}
+
 func (s *server) withPlaylistPut(r *gin.RouterGroup, route Route) *server {
 	r.PUT(fmt.Sprintf("%v/:%v", route, idKey), s.putPlaylist)
 	return s
@@ -40,6 +56,13 @@ func (s *server) withPlaylistDelete(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withPlaylistUsers(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/users", route, idKey), s.getPlaylistUsers)
+	r.PUT(fmt.Sprintf("%v/:%v/users/:%v", route, idKey, userIdKey), s.grantPlaylistAccess)
+	r.DELETE(fmt.Sprintf("%v/:%v/users/:%v", route, idKey, userIdKey), s.revokePlaylistAccess)
+	return s
+}
+
 func (s *server) deletePlaylist(c *gin.Context) {
 	id, err := uuid.Parse((c.Param(idKey)))
 	if err != nil {
@@ -47,9 +70,14 @@ func (s *server) deletePlaylist(c *gin.Context) {
 		return
 	}
 
-	if err := s.service.Playlist().Delete(id); err != nil {
-		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "error deleting playlist"})
-		s.logger.Errorf("Error deleting playlist by id %v: %v", id.String(), err.Error())
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Playlist().Delete(id, *userId); err != nil {
+		s.playlistServiceError(c, err, ErrDeletePlaylist)
 		return
 	}
 
@@ -69,15 +97,15 @@ func (s *server) putPlaylist(c *gin.Context) {
 		return
 	}
 
-	updateModel := model.Playlist{
-		ID:   id,
-		Name: updateDto.Name,
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
 	}
 
-	updatedModel, err := s.repo.Playlist().Update(updateModel)
+	updatedModel, err := s.service.Playlist().Update(id, *userId, updateDto)
 	if err != nil {
-		s.logger.Errorf("error updating playlist %v: %v", id.String(), err.Error())
-		c.AbortWithStatus(http.StatusInternalServerError)
+		s.playlistServiceError(c, err, ErrUpdatePlaylist)
 		return
 	}
 
@@ -86,6 +114,105 @@ func (s *server) putPlaylist(c *gin.Context) {
 	c.JSON(http.StatusOK, updatedDto)
 }
 
+const (
+	ErrPlaylistNotFound     ApiError = "playlist not found"
+	ErrMediaNotInPlaylist   ApiError = "media is not in the playlist"
+	ErrRemovePlaylistMedia  ApiError = "could not remove media from playlist"
+	ErrMovePlaylistMedia    ApiError = "could not move media in playlist"
+	ErrAddPlaylistMedia     ApiError = "could not add media to playlist"
+	ErrGetPlaylistMedia     ApiError = "could not get media of playlist"
+	ErrSmartPlaylist        ApiError = "the media of a smart playlist comes from its search"
+	ErrDeletePlaylist       ApiError = "could not delete playlist"
+	ErrUpdatePlaylist       ApiError = "could not update playlist"
+	ErrGetPlaylists         ApiError = "could not get playlists"
+	ErrGetPlaylistUsers     ApiError = "could not get users of playlist"
+	ErrGrantPlaylistAccess  ApiError = "could not grant access to playlist"
+	ErrRevokePlaylistAccess ApiError = "could not revoke access to playlist"
+	ErrPlaylistAccessDenied ApiError = "you do not have access to do that to the playlist"
+	ErrShareWithOwner       ApiError = "a playlist can not be shared with its owner"
+)
+
+func (s *server) deletePlaylistMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	mediaId, err := uuid.Parse(c.Param(mediaIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Playlist().RemoveMedia(id, *userId, mediaId); err != nil {
+		s.playlistServiceError(c, err, ErrRemovePlaylistMedia)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) movePlaylistMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	mediaId, err := uuid.Parse(c.Param(mediaIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var moveDto dto.MovePlaylistMediaDTO
+	if err := c.ShouldBindBodyWithJSON(&moveDto); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Playlist().MoveMedia(id, *userId, mediaId, *moveDto.Position); err != nil {
+		s.playlistServiceError(c, err, ErrMovePlaylistMedia)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// playlistServiceError responds with the status that matches the error of the playlist service
+func (s *server) playlistServiceError(c *gin.Context, err error, fallback ApiError) {
+	switch {
+	case errors.Is(err, playlistService.ErrPlaylistNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrPlaylistNotFound})
+	case errors.Is(err, playlistService.ErrPlaylistAccessDenied):
+		c.JSON(http.StatusForbidden, gin.H{"error": ErrPlaylistAccessDenied})
+	case errors.Is(err, playlistService.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrUserNotFound})
+	case errors.Is(err, playlistService.ErrShareWithOwner):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrShareWithOwner})
+	case errors.Is(err, playlistService.ErrMediaNotInPlaylist):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrMediaNotInPlaylist})
+	case errors.Is(err, playlistService.ErrSmartPlaylist):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrSmartPlaylist})
+	default:
+		s.logger.Errorf("%v: %v", fallback, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
 func (s *server) putPlaylistMedia(c *gin.Context) {
 	playlistId, err := uuid.Parse(c.Param(idKey))
 	if err != nil {
@@ -99,10 +226,15 @@ func (s *server) putPlaylistMedia(c *gin.Context) {
 		return
 	}
 
-	playlistMedia, err := s.service.Playlist().AddMedia(playlistId, playlistMediaDtos)
+	userId, err := s.getUserId(c)
 	if err != nil {
-		s.logger.Errorf("error adding media to playlist %v: %v", playlistId.String(), err.Error())
-		c.AbortWithStatus(http.StatusInternalServerError)
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	playlistMedia, err := s.service.Playlist().AddMedia(playlistId, *userId, playlistMediaDtos)
+	if err != nil {
+		s.playlistServiceError(c, err, ErrAddPlaylistMedia)
 		return
 	}
 
@@ -132,8 +264,7 @@ func (s *server) getMediaByPlaylist(c *gin.Context) {
 
 	mediaOverviewModels, err := s.service.Playlist().GetMedia(playlistId, *userId, search)
 	if err != nil {
-		s.logger.Errorf("error fetching media for playlist %v: %v", playlistId.String(), err.Error())
-		c.AbortWithStatus(http.StatusInternalServerError)
+		s.playlistServiceError(c, err, ErrGetPlaylistMedia)
 		return
 	}
 
@@ -178,10 +309,16 @@ func (s *server) createPlaylists(c *gin.Context) {
 }
 
 func (s *server) getAllPlayists(c *gin.Context) {
-	playlists, err := s.repo.Playlist().GetAll()
+	userId, err := s.getUserId(c)
 	if err != nil {
-		s.logger.Errorf("could not get all playlists from repo: %v", err.Error())
-		c.AbortWithStatus(http.StatusInternalServerError)
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	playlists, err := s.service.Playlist().GetAll(*userId)
+	if err != nil {
+		s.logger.Errorf("could not get playlists of user %v: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetPlaylists})
 		return
 	}
 
@@ -192,3 +329,114 @@ func (s *server) getAllPlayists(c *gin.Context) {
 
 	c.JSON(http.StatusOK, playlistDtos)
 }
+
+func (s *server) getSharedPlaylists(c *gin.Context) {
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	playlists, err := s.service.Playlist().GetShared(*userId)
+	if err != nil {
+		s.logger.Errorf("could not get playlists shared with user %v: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetPlaylists})
+		return
+	}
+
+	dtos := make([]dto.SharedPlaylistDTO, len(playlists))
+	for i, m := range playlists {
+		dtos[i] = *(&dto.SharedPlaylistDTO{}).FromModel(m)
+	}
+
+	c.JSON(http.StatusOK, dtos)
+}
+
+func (s *server) getPlaylistUsers(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	users, err := s.service.Playlist().GetUsers(id, *userId)
+	if err != nil {
+		s.playlistServiceError(c, err, ErrGetPlaylistUsers)
+		return
+	}
+
+	dtos := make([]dto.PlaylistUserDTO, len(users))
+	for i, u := range users {
+		dtos[i] = *(&dto.PlaylistUserDTO{}).FromModel(u)
+	}
+
+	c.JSON(http.StatusOK, dtos)
+}
+
+func (s *server) grantPlaylistAccess(c *gin.Context) {
+	id, sharedWith, ok := s.parsePlaylistUserParams(c)
+	if !ok {
+		return
+	}
+
+	var grantDto dto.GrantPlaylistAccessDTO
+	if err := c.ShouldBindBodyWithJSON(&grantDto); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Playlist().GrantAccess(id, *userId, sharedWith, grantDto.Access); err != nil {
+		s.playlistServiceError(c, err, ErrGrantPlaylistAccess)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) revokePlaylistAccess(c *gin.Context) {
+	id, sharedWith, ok := s.parsePlaylistUserParams(c)
+	if !ok {
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Playlist().RevokeAccess(id, *userId, sharedWith); err != nil {
+		s.playlistServiceError(c, err, ErrRevokePlaylistAccess)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) parsePlaylistUserParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userId, err := uuid.Parse(c.Param(userIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return id, userId, true
+}
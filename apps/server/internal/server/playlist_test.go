@@ -0,0 +1,202 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	playlistService "github.com/slugger7/exorcist/apps/server/internal/service/playlist"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PlaylistRoutes_DoNotConflict(t *testing.T) {
+	s := setupServer(t).
+		withAuth()
+
+	s.server.withPlaylistsGetAll(s.authGroup, "/playlists").
+		withPlaylistsShared(s.authGroup, "/playlists").
+		withPlaylistsCreate(s.authGroup, "/playlists").
+		withPlaylistsMedia(s.authGroup, "/playlists").
+		withPlaylistMediaAdd(s.authGroup, "/playlists").
+		withPlaylistMediaRemove(s.authGroup, "/playlists").
+		withPlaylistMediaMove(s.authGroup, "/playlists").
+		withPlaylistPut(s.authGroup, "/playlists").
+		withPlaylistDelete(s.authGroup, "/playlists").
+		withPlaylistUsers(s.authGroup, "/playlists")
+}
+
+func Test_DeletePlaylistMedia_NotInPlaylist(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	id, userId, mediaId := uuid.New(), uuid.New(), uuid.New()
+	s.mockPlaylistService.EXPECT().
+		RemoveMedia(gomock.Eq(id), gomock.Eq(userId), gomock.Eq(mediaId)).
+		Return(playlistService.ErrMediaNotInPlaylist).
+		Times(1)
+
+	s.server.withPlaylistMediaRemove(s.authGroup, "/playlists")
+	rr := s.withAuthDeleteRequest(fmt.Sprintf("playlists/%v/media/%v", id.String(), mediaId.String())).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusNotFound, rr.Code)
+	assert.Body(t, errBody(ErrMediaNotInPlaylist), rr.Body.String())
+}
+
+func Test_MovePlaylistMedia_MissingPosition(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	s.server.withPlaylistMediaMove(s.authGroup, "/playlists")
+	rr := s.withAuthPutRequest(body(`{}`), fmt.Sprintf("playlists/%v/media/%v/position", uuid.New().String(), uuid.New().String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func Test_MovePlaylistMedia_PlaylistNotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	id, userId, mediaId := uuid.New(), uuid.New(), uuid.New()
+	s.mockPlaylistService.EXPECT().
+		MoveMedia(gomock.Eq(id), gomock.Eq(userId), gomock.Eq(mediaId), gomock.Eq(0)).
+		Return(playlistService.ErrPlaylistNotFound).
+		Times(1)
+
+	s.server.withPlaylistMediaMove(s.authGroup, "/playlists")
+	rr := s.withAuthPutRequest(body(`{"position":0}`), fmt.Sprintf("playlists/%v/media/%v/position", id.String(), mediaId.String())).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusNotFound, rr.Code)
+	assert.Body(t, errBody(ErrPlaylistNotFound), rr.Body.String())
+}
+
+func Test_MovePlaylistMedia_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	id, userId, mediaId := uuid.New(), uuid.New(), uuid.New()
+	s.mockPlaylistService.EXPECT().
+		MoveMedia(gomock.Eq(id), gomock.Eq(userId), gomock.Eq(mediaId), gomock.Eq(3)).
+		Return(nil).
+		Times(1)
+
+	s.server.withPlaylistMediaMove(s.authGroup, "/playlists")
+	rr := s.withAuthPutRequest(body(`{"position":3}`), fmt.Sprintf("playlists/%v/media/%v/position", id.String(), mediaId.String())).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+}
+
+func Test_PutPlaylistMedia_SmartPlaylist(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockPlaylistService.EXPECT().
+		AddMedia(gomock.Eq(id), gomock.Eq(userId), gomock.Any()).
+		Return(nil, playlistService.ErrSmartPlaylist).
+		Times(1)
+
+	s.server.withPlaylistMediaAdd(s.authGroup, "/playlists")
+	rr := s.withAuthPutRequest(body(`[{"mediaId":"%v"}]`, uuid.New().String()), fmt.Sprintf("playlists/%v/media", id.String())).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusBadRequest, rr.Code)
+	assert.Body(t, errBody(ErrSmartPlaylist), rr.Body.String())
+}
+
+func Test_DeletePlaylist_AccessDenied(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockPlaylistService.EXPECT().
+		Delete(gomock.Eq(id), gomock.Eq(userId)).
+		Return(playlistService.ErrPlaylistAccessDenied).
+		Times(1)
+
+	s.server.withPlaylistDelete(s.authGroup, "/playlists")
+	rr := s.withAuthDeleteRequest(fmt.Sprintf("playlists/%v", id.String())).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusForbidden, rr.Code)
+	assert.Body(t, errBody(ErrPlaylistAccessDenied), rr.Body.String())
+}
+
+func Test_GrantPlaylistAccess_InvalidAccess(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	s.server.withPlaylistUsers(s.authGroup, "/playlists")
+	rr := s.withAuthPutRequest(body(`{"access":"owner"}`), fmt.Sprintf("playlists/%v/users/%v", uuid.New().String(), uuid.New().String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func Test_GrantPlaylistAccess_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	id, userId, sharedWith := uuid.New(), uuid.New(), uuid.New()
+	s.mockPlaylistService.EXPECT().
+		GrantAccess(gomock.Eq(id), gomock.Eq(userId), gomock.Eq(sharedWith), gomock.Eq(model.PlaylistAccessEnum_Collaborate)).
+		Return(nil).
+		Times(1)
+
+	s.server.withPlaylistUsers(s.authGroup, "/playlists")
+	rr := s.withAuthPutRequest(body(`{"access":"collaborate"}`), fmt.Sprintf("playlists/%v/users/%v", id.String(), sharedWith.String())).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+}
+
+func Test_GetSharedPlaylists(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withPlaylistService()
+
+	userId := uuid.New()
+	shared := models.SharedPlaylist{
+		Playlist:     model.Playlist{ID: uuid.New(), Name: "movie night", UserID: uuid.New()},
+		PlaylistUser: model.PlaylistUser{UserID: userId, Access: model.PlaylistAccessEnum_Read},
+	}
+	s.mockPlaylistService.EXPECT().
+		GetShared(gomock.Eq(userId)).
+		Return([]models.SharedPlaylist{shared}, nil).
+		Times(1)
+
+	s.server.withPlaylistsShared(s.authGroup, "/playlists")
+	rr := s.withAuthGetRequest("playlists/shared").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+
+	body, _ := json.Marshal([]dto.SharedPlaylistDTO{*(&dto.SharedPlaylistDTO{}).FromModel(shared)})
+	assert.Body(t, string(body), rr.Body.String())
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 )
 
@@ -27,11 +28,17 @@ const (
 type key = string
 
 const (
-	nameKey     key = "name"
-	idKey       key = "id"
-	idKey1      key = "id1"
-	tagIdKey    key = "tagIdKey"
-	personIdKey key = "personIdKey"
+	nameKey      key = "name"
+	idKey        key = "id"
+	idKey1       key = "id1"
+	aliasIdKey   key = "aliasId"
+	tagIdKey     key = "tagIdKey"
+	personIdKey  key = "personIdKey"
+	userIdKey    key = "userId"
+	mediaIdKey   key = "mediaId"
+	renditionKey key = "rendition"
+	segmentKey   key = "segment"
+	trackKey     key = "track"
 )
 
 func (s *server) RegisterRoutes() http.Handler {
@@ -50,70 +57,109 @@ func (s *server) RegisterRoutes() http.Handler {
 	s.withAuthLogin(&r.RouterGroup, fmt.Sprintf("%v/api/login", root)).
 		withAuthLogout(&r.RouterGroup, fmt.Sprintf("%v/api/logout", root))
 
-	authenticated := r.Group("/api")
-	authenticated.Use(s.AuthRequired)
+	// Users that still have to change their password can only get to their own account
+	account := r.Group("/api")
+	account.Use(s.AuthRequired)
+	authenticated := account.Group("", s.RequirePasswordChanged)
+
+	// Route groups for the permissions that roles grant on top of reading
+	mediaEditors := authenticated.Group("", s.RequirePermission(dto.Permission_EditMedia))
+	jobRunners := authenticated.Group("", s.RequirePermission(dto.Permission_RunJobs))
+	jobManagers := authenticated.Group("", s.RequirePermission(dto.Permission_ManageJobs))
+	libraryManagers := authenticated.Group("", s.RequirePermission(dto.Permission_ManageLibraries))
+	userManagers := authenticated.Group("", s.RequirePermission(dto.Permission_ManageUsers))
 
 	// Register user controller routes
-	s.withUserCreate(authenticated, users).
-		withUserUpdatePassword(authenticated, users).
+	s.withUserCreate(userManagers, users).
+		withUserManagement(userManagers, users).
+		withUserGetMe(account, users).
+		withUserApiTokens(authenticated, users).
+		withUserSessions(authenticated, users).
+		withUserUpdatePassword(account, users).
 		withUserPutFavourite(authenticated, users).
-		withUserDeleteFavourite(authenticated, users)
+		withUserDeleteFavourite(authenticated, users).
+		withUserWatched(authenticated, users).
+		withUserContinueWatching(authenticated, users)
 
 	// Register library controller routes
 	s.withLibraryGet(authenticated, libraries).
-		withLibraryPost(authenticated, libraries).
+		withLibraryPost(libraryManagers, libraries).
 		withLibraryGetPaths(authenticated, libraries).
-		withLibraryGetMedia(authenticated, libraries)
+		withLibraryGetMedia(authenticated, libraries).
+		withLibraryUsers(libraryManagers, libraries)
 
 	// Register library path controller routes
-	s.withLibraryPathCreate(authenticated, libraryPath).
+	s.withLibraryPathCreate(libraryManagers, libraryPath).
 		withLibraryPathGetAll(authenticated, libraryPath).
 		withLibraryPathGet(authenticated, libraryPath).
-		withLibraryPut(authenticated, libraries)
+		withLibraryPut(libraryManagers, libraries)
 
 	// Register media controller routes
 	s.withMediaSearch(authenticated, mediaRoute).
+		withMediaDuplicates(mediaEditors, mediaRoute).
 		withMediaGet(authenticated, mediaRoute).
-		withMediaPutTag(authenticated, mediaRoute).
-		withMediaDeleteTag(authenticated, mediaRoute).
-		withMediaPutPerson(authenticated, mediaRoute).
-		withMediaDeletePerson(authenticated, mediaRoute).
-		withMediaDelete(authenticated, mediaRoute).
-		withMediaPut(authenticated, mediaRoute).
+		withMediaPutTag(mediaEditors, mediaRoute).
+		withMediaDeleteTag(mediaEditors, mediaRoute).
+		withMediaPutPerson(mediaEditors, mediaRoute).
+		withMediaDeletePerson(mediaEditors, mediaRoute).
+		withMediaDelete(mediaEditors, mediaRoute).
+		withMediaPut(mediaEditors, mediaRoute).
 		withMediaThumbnailGet(authenticated, mediaRoute).
-		withMediaRelatePut(authenticated, mediaRoute).
-		withMediaRelateDelete(authenticated, mediaRoute)
+		withMediaRelatePut(mediaEditors, mediaRoute).
+		withMediaRelateDelete(mediaEditors, mediaRoute).
+		withMediaRating(authenticated, mediaRoute)
 
 	s.withImageGet(authenticated, images).
 		withVideoGet(authenticated, videos).
-		withVideoPut(authenticated, videos)
+		withVideoHls(authenticated, videos).
+		withVideoSubtitles(authenticated, videos).
+		withVideoPut(mediaEditors, videos)
 
 	// Register job controller routes
-	s.withJobRoutes(authenticated, jobs).
-		withJobCreate(authenticated, jobs).
-		withJobGetAll(authenticated, jobs)
+	s.withJobRoutes(jobManagers, jobs).
+		withJobCreate(jobRunners, jobs).
+		withJobGetAll(authenticated, jobs).
+		withJobCancel(jobRunners, jobs).
+		withJobRetry(jobRunners, jobs).
+		withJobTree(authenticated, jobs).
+		withJobDelete(jobManagers, jobs).
+		withJobSchedules(jobManagers, jobs)
 
 	// Register person controller routes
 	s.withPersonGetAll(authenticated, people).
-		withPersonCreate(authenticated, people).
+		withPersonCreate(mediaEditors, people).
 		withPersonGetMedia(authenticated, people).
-		withPersonPut(authenticated, people).
-		withPersonDelete(authenticated, people)
+		withPersonPut(mediaEditors, people).
+		withPersonDelete(mediaEditors, people).
+		withPersonGetAliases(authenticated, people).
+		withPersonCreateAlias(mediaEditors, people).
+		withPersonDeleteAlias(mediaEditors, people).
+		withPersonMerge(mediaEditors, people).
+		withPersonRating(authenticated, people).
+		withPersonFavourite(authenticated, people)
 
 	// Regsiter tags controller routes
 	s.withTagGetAll(authenticated, tags).
-		withTagCreate(authenticated, tags).
+		withTagCreate(mediaEditors, tags).
 		withTagGetMedia(authenticated, tags).
-		withTagPut(authenticated, tags).
-		withTagDelete(authenticated, tags)
+		withTagPut(mediaEditors, tags).
+		withTagDelete(mediaEditors, tags).
+		withTagGetAliases(authenticated, tags).
+		withTagCreateAlias(mediaEditors, tags).
+		withTagDeleteAlias(mediaEditors, tags).
+		withTagMerge(mediaEditors, tags)
 
 	// Register playlist controller routes
 	s.withPlaylistsGetAll(authenticated, playlists).
+		withPlaylistsShared(authenticated, playlists).
 		withPlaylistsCreate(authenticated, playlists).
 		withPlaylistsMedia(authenticated, playlists).
 		withPlaylistMediaAdd(authenticated, playlists).
+		withPlaylistMediaRemove(authenticated, playlists).
+		withPlaylistMediaMove(authenticated, playlists).
 		withPlaylistPut(authenticated, playlists).
-		withPlaylistDelete(authenticated, playlists)
+		withPlaylistDelete(authenticated, playlists).
+		withPlaylistUsers(authenticated, playlists)
 
 	s.withWS(authenticated, root)
 
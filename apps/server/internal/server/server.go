@@ -7,6 +7,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	"github.com/slugger7/exorcist/apps/server/internal/job"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
@@ -22,19 +24,27 @@ type server struct {
 	service          service.Service
 	logger           logger.Logger
 	jobCh            chan bool
+	cancelJobCh      chan uuid.UUID
 	wsService        websockets.Websockets
 	directoryWatcher filewatcher.WatcherService
 }
 
 func (s *server) withJobRunner(ctx context.Context, wg *sync.WaitGroup, ws websockets.Websockets) *server {
-	ch := job.New(s.env, s.service, s.logger, ctx, wg, ws)
+	ch, cancelCh := job.New(s.env, s.service, s.logger, ctx, wg, ws)
 	s.jobCh = ch
+	s.cancelJobCh = cancelCh
 
 	ch <- true // start if any jobs exist
 
 	return s
 }
 
+func (s *server) withJobScheduler(ctx context.Context, wg *sync.WaitGroup) *server {
+	job.NewScheduler(s.env, s.service, s.logger, ctx, wg, s.wsService)
+
+	return s
+}
+
 func New(env *environment.EnvironmentVariables, wg *sync.WaitGroup) *http.Server {
 	lg := logger.New(env)
 	shutdownCtx, cancel := context.WithCancel(context.Background())
@@ -45,7 +55,7 @@ func New(env *environment.EnvironmentVariables, wg *sync.WaitGroup) *http.Server
 		repo:      repo,
 		env:       env,
 		logger:    lg,
-		wsService: websockets.New(env),
+		wsService: websockets.New(env, repo),
 	}
 
 	err := newServer.repo.Job().CancelInprogress()
@@ -56,7 +66,11 @@ func New(env *environment.EnvironmentVariables, wg *sync.WaitGroup) *http.Server
 	if env.JobRunner {
 		newServer.withJobRunner(shutdownCtx, wg, newServer.wsService)
 	}
-	newServer.service = service.New(repo, env, newServer.jobCh, shutdownCtx)
+	newServer.service = service.New(repo, env, newServer.jobCh, newServer.cancelJobCh, shutdownCtx)
+
+	if env.JobRunner {
+		newServer.withJobScheduler(shutdownCtx, wg)
+	}
 
 	newServer.directoryWatcher = filewatcher.New(*env, shutdownCtx, wg, repo, newServer.wsService, newServer.service)
 	newServer.directoryWatcher.WithDirectoryWatcher()
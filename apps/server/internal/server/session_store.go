@@ -0,0 +1,226 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/google/uuid"
+	"github.com/gorilla/securecookie"
+	gsessions "github.com/gorilla/sessions"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/slugger7/exorcist/apps/server/internal/repository"
+)
+
+// defaultSessionAge is how long sessions are kept when the cookie has no max age
+const defaultSessionAge = 30 * 24 * time.Hour
+
+// lastSeenInterval limits how often the last seen time of a session is written
+const lastSeenInterval = time.Minute
+
+// sessionStore keeps sessions in the database so that they can be listed and revoked.
+// The cookie only holds the signed id of the session.
+type sessionStore struct {
+	repo    repository.Repository
+	logger  logger.Logger
+	codecs  []securecookie.Codec
+	options *gsessions.Options
+	encoder securecookie.GobEncoder
+}
+
+func newSessionStore(repo repository.Repository, lg logger.Logger, keyPairs ...[]byte) sessions.Store {
+	return &sessionStore{
+		repo:    repo,
+		logger:  lg,
+		codecs:  securecookie.CodecsFromPairs(keyPairs...),
+		options: &gsessions.Options{Path: "/", MaxAge: int(defaultSessionAge.Seconds())},
+	}
+}
+
+// Options implements sessions.Store.
+func (s *sessionStore) Options(options sessions.Options) {
+	s.options = options.ToGorillaOptions()
+}
+
+// Get implements sessions.Store.
+func (s *sessionStore) Get(r *http.Request, name string) (*gsessions.Session, error) {
+	return gsessions.GetRegistry(r).Get(s, name)
+}
+
+// New implements sessions.Store. Unknown, expired and revoked sessions result in a new empty session.
+func (s *sessionStore) New(r *http.Request, name string) (*gsessions.Session, error) {
+	session := gsessions.NewSession(s, name)
+	opts := *s.options
+	session.Options = &opts
+	session.IsNew = true
+
+	c, err := r.Cookie(name)
+	if err != nil {
+		return session, nil
+	}
+
+	var id uuid.UUID
+	if err := securecookie.DecodeMulti(name, c.Value, &id, s.codecs...); err != nil {
+		s.logger.Debugf("could not decode session cookie: %v", err.Error())
+		return session, nil
+	}
+
+	userSession, err := s.repo.Session().GetById(id)
+	if err != nil {
+		return session, errs.BuildError(err, "could not get session %v", id.String())
+	}
+
+	if userSession == nil {
+		return session, nil
+	}
+
+	if err := s.encoder.Deserialize(userSession.Data, &session.Values); err != nil {
+		return session, errs.BuildError(err, "could not decode data of session %v", id.String())
+	}
+
+	session.ID = id.String()
+	session.IsNew = false
+
+	if time.Since(userSession.LastSeen) > lastSeenInterval {
+		userSession.LastSeen = time.Now()
+		userSession.UserAgent = nilIfEmpty(r.UserAgent())
+		userSession.IPAddress = nilIfEmpty(remoteIp(r))
+		if err := s.repo.Session().UpdateLastSeen(*userSession); err != nil {
+			s.logger.Errorf("could not update last seen of session %v: %v", id.String(), err.Error())
+		}
+	}
+
+	return session, nil
+}
+
+// Save implements sessions.Store. Sessions without a user are removed as there is nothing worth keeping in them.
+func (s *sessionStore) Save(r *http.Request, w http.ResponseWriter, session *gsessions.Session) error {
+	userId, _ := session.Values[userKey].(string)
+	if session.Options.MaxAge < 0 || userId == "" {
+		if session.ID != "" {
+			id, err := uuid.Parse(session.ID)
+			if err != nil {
+				return errs.BuildError(err, "could not parse session id %v", session.ID)
+			}
+
+			if err := s.repo.Session().Delete(id); err != nil {
+				return err
+			}
+		}
+
+		s.expireCookie(w, session)
+		return nil
+	}
+
+	parsedUserId, err := uuid.Parse(userId)
+	if err != nil {
+		return errs.BuildError(err, "could not parse user id of session: %v", userId)
+	}
+
+	data, err := s.encoder.Serialize(session.Values)
+	if err != nil {
+		return errs.BuildError(err, "could not encode session data")
+	}
+
+	age := defaultSessionAge
+	if session.Options.MaxAge > 0 {
+		age = time.Duration(session.Options.MaxAge) * time.Second
+	}
+
+	userSession := model.UserSession{
+		UserID:    parsedUserId,
+		Data:      data,
+		UserAgent: nilIfEmpty(r.UserAgent()),
+		IPAddress: nilIfEmpty(remoteIp(r)),
+		Expires:   time.Now().Add(age),
+	}
+
+	var id uuid.UUID
+	if session.ID == "" {
+		created, err := s.repo.Session().Create(userSession)
+		if err != nil {
+			return err
+		}
+		id = created.ID
+		session.ID = id.String()
+
+		if err := s.repo.Session().DeleteExpired(); err != nil {
+			s.logger.Errorf("could not clean up expired sessions: %v", err.Error())
+		}
+	} else {
+		id, err = uuid.Parse(session.ID)
+		if err != nil {
+			return errs.BuildError(err, "could not parse session id %v", session.ID)
+		}
+		userSession.ID = id
+
+		updated, err := s.repo.Session().Update(userSession)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
+			s.expireCookie(w, session)
+			return nil
+		}
+	}
+
+	encoded, err := securecookie.EncodeMulti(session.Name(), id, s.codecs...)
+	if err != nil {
+		return errs.BuildError(err, "could not encode session cookie")
+	}
+
+	http.SetCookie(w, gsessions.NewCookie(session.Name(), encoded, session.Options))
+
+	return nil
+}
+
+// renewSession throws away the session the request came with so that logging in always issues a new session id.
+// Otherwise a session cookie planted in the browser before logging in would end up belonging to the user.
+func (s *server) renewSession(session sessions.Session) error {
+	session.Clear()
+
+	gs, ok := session.(interface{ Session() *gsessions.Session })
+	if !ok || gs.Session().ID == "" {
+		return nil
+	}
+
+	id, err := uuid.Parse(gs.Session().ID)
+	if err != nil {
+		return errs.BuildError(err, "could not parse session id %v", gs.Session().ID)
+	}
+
+	if err := s.repo.Session().Delete(id); err != nil {
+		return err
+	}
+
+	gs.Session().ID = ""
+
+	return nil
+}
+
+func (s *sessionStore) expireCookie(w http.ResponseWriter, session *gsessions.Session) {
+	opts := *session.Options
+	opts.MaxAge = -1
+	http.SetCookie(w, gsessions.NewCookie(session.Name(), "", &opts))
+}
+
+func remoteIp(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/securecookie"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_sessionRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/session"
+	sessionRepository "github.com/slugger7/exorcist/apps/server/internal/repository/session"
+	"go.uber.org/mock/gomock"
+)
+
+type testSessionStore struct {
+	engine      *gin.Engine
+	sessionRepo *mock_sessionRepository.MockSessionRepository
+}
+
+func setupSessionStore(t *testing.T) *testSessionStore {
+	ctrl := gomock.NewController(t)
+	repo := mock_repository.NewMockRepository(ctrl)
+	sessionRepo := mock_sessionRepository.NewMockSessionRepository(ctrl)
+
+	repo.EXPECT().
+		Session().
+		DoAndReturn(func() sessionRepository.SessionRepository {
+			return sessionRepo
+		}).
+		AnyTimes()
+
+	env := environment.EnvironmentVariables{LogLevel: "none"}
+	srv := &server{repo: repo, logger: logger.New(&env), env: &env}
+	r := gin.New()
+	r.Use(sessions.Sessions("exorcist", newSessionStore(repo, srv.logger, []byte("cookieSecret"))))
+
+	r.GET("/login/:id", func(c *gin.Context) {
+		session := sessions.Default(c)
+		if err := srv.renewSession(session); err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		session.Set(userKey, c.Param(idKey))
+		_ = session.Save()
+		c.String(http.StatusOK, session.ID())
+	})
+
+	r.GET("/me", func(c *gin.Context) {
+		user, _ := sessions.Default(c).Get(userKey).(string)
+		c.String(http.StatusOK, user)
+	})
+
+	return &testSessionStore{r, sessionRepo}
+}
+
+func sessionCookie(t *testing.T, id uuid.UUID) string {
+	encoded, err := securecookie.EncodeMulti("exorcist", id, securecookie.CodecsFromPairs([]byte("cookieSecret"))...)
+	assert.ErrorNil(t, err)
+
+	return (&http.Cookie{Name: "exorcist", Value: encoded}).String()
+}
+
+func Test_SessionStore_Save_CreatesSession(t *testing.T) {
+	s := setupSessionStore(t)
+
+	userId, sessionId := uuid.New(), uuid.New()
+	s.sessionRepo.EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(m model.UserSession) (*model.UserSession, error) {
+			assert.Eq(t, userId, m.UserID)
+			assert.Eq(t, "test-agent", *m.UserAgent)
+			m.ID = sessionId
+			return &m, nil
+		}).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		DeleteExpired().
+		Return(nil).
+		Times(1)
+
+	req, _ := http.NewRequest("GET", "/login/"+userId.String(), nil)
+	req.Header.Set("User-Agent", "test-agent")
+	rr := httptest.NewRecorder()
+	s.engine.ServeHTTP(rr, req)
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, sessionId.String(), rr.Body.String())
+
+	var decoded uuid.UUID
+	cookie := rr.Result().Cookies()[0]
+	err := securecookie.DecodeMulti("exorcist", cookie.Value, &decoded, securecookie.CodecsFromPairs([]byte("cookieSecret"))...)
+	assert.ErrorNil(t, err)
+	assert.Eq(t, sessionId, decoded)
+}
+
+func Test_SessionStore_Login_ReplacesExistingSession(t *testing.T) {
+	s := setupSessionStore(t)
+
+	plantedUserId, userId, plantedId, sessionId := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	data, _ := securecookie.GobEncoder{}.Serialize(map[interface{}]interface{}{userKey: plantedUserId.String()})
+	s.sessionRepo.EXPECT().
+		GetById(gomock.Eq(plantedId)).
+		Return(&model.UserSession{ID: plantedId, UserID: plantedUserId, Data: data, LastSeen: time.Now()}, nil).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		Delete(gomock.Eq(plantedId)).
+		Return(nil).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(m model.UserSession) (*model.UserSession, error) {
+			assert.Eq(t, userId, m.UserID)
+			m.ID = sessionId
+			return &m, nil
+		}).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		DeleteExpired().
+		Return(nil).
+		Times(1)
+
+	req, _ := http.NewRequest("GET", "/login/"+userId.String(), nil)
+	req.Header.Set("Cookie", sessionCookie(t, plantedId))
+	rr := httptest.NewRecorder()
+	s.engine.ServeHTTP(rr, req)
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, sessionId.String(), rr.Body.String())
+}
+
+func Test_SessionStore_New_LoadsSession(t *testing.T) {
+	s := setupSessionStore(t)
+
+	userId, sessionId := uuid.New(), uuid.New()
+	data, _ := securecookie.GobEncoder{}.Serialize(map[interface{}]interface{}{userKey: userId.String()})
+	s.sessionRepo.EXPECT().
+		GetById(gomock.Eq(sessionId)).
+		Return(&model.UserSession{ID: sessionId, UserID: userId, Data: data, LastSeen: time.Now()}, nil).
+		Times(1)
+
+	req, _ := http.NewRequest("GET", "/me", nil)
+	req.Header.Set("Cookie", sessionCookie(t, sessionId))
+	rr := httptest.NewRecorder()
+	s.engine.ServeHTTP(rr, req)
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, userId.String(), rr.Body.String())
+}
+
+func Test_SessionStore_New_RevokedSessionIsEmpty(t *testing.T) {
+	s := setupSessionStore(t)
+
+	sessionId := uuid.New()
+	s.sessionRepo.EXPECT().
+		GetById(gomock.Eq(sessionId)).
+		Return(nil, nil).
+		Times(1)
+
+	req, _ := http.NewRequest("GET", "/me", nil)
+	req.Header.Set("Cookie", sessionCookie(t, sessionId))
+	rr := httptest.NewRecorder()
+	s.engine.ServeHTTP(rr, req)
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	assert.Body(t, "", rr.Body.String())
+}
+
+func Test_SessionStore_New_UpdatesLastSeenWhenStale(t *testing.T) {
+	s := setupSessionStore(t)
+
+	userId, sessionId := uuid.New(), uuid.New()
+	data, _ := securecookie.GobEncoder{}.Serialize(map[interface{}]interface{}{userKey: userId.String()})
+	s.sessionRepo.EXPECT().
+		GetById(gomock.Eq(sessionId)).
+		Return(&model.UserSession{ID: sessionId, UserID: userId, Data: data, LastSeen: time.Now().Add(-time.Hour)}, nil).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		UpdateLastSeen(gomock.Any()).
+		DoAndReturn(func(m model.UserSession) error {
+			assert.Eq(t, sessionId, m.ID)
+			return nil
+		}).
+		Times(1)
+
+	req, _ := http.NewRequest("GET", "/me", nil)
+	req.Header.Set("Cookie", sessionCookie(t, sessionId))
+	rr := httptest.NewRecorder()
+	s.engine.ServeHTTP(rr, req)
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+}
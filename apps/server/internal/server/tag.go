@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	tagService "github.com/slugger7/exorcist/apps/server/internal/service/tag"
 )
 
 func (s *server) withTagGetAll(r *gin.RouterGroup, route Route) *server {
@@ -165,3 +166,151 @@ func (s *server) getAllTags(c *gin.Context) {
 
 	c.JSON(http.StatusOK, tagDtos)
 }
+
+func (s *server) withTagGetAliases(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/aliases", route, idKey), s.getTagAliases)
+	return s
+}
+
+func (s *server) withTagCreateAlias(r *gin.RouterGroup, route Route) *server {
+	r.POST(fmt.Sprintf("%v/:%v/aliases", route, idKey), s.createTagAlias)
+	return s
+}
+
+func (s *server) withTagDeleteAlias(r *gin.RouterGroup, route Route) *server {
+	r.DELETE(fmt.Sprintf("%v/:%v/aliases/:%v", route, idKey, aliasIdKey), s.deleteTagAlias)
+	return s
+}
+
+func (s *server) withTagMerge(r *gin.RouterGroup, route Route) *server {
+	r.POST(fmt.Sprintf("%v/:%v/merge", route, idKey), s.mergeTag)
+	return s
+}
+
+const (
+	ErrTagNotFound        ApiError = "tag not found"
+	ErrGetTagAliases      ApiError = "could not get tag aliases"
+	ErrCreateTagAlias     ApiError = "could not create tag alias"
+	ErrDeleteTagAlias     ApiError = "could not delete tag alias"
+	ErrMergeTag           ApiError = "could not merge tags"
+	ErrTagMergeIntoItself ApiError = "tag cannot be merged into itself"
+	ErrAliasNotFound      ApiError = "alias not found"
+	ErrAliasTaken         ApiError = "alias is already in use"
+	ErrAliasEmpty         ApiError = "alias can not be empty"
+)
+
+func (s *server) getTagAliases(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	aliases, err := s.service.Tag().GetAliases(id)
+	if err != nil {
+		s.tagServiceError(c, err, ErrGetTagAliases)
+		return
+	}
+
+	aliasDtos := make([]dto.AliasDTO, len(aliases))
+	for i, a := range aliases {
+		aliasDtos[i] = *(&dto.AliasDTO{}).FromTagAlias(a)
+	}
+
+	c.JSON(http.StatusOK, aliasDtos)
+}
+
+func (s *server) createTagAlias(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var body dto.CreateAliasDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	alias, err := s.service.Tag().AddAlias(id, body.Alias)
+	if err != nil {
+		s.tagServiceError(c, err, ErrCreateTagAlias)
+		return
+	}
+
+	c.JSON(http.StatusCreated, (&dto.AliasDTO{}).FromTagAlias(*alias))
+}
+
+func (s *server) deleteTagAlias(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	aliasId, err := uuid.Parse(c.Param(aliasIdKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if err := s.service.Tag().RemoveAlias(id, aliasId); err != nil {
+		s.tagServiceError(c, err, ErrDeleteTagAlias)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) mergeTag(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var mergeDto dto.MergeDTO
+	if err := c.ShouldBindBodyWithJSON(&mergeDto); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	mediaIds, err := s.service.Tag().Merge(id, mergeDto.MergeIds)
+	if err != nil {
+		s.tagServiceError(c, err, ErrMergeTag)
+		return
+	}
+
+	for _, mediaId := range mediaIds {
+		m, err := s.repo.Media().GetById(mediaId)
+		if err != nil {
+			s.logger.Errorf("could not get media %v to send tags update: %v", mediaId.String(), err.Error())
+			continue
+		}
+
+		mediaDto := new(dto.MediaDTO).FromModel(*m)
+		s.wsService.MediaUpdate(dto.MediaDTO{ID: mediaId, Tags: mediaDto.Tags})
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// tagServiceError responds with the status that matches the error of the tag service
+func (s *server) tagServiceError(c *gin.Context, err error, fallback ApiError) {
+	switch {
+	case errors.Is(err, tagService.ErrTagNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrTagNotFound})
+	case errors.Is(err, tagService.ErrAliasNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrAliasNotFound})
+	case errors.Is(err, tagService.ErrAliasTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": ErrAliasTaken})
+	case errors.Is(err, tagService.ErrAliasEmpty):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrAliasEmpty})
+	case errors.Is(err, tagService.ErrMergeIntoItself):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrTagMergeIntoItself})
+	default:
+		s.logger.Errorf("%v: %v", fallback, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
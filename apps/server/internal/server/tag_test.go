@@ -0,0 +1,182 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	tagService "github.com/slugger7/exorcist/apps/server/internal/service/tag"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_GetTagAliases_TagNotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id := uuid.New()
+	s.mockTagService.EXPECT().
+		GetAliases(gomock.Eq(id)).
+		Return(nil, tagService.ErrTagNotFound).
+		Times(1)
+
+	s.server.withTagGetAliases(s.authGroup, "/tags")
+	rr := s.withAuthGetRequest(fmt.Sprintf("tags/%v/aliases", id.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusNotFound, rr.Code)
+	assert.Body(t, errBody(ErrTagNotFound), rr.Body.String())
+}
+
+func Test_CreateTagAlias_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id, aliasId := uuid.New(), uuid.New()
+	created := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.mockTagService.EXPECT().
+		AddAlias(gomock.Eq(id), gomock.Eq("sci-fi")).
+		Return(&model.TagAlias{ID: aliasId, TagID: id, Alias: "sci-fi", Created: created}, nil).
+		Times(1)
+
+	s.server.withTagCreateAlias(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(bodyM(dto.CreateAliasDTO{Alias: "sci-fi"}), fmt.Sprintf("tags/%v/aliases", id.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusCreated, rr.Code)
+	expectedBody := fmt.Sprintf(`{"id":"%v","alias":"sci-fi","created":"2026-10-18T09:00:00Z"}`, aliasId.String())
+	assert.Body(t, expectedBody, rr.Body.String())
+}
+
+func Test_CreateTagAlias_Taken(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id := uuid.New()
+	s.mockTagService.EXPECT().
+		AddAlias(gomock.Eq(id), gomock.Eq("sci-fi")).
+		Return(nil, tagService.ErrAliasTaken).
+		Times(1)
+
+	s.server.withTagCreateAlias(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(bodyM(dto.CreateAliasDTO{Alias: "sci-fi"}), fmt.Sprintf("tags/%v/aliases", id.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusConflict, rr.Code)
+	assert.Body(t, errBody(ErrAliasTaken), rr.Body.String())
+}
+
+func Test_CreateTagAlias_BlankAlias(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id := uuid.New()
+	s.mockTagService.EXPECT().
+		AddAlias(gomock.Eq(id), gomock.Eq("   ")).
+		Return(nil, tagService.ErrAliasEmpty).
+		Times(1)
+
+	s.server.withTagCreateAlias(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(bodyM(dto.CreateAliasDTO{Alias: "   "}), fmt.Sprintf("tags/%v/aliases", id.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusBadRequest, rr.Code)
+	assert.Body(t, errBody(ErrAliasEmpty), rr.Body.String())
+}
+
+func Test_CreateTagAlias_MissingAlias(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	s.server.withTagCreateAlias(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(body(`{}`), fmt.Sprintf("tags/%v/aliases", uuid.New().String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func Test_DeleteTagAlias_NotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id, aliasId := uuid.New(), uuid.New()
+	s.mockTagService.EXPECT().
+		RemoveAlias(gomock.Eq(id), gomock.Eq(aliasId)).
+		Return(tagService.ErrAliasNotFound).
+		Times(1)
+
+	s.server.withTagDeleteAlias(s.authGroup, "/tags")
+	rr := s.withAuthDeleteRequest(fmt.Sprintf("tags/%v/aliases/%v", id.String(), aliasId.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusNotFound, rr.Code)
+	assert.Body(t, errBody(ErrAliasNotFound), rr.Body.String())
+}
+
+func Test_MergeTag_IntoItself(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id := uuid.New()
+	s.mockTagService.EXPECT().
+		Merge(gomock.Eq(id), gomock.Eq([]uuid.UUID{id})).
+		Return(nil, tagService.ErrMergeIntoItself).
+		Times(1)
+
+	s.server.withTagMerge(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(bodyM(dto.MergeDTO{MergeIds: []uuid.UUID{id}}), fmt.Sprintf("tags/%v/merge", id.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusBadRequest, rr.Code)
+	assert.Body(t, errBody(ErrTagMergeIntoItself), rr.Body.String())
+}
+
+func Test_MergeTag_MissingMergeIds(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	s.server.withTagMerge(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(body(`{"mergeIds":[]}`), fmt.Sprintf("tags/%v/merge", uuid.New().String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func Test_MergeTag_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withTagService()
+
+	id, mergeId := uuid.New(), uuid.New()
+	s.mockTagService.EXPECT().
+		Merge(gomock.Eq(id), gomock.Eq([]uuid.UUID{mergeId})).
+		Return([]uuid.UUID{}, nil).
+		Times(1)
+
+	s.server.withTagMerge(s.authGroup, "/tags")
+	rr := s.withAuthPostRequest(bodyM(dto.MergeDTO{MergeIds: []uuid.UUID{mergeId}}), fmt.Sprintf("tags/%v/merge", id.String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+}
@@ -16,13 +16,26 @@ import (
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/library_path"
+	mock_mediaRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/media"
 	mock_service "github.com/slugger7/exorcist/apps/server/internal/mock/service"
 	mock_filewatcher "github.com/slugger7/exorcist/apps/server/internal/mock/service/file_watcher"
+	mock_hlsService "github.com/slugger7/exorcist/apps/server/internal/mock/service/hls"
 	mock_libraryService "github.com/slugger7/exorcist/apps/server/internal/mock/service/library"
 	mock_libraryPathService "github.com/slugger7/exorcist/apps/server/internal/mock/service/library_path"
+	mock_personService "github.com/slugger7/exorcist/apps/server/internal/mock/service/person"
+	mock_playlistService "github.com/slugger7/exorcist/apps/server/internal/mock/service/playlist"
+	mock_tagService "github.com/slugger7/exorcist/apps/server/internal/mock/service/tag"
 	mock_userService "github.com/slugger7/exorcist/apps/server/internal/mock/service/user"
+	libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library_path"
+	mediaRepository "github.com/slugger7/exorcist/apps/server/internal/repository/media"
+	hlsService "github.com/slugger7/exorcist/apps/server/internal/service/hls"
 	libraryService "github.com/slugger7/exorcist/apps/server/internal/service/library"
 	libraryPathService "github.com/slugger7/exorcist/apps/server/internal/service/library_path"
+	personService "github.com/slugger7/exorcist/apps/server/internal/service/person"
+	playlistService "github.com/slugger7/exorcist/apps/server/internal/service/playlist"
+	tagService "github.com/slugger7/exorcist/apps/server/internal/service/tag"
 	userService "github.com/slugger7/exorcist/apps/server/internal/service/user"
 	"go.uber.org/mock/gomock"
 )
@@ -41,7 +54,14 @@ type TestServer struct {
 	mockUserService             *mock_userService.MockUserService
 	mockLibraryService          *mock_libraryService.MockLibraryService
 	mockLibraryPathService      *mock_libraryPathService.MockLibraryPathService
+	mockTagService              *mock_tagService.MockTagService
+	mockPlaylistService         *mock_playlistService.MockPlaylistService
+	mockPersonService           *mock_personService.MockPersonService
 	mockDirectoryWatcherService *mock_filewatcher.MockWatcherService
+	mockHlsService              *mock_hlsService.MockHlsService
+	mockRepo                    *mock_repository.MockRepository
+	mockMediaRepo               *mock_mediaRepository.MockMediaRepository
+	mockLibraryPathRepo         *mock_libraryPathRepository.MockLibraryPathRepository
 	ctrl                        *gomock.Controller
 	engine                      *gin.Engine
 	authGroup                   *gin.RouterGroup
@@ -102,6 +122,94 @@ func (s *TestServer) withLibraryPathService() *TestServer {
 	return s
 }
 
+func (s *TestServer) withTagService() *TestServer {
+	ts := mock_tagService.NewMockTagService(s.ctrl)
+
+	s.mockService.EXPECT().
+		Tag().
+		DoAndReturn(func() tagService.TagService {
+			return ts
+		}).
+		AnyTimes()
+
+	s.mockTagService = ts
+
+	return s
+}
+
+func (s *TestServer) withPlaylistService() *TestServer {
+	ps := mock_playlistService.NewMockPlaylistService(s.ctrl)
+
+	s.mockService.EXPECT().
+		Playlist().
+		DoAndReturn(func() playlistService.PlaylistService {
+			return ps
+		}).
+		AnyTimes()
+
+	s.mockPlaylistService = ps
+
+	return s
+}
+
+func (s *TestServer) withPersonService() *TestServer {
+	ps := mock_personService.NewMockPersonService(s.ctrl)
+
+	s.mockService.EXPECT().
+		Person().
+		DoAndReturn(func() personService.PersonService {
+			return ps
+		}).
+		AnyTimes()
+
+	s.mockPersonService = ps
+
+	return s
+}
+
+func (s *TestServer) withHlsService() *TestServer {
+	hs := mock_hlsService.NewMockHlsService(s.ctrl)
+
+	s.mockService.EXPECT().
+		Hls().
+		DoAndReturn(func() hlsService.HlsService {
+			return hs
+		}).
+		AnyTimes()
+
+	s.mockHlsService = hs
+
+	return s
+}
+
+// withRepo is used by handlers that query the repository directly, like the media and library path access checks
+func (s *TestServer) withRepo() *TestServer {
+	repo := mock_repository.NewMockRepository(s.ctrl)
+	mr := mock_mediaRepository.NewMockMediaRepository(s.ctrl)
+	lpr := mock_libraryPathRepository.NewMockLibraryPathRepository(s.ctrl)
+
+	repo.EXPECT().
+		Media().
+		DoAndReturn(func() mediaRepository.MediaRepository {
+			return mr
+		}).
+		AnyTimes()
+
+	repo.EXPECT().
+		LibraryPath().
+		DoAndReturn(func() libraryPathRepository.LibraryPathRepository {
+			return lpr
+		}).
+		AnyTimes()
+
+	s.server.repo = repo
+	s.mockRepo = repo
+	s.mockMediaRepo = mr
+	s.mockLibraryPathRepo = lpr
+
+	return s
+}
+
 func (s *TestServer) withDirectoryWatcher() *TestServer {
 	dirWatch := mock_filewatcher.NewMockWatcherService(s.ctrl)
 
@@ -123,6 +231,12 @@ func (s *TestServer) withCookie(cookie TestCookie) *TestServer {
 	return s
 }
 
+func (s *TestServer) withBearer(token string) *TestServer {
+	s.request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token))
+
+	return s
+}
+
 func (s *TestServer) withAuth() *TestServer {
 	s.authGroup = s.engine.Group(AUTH_ROUTE)
 	s.authGroup.Use(s.server.AuthRequired)
@@ -168,6 +282,12 @@ func (s *TestServer) withAuthGetRequest(params string) *TestServer {
 	return s
 }
 
+func (s *TestServer) withAuthPostRequest(body io.Reader, params string) *TestServer {
+	req, _ := http.NewRequest("POST", fmt.Sprintf("%v/%v", AUTH_ROUTE, params), body)
+	s.request = req
+	return s
+}
+
 func (s *TestServer) withAuthPutRequest(body io.Reader, params string) *TestServer {
 	route := fmt.Sprintf("%v/%v", AUTH_ROUTE, params)
 	req, _ := http.NewRequest("PUT", route, body)
@@ -175,6 +295,12 @@ func (s *TestServer) withAuthPutRequest(body io.Reader, params string) *TestServ
 	return s
 }
 
+func (s *TestServer) withAuthDeleteRequest(params string) *TestServer {
+	req, _ := http.NewRequest("DELETE", fmt.Sprintf("%v/%v", AUTH_ROUTE, params), nil)
+	s.request = req
+	return s
+}
+
 func (s *TestServer) exec() *httptest.ResponseRecorder {
 	rr := httptest.NewRecorder()
 	s.engine.ServeHTTP(rr, s.request)
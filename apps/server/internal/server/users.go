@@ -1,14 +1,16 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
-	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
+	userService "github.com/slugger7/exorcist/apps/server/internal/service/user"
 )
 
 const ErrCreateUser ApiError = "could not create new user"
@@ -18,6 +20,33 @@ func (s *server) withUserCreate(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withUserManagement(r *gin.RouterGroup, route Route) *server {
+	r.GET(route, s.getUsers)
+	r.PUT(fmt.Sprintf("%v/:%v", route, idKey), s.updateUser)
+	r.PUT(fmt.Sprintf("%v/:%v/password", route, idKey), s.setUserPassword)
+	r.DELETE(fmt.Sprintf("%v/:%v", route, idKey), s.deleteUser)
+	return s
+}
+
+func (s *server) withUserGetMe(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/me", route), s.getMe)
+	return s
+}
+
+func (s *server) withUserApiTokens(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/tokens", route), s.getApiTokens)
+	r.POST(fmt.Sprintf("%v/tokens", route), s.createApiToken)
+	r.DELETE(fmt.Sprintf("%v/tokens/:%v", route, idKey), s.revokeApiToken)
+	return s
+}
+
+func (s *server) withUserSessions(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/sessions", route), s.getSessions)
+	r.DELETE(fmt.Sprintf("%v/sessions", route), s.revokeOtherSessions)
+	r.DELETE(fmt.Sprintf("%v/sessions/:%v", route, idKey), s.revokeSession)
+	return s
+}
+
 func (s *server) withUserUpdatePassword(r *gin.RouterGroup, route Route) *server {
 	r.PUT(route, s.UpdatePassword)
 	return s
@@ -33,6 +62,106 @@ func (s *server) withUserDeleteFavourite(r *gin.RouterGroup, route Route) *serve
 	return s
 }
 
+func (s *server) withUserWatched(r *gin.RouterGroup, route Route) *server {
+	r.PUT(fmt.Sprintf("%v/watched/:%v", route, idKey), s.markMediaWatched)
+	r.DELETE(fmt.Sprintf("%v/watched/:%v", route, idKey), s.markMediaUnwatched)
+	return s
+}
+
+func (s *server) withUserContinueWatching(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/continue-watching", route), s.getContinueWatching)
+	return s
+}
+
+const (
+	ErrMarkWatched      ApiError = "could not mark media as watched"
+	ErrMarkUnwatched    ApiError = "could not mark media as unwatched"
+	ErrContinueWatching ApiError = "could not get media to continue watching"
+)
+
+func (s *server) markMediaWatched(c *gin.Context) {
+	mediaId, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if !s.canAccessMedia(c, mediaId) {
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	prog, err := s.service.Media().MarkWatched(mediaId, *userId)
+	if err != nil {
+		s.logger.Errorf("could not mark media %v as watched for user %v: %v", mediaId.String(), userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrMarkWatched})
+		return
+	}
+
+	c.JSON(http.StatusOK, (&dto.ProgressDTO{}).FromModel(*prog))
+}
+
+func (s *server) markMediaUnwatched(c *gin.Context) {
+	mediaId, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if !s.canAccessMedia(c, mediaId) {
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.Media().MarkUnwatched(mediaId, *userId); err != nil {
+		s.logger.Errorf("could not mark media %v as unwatched for user %v: %v", mediaId.String(), userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrMarkUnwatched})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *server) getContinueWatching(c *gin.Context) {
+	var page dto.PageRequestDTO
+	if err := c.ShouldBindQuery(&page); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	page.Defaults(MEDIA_SEARCH_DEFAULT.PageRequestDTO)
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	result, err := s.service.Media().ContinueWatching(*userId, page)
+	if err != nil {
+		s.logger.Errorf("could not get media for user %v to continue watching: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrContinueWatching})
+		return
+	}
+
+	dtos := make([]dto.MediaOverviewDTO, len(result.Data))
+	for i, m := range result.Data {
+		dtos[i] = *(&dto.MediaOverviewDTO{}).FromModel(m)
+	}
+
+	c.JSON(http.StatusOK, dto.DataToPage(dtos, *result))
+}
+
 func (s *server) removeMediaFavourite(c *gin.Context) {
 	userId, err := s.getUserId(c)
 	if err != nil {
@@ -46,6 +175,10 @@ func (s *server) removeMediaFavourite(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, mediaId) {
+		return
+	}
+
 	if err := s.repo.User().RemoveFavourite(*userId, mediaId); err != nil {
 		s.logger.Errorf("could not remove media %v from your favourites of user %v: %v", mediaId.String(), userId.String(), err.Error())
 		c.AbortWithStatus(http.StatusInternalServerError)
@@ -68,6 +201,10 @@ func (s *server) addMediaToFavourite(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, mediaId) {
+		return
+	}
+
 	if err := s.service.User().AddMediaToFavourites(*userId, mediaId); err != nil {
 		s.logger.Errorf("could not add media %v to favourites of user %v: %v", mediaId.String(), userId.String(), err.Error())
 		c.AbortWithStatus(http.StatusInternalServerError)
@@ -86,7 +223,12 @@ func (s *server) CreateUser(c *gin.Context) {
 		return
 	}
 
-	user, err := s.service.User().Create(newUser.Username, newUser.Password)
+	role := model.UserRoleEnum_Viewer
+	if newUser.Role != nil {
+		role = *newUser.Role
+	}
+
+	user, err := s.service.User().Create(newUser.Username, newUser.Password, role)
 	if err != nil {
 		s.logger.Errorf("could not create new user: %v", err.Error())
 		c.JSON(http.StatusBadRequest, gin.H{"error": ErrCreateUser})
@@ -96,6 +238,17 @@ func (s *server) CreateUser(c *gin.Context) {
 	c.JSON(http.StatusCreated, user)
 }
 
+func (s *server) getMe(c *gin.Context) {
+	user, err := s.getCurrentUser(c)
+	if err != nil {
+		s.logger.Errorf("could not get current user: %v", err.Error())
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized})
+		return
+	}
+
+	c.JSON(http.StatusOK, (&dto.UserDTO{}).FromModel(*user))
+}
+
 const ErrUpdatePassword string = "could not update password"
 const OkPasswordUpdate string = "password updated"
 
@@ -106,16 +259,14 @@ func (s *server) UpdatePassword(c *gin.Context) {
 		return
 	}
 
-	session := sessions.Default(c)
-	user := session.Get(userKey).(string)
-	id, err := uuid.Parse(user)
+	userId, err := s.getUserId(c)
 	if err != nil {
-		s.logger.Errorf("could not parse user id (%v): %v", user, err.Error())
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session"})
 		return
 	}
+	id := *userId
 
-	if err := s.service.User().UpdatePassword(id, model); err != nil {
+	if err := s.service.User().UpdatePassword(id, model, getSessionId(c)); err != nil {
 		s.logger.Errorf("error updating password for %v: %v", id.String(), err.Error())
 		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrUpdatePassword})
 		return
@@ -123,3 +274,280 @@ func (s *server) UpdatePassword(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": OkPasswordUpdate})
 }
+
+const (
+	ErrGetApiTokens     ApiError = "could not get api tokens"
+	ErrCreateApiToken   ApiError = "could not create api token"
+	ErrRevokeApiToken   ApiError = "could not revoke api token"
+	ErrApiTokenNotFound ApiError = "api token not found"
+)
+
+func (s *server) getApiTokens(c *gin.Context) {
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	tokens, err := s.service.User().GetApiTokens(*userId)
+	if err != nil {
+		s.logger.Errorf("could not get api tokens of user %v: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetApiTokens})
+		return
+	}
+
+	dtos := make([]dto.ApiTokenDTO, len(tokens))
+	for i, t := range tokens {
+		dtos[i] = *(&dto.ApiTokenDTO{}).FromModel(t)
+	}
+
+	c.JSON(http.StatusOK, dtos)
+}
+
+func (s *server) createApiToken(c *gin.Context) {
+	var body dto.CreateApiTokenDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	// a scoped token can not be used to create a token with more permissions than it has itself
+	if scope, ok := c.Get(tokenScopeKey); ok {
+		capped := scope.(model.UserRoleEnum)
+		if body.Scope != nil {
+			capped = dto.LeastPrivileged(*body.Scope, capped)
+		}
+		body.Scope = &capped
+	}
+
+	created, token, err := s.service.User().CreateApiToken(*userId, body)
+	if err != nil {
+		if errors.Is(err, userService.ErrApiTokenExpiryPast) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		s.logger.Errorf("could not create api token for user %v: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrCreateApiToken})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreatedApiTokenDTO{
+		ApiTokenDTO: *(&dto.ApiTokenDTO{}).FromModel(*created),
+		Token:       token,
+	})
+}
+
+func (s *server) revokeApiToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.User().RevokeApiToken(*userId, id); err != nil {
+		if errors.Is(err, userService.ErrApiTokenNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrApiTokenNotFound})
+			return
+		}
+		s.logger.Errorf("could not revoke api token %v of user %v: %v", id.String(), userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrRevokeApiToken})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+const (
+	ErrGetSessions     ApiError = "could not get sessions"
+	ErrRevokeSession   ApiError = "could not revoke session"
+	ErrRevokeSessions  ApiError = "could not revoke sessions"
+	ErrSessionNotFound ApiError = "session not found"
+)
+
+func (s *server) getSessions(c *gin.Context) {
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	sessions, err := s.service.User().GetSessions(*userId)
+	if err != nil {
+		s.logger.Errorf("could not get sessions of user %v: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetSessions})
+		return
+	}
+
+	current := getSessionId(c)
+	dtos := make([]dto.SessionDTO, len(sessions))
+	for i, m := range sessions {
+		dtos[i] = *(&dto.SessionDTO{}).FromModel(m, current)
+	}
+
+	c.JSON(http.StatusOK, dtos)
+}
+
+func (s *server) revokeSession(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.User().RevokeSession(*userId, id); err != nil {
+		if errors.Is(err, userService.ErrSessionNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrSessionNotFound})
+			return
+		}
+		s.logger.Errorf("could not revoke session %v of user %v: %v", id.String(), userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrRevokeSession})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// revokeOtherSessions signs the user out everywhere except for the session that made the request
+func (s *server) revokeOtherSessions(c *gin.Context) {
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.User().RevokeSessions(*userId, getSessionId(c)); err != nil {
+		s.logger.Errorf("could not revoke sessions of user %v: %v", userId.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrRevokeSessions})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+const (
+	ErrGetUsers         ApiError = "could not get users"
+	ErrUpdateUser       ApiError = "could not update user"
+	ErrDeleteUser       ApiError = "could not delete user"
+	ErrSetUserPassword  ApiError = "could not set password of user"
+	ErrUsernameTaken    ApiError = "username is already taken"
+	ErrCannotManageSelf ApiError = "you can not deactivate, demote or delete yourself"
+)
+
+func (s *server) getUsers(c *gin.Context) {
+	users, err := s.service.User().GetAll()
+	if err != nil {
+		s.logger.Errorf("could not get users: %v", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetUsers})
+		return
+	}
+
+	dtos := make([]dto.ManagedUserDTO, len(users))
+	for i, u := range users {
+		dtos[i] = *(&dto.ManagedUserDTO{}).FromModel(u)
+	}
+
+	c.JSON(http.StatusOK, dtos)
+}
+
+func (s *server) updateUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var body dto.UpdateUserDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	actorId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	user, err := s.service.User().Update(*actorId, id, body)
+	if err != nil {
+		s.userManagementError(c, err, ErrUpdateUser)
+		return
+	}
+
+	c.JSON(http.StatusOK, (&dto.ManagedUserDTO{}).FromModel(*user))
+}
+
+func (s *server) setUserPassword(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	var body dto.SetPasswordDTO
+	if err := c.ShouldBindBodyWithJSON(&body); err != nil {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := s.service.User().SetPassword(id, body.Password); err != nil {
+		s.userManagementError(c, err, ErrSetUserPassword)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": OkPasswordUpdate})
+}
+
+func (s *server) deleteUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	actorId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.service.User().Delete(*actorId, id); err != nil {
+		s.userManagementError(c, err, ErrDeleteUser)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// userManagementError responds with the status that matches the error of the user service
+func (s *server) userManagementError(c *gin.Context, err error, fallback ApiError) {
+	switch {
+	case errors.Is(err, userService.ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrUserNotFound})
+	case errors.Is(err, userService.ErrUsernameTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": ErrUsernameTaken})
+	case errors.Is(err, userService.ErrCannotManageSelf):
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrCannotManageSelf})
+	default:
+		s.logger.Errorf("%v: %v", fallback, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/assert"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
+	userService "github.com/slugger7/exorcist/apps/server/internal/service/user"
 	"go.uber.org/mock/gomock"
 )
 
@@ -36,8 +38,8 @@ func Test_Create_ServiceReturnsError(t *testing.T) {
 	}
 
 	s.mockUserService.EXPECT().
-		Create(gomock.Eq(u.Username), gomock.Eq(u.Password)).
-		DoAndReturn(func(string, string) (*model.User, error) {
+		Create(gomock.Eq(u.Username), gomock.Eq(u.Password), gomock.Eq(model.UserRoleEnum_Viewer)).
+		DoAndReturn(func(string, string, model.UserRoleEnum) (*model.User, error) {
 			return nil, fmt.Errorf("some error")
 		}).
 		Times(1)
@@ -65,8 +67,8 @@ func Test_Create_Success(t *testing.T) {
 	}
 
 	s.mockUserService.EXPECT().
-		Create(gomock.Eq(nu.Username), gomock.Eq(nu.Password)).
-		DoAndReturn(func(string, string) (*model.User, error) {
+		Create(gomock.Eq(nu.Username), gomock.Eq(nu.Password), gomock.Eq(model.UserRoleEnum_Viewer)).
+		DoAndReturn(func(string, string, model.UserRoleEnum) (*model.User, error) {
 			return m, nil
 		}).
 		Times(1)
@@ -105,8 +107,8 @@ func Test_UpdatePassword_ServiceReturnsError(t *testing.T) {
 	id, _ := uuid.NewRandom()
 
 	s.mockUserService.EXPECT().
-		UpdatePassword(gomock.Eq(id), gomock.Eq(rpm)).
-		DoAndReturn(func(uuid.UUID, dto.ResetPasswordDTO) error {
+		UpdatePassword(gomock.Eq(id), gomock.Eq(rpm), gomock.Nil()).
+		DoAndReturn(func(uuid.UUID, dto.ResetPasswordDTO, *uuid.UUID) error {
 			return fmt.Errorf("some error")
 		}).
 		Times(1)
@@ -134,8 +136,8 @@ func Test_UpdatePasswrod_ServiceSucceeds(t *testing.T) {
 	id, _ := uuid.NewRandom()
 
 	s.mockUserService.EXPECT().
-		UpdatePassword(gomock.Eq(id), gomock.Eq(rpm)).
-		DoAndReturn(func(uuid.UUID, dto.ResetPasswordDTO) error {
+		UpdatePassword(gomock.Eq(id), gomock.Eq(rpm), gomock.Nil()).
+		DoAndReturn(func(uuid.UUID, dto.ResetPasswordDTO, *uuid.UUID) error {
 			return nil
 		}).
 		Times(1)
@@ -148,3 +150,253 @@ func Test_UpdatePasswrod_ServiceSucceeds(t *testing.T) {
 	assert.StatusCode(t, http.StatusOK, rr.Code)
 	assert.Body(t, fmt.Sprintf(`{"message":"%v"}`, OkPasswordUpdate), rr.Body.String())
 }
+
+func Test_GetMe_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id, _ := uuid.NewRandom()
+	s.mockUserService.EXPECT().
+		GetById(gomock.Eq(id)).
+		DoAndReturn(func(uuid.UUID) (*model.User, error) {
+			return &model.User{ID: id, Username: "someUsername", Active: true, Role: model.UserRoleEnum_Editor}, nil
+		}).
+		Times(1)
+
+	s.server.withUserGetMe(s.authGroup, "/users")
+	rr := s.withAuthGetRequest("users/me").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	expectedBody := fmt.Sprintf(`{"id":"%v","username":"someUsername","role":"editor","permissions":["edit_media","run_jobs"],"mustChangePassword":false}`, id.String())
+	assert.Body(t, expectedBody, rr.Body.String())
+}
+
+func Test_GetSessions_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id, sessionId := uuid.New(), uuid.New()
+	agent := "Firefox"
+	seen := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.mockUserService.EXPECT().
+		GetSessions(gomock.Eq(id)).
+		Return([]model.UserSession{{ID: sessionId, UserID: id, UserAgent: &agent, LastSeen: seen, Created: seen, Expires: seen}}, nil).
+		Times(1)
+
+	s.server.withUserSessions(s.authGroup, "/users")
+	rr := s.withAuthGetRequest("users/sessions").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	expectedBody := fmt.Sprintf(`[{"id":"%v","userAgent":"Firefox","lastSeen":"2026-10-18T09:00:00Z","created":"2026-10-18T09:00:00Z","expires":"2026-10-18T09:00:00Z","current":false}]`, sessionId.String())
+	assert.Body(t, expectedBody, rr.Body.String())
+}
+
+func Test_RevokeSession_NotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id, sessionId := uuid.New(), uuid.New()
+	s.mockUserService.EXPECT().
+		RevokeSession(gomock.Eq(id), gomock.Eq(sessionId)).
+		Return(userService.ErrSessionNotFound).
+		Times(1)
+
+	s.server.withUserSessions(s.authGroup, "/users")
+	rr := s.withAuthDeleteRequest(fmt.Sprintf("users/sessions/%v", sessionId.String())).
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusNotFound, rr.Code)
+	assert.Body(t, errBody(ErrSessionNotFound), rr.Body.String())
+}
+
+func Test_RevokeOtherSessions_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id := uuid.New()
+	s.mockUserService.EXPECT().
+		RevokeSessions(gomock.Eq(id), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	s.server.withUserSessions(s.authGroup, "/users")
+	rr := s.withAuthDeleteRequest("users/sessions").
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+}
+
+func Test_GetUsers_Success(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id := uuid.New()
+	created := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	s.mockUserService.EXPECT().
+		GetAll().
+		Return([]model.User{{ID: id, Username: "someone", Role: model.UserRoleEnum_Viewer, Active: false, Created: created, Modified: created}}, nil).
+		Times(1)
+
+	s.server.withUserManagement(s.authGroup, "/users")
+	rr := s.withAuthGetRequest("users").
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusOK, rr.Code)
+	expectedBody := fmt.Sprintf(`[{"id":"%v","username":"someone","role":"viewer","permissions":[],"mustChangePassword":false,"active":false,"created":"2026-10-18T09:00:00Z","modified":"2026-10-18T09:00:00Z"}]`, id.String())
+	assert.Body(t, expectedBody, rr.Body.String())
+}
+
+func Test_UpdateUser_UsernameTaken(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	actorId, id := uuid.New(), uuid.New()
+	username := "taken"
+	s.mockUserService.EXPECT().
+		Update(gomock.Eq(actorId), gomock.Eq(id), gomock.Eq(dto.UpdateUserDTO{Username: &username})).
+		Return(nil, userService.ErrUsernameTaken).
+		Times(1)
+
+	s.server.withUserManagement(s.authGroup, "/users")
+	rr := s.withAuthPutRequest(body(`{"username":"taken"}`), fmt.Sprintf("users/%v", id.String())).
+		withCookie(TestCookie{Value: actorId}).
+		exec()
+
+	assert.StatusCode(t, http.StatusConflict, rr.Code)
+	assert.Body(t, errBody(ErrUsernameTaken), rr.Body.String())
+}
+
+func Test_SetUserPassword_PasswordsDoNotMatch(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	s.server.withUserManagement(s.authGroup, "/users")
+	rr := s.withAuthPutRequest(body(`{"password":"one","repeatPassword":"two"}`), fmt.Sprintf("users/%v/password", uuid.New().String())).
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	assert.StatusCode(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func Test_DeleteUser_Self(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	id := uuid.New()
+	s.mockUserService.EXPECT().
+		Delete(gomock.Eq(id), gomock.Eq(id)).
+		Return(userService.ErrCannotManageSelf).
+		Times(1)
+
+	s.server.withUserManagement(s.authGroup, "/users")
+	rr := s.withAuthDeleteRequest(fmt.Sprintf("users/%v", id.String())).
+		withCookie(TestCookie{Value: id}).
+		exec()
+
+	assert.StatusCode(t, http.StatusBadRequest, rr.Code)
+	assert.Body(t, errBody(ErrCannotManageSelf), rr.Body.String())
+}
+
+func Test_CreateApiToken_WithScopedApiToken_ShouldNotEscalateScope(t *testing.T) {
+	admin := model.UserRoleEnum_Admin
+	cases := []struct {
+		name      string
+		requested *model.UserRoleEnum
+	}{
+		{"requesting an admin token", &admin},
+		{"requesting an unscoped token", nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := setupServer(t).
+				withAuth().
+				withUserService()
+
+			userId := uuid.New()
+			scope := model.UserRoleEnum_Viewer
+			s.mockUserService.EXPECT().
+				ValidateApiToken(gomock.Eq("exo_viewer")).
+				Return(&model.APIToken{UserID: userId, Scope: &scope}, nil).
+				Times(1)
+			s.mockUserService.EXPECT().
+				CreateApiToken(gomock.Eq(userId), gomock.Any()).
+				DoAndReturn(func(_ uuid.UUID, m dto.CreateApiTokenDTO) (*model.APIToken, string, error) {
+					if m.Scope == nil || *m.Scope != model.UserRoleEnum_Viewer {
+						t.Errorf("expected the token to be limited to the viewer scope but got %v", m.Scope)
+					}
+					return &model.APIToken{UserID: userId, Name: m.Name, Scope: m.Scope}, "exo_new", nil
+				}).
+				Times(1)
+
+			s.server.withUserApiTokens(s.authGroup, "/users")
+			rr := s.withAuthPostRequest(bodyM(dto.CreateApiTokenDTO{Name: "escalate", Scope: c.requested}), "users/tokens").
+				withBearer("exo_viewer").
+				exec()
+
+			assert.StatusCode(t, http.StatusCreated, rr.Code)
+		})
+	}
+}
+
+func Test_CreateApiToken_WithScopedApiToken_ShouldKeepLowerScope(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withUserService()
+
+	userId := uuid.New()
+	scope := model.UserRoleEnum_Editor
+	requested := model.UserRoleEnum_Viewer
+	s.mockUserService.EXPECT().
+		ValidateApiToken(gomock.Eq("exo_editor")).
+		Return(&model.APIToken{UserID: userId, Scope: &scope}, nil).
+		Times(1)
+	s.mockUserService.EXPECT().
+		CreateApiToken(gomock.Eq(userId), gomock.Eq(dto.CreateApiTokenDTO{Name: "viewer", Scope: &requested})).
+		Return(&model.APIToken{UserID: userId, Name: "viewer", Scope: &requested}, "exo_new", nil).
+		Times(1)
+
+	s.server.withUserApiTokens(s.authGroup, "/users")
+	rr := s.withAuthPostRequest(bodyM(dto.CreateApiTokenDTO{Name: "viewer", Scope: &requested}), "users/tokens").
+		withBearer("exo_editor").
+		exec()
+
+	assert.StatusCode(t, http.StatusCreated, rr.Code)
+}
+
+func Test_AddMediaToFavourite_WithInaccessibleMedia(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo()
+
+	s.server.withUserPutFavourite(s.authGroup, "/users")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockMediaRepo.EXPECT().HasAccess(id, userId).Return(false, nil).Times(1)
+
+	rr := s.withAuthPutRequest(nil, "users/favourites/"+id.String()).
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %v but got %v", http.StatusNotFound, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrMediaNotFound) {
+		t.Errorf("expected body %v but got %v", errBody(ErrMediaNotFound), body)
+	}
+}
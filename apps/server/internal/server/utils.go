@@ -1,6 +1,8 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -13,9 +15,14 @@ func createError(e ApiError) map[string]any {
 	return gin.H{"error": e}
 }
 
+// getUserId gets the user of the request from the api token that authenticated it or otherwise from the session
 func (s *server) getUserId(c *gin.Context) (*uuid.UUID, error) {
-	session := sessions.Default(c)
-	userString := session.Get(userKey).(string)
+	userString := c.GetString(userKey)
+	if userString == "" {
+		session := sessions.Default(c)
+		userString, _ = session.Get(userKey).(string)
+	}
+
 	userId, err := uuid.Parse(userString)
 	if err != nil {
 		s.logger.Errorf("could not parse userId from string: %v\n%v", userString, err.Error())
@@ -24,6 +31,41 @@ func (s *server) getUserId(c *gin.Context) (*uuid.UUID, error) {
 	return &userId, err
 }
 
+// getSessionId gets the id of the session of the request. Requests authenticated with an api token have no session.
+func getSessionId(c *gin.Context) *uuid.UUID {
+	id, err := uuid.Parse(sessions.Default(c).ID())
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
+
+const ErrMediaNotFound ApiError = "media not found"
+
+// canAccessMedia responds with not found when the media is in a library that the user has no access to
+func (s *server) canAccessMedia(c *gin.Context, id uuid.UUID) bool {
+	userId, err := s.getUserId(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return false
+	}
+
+	hasAccess, err := s.repo.Media().HasAccess(id, *userId)
+	if err != nil {
+		s.logger.Errorf("could not determine if user %v has access to media %v: %v", userId.String(), id.String(), err.Error())
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return false
+	}
+
+	if !hasAccess {
+		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": ErrMediaNotFound})
+		return false
+	}
+
+	return true
+}
+
 var TRUE bool = true
 var FALSE bool = false
 
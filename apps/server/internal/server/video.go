@@ -1,12 +1,32 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	hlsService "github.com/slugger7/exorcist/apps/server/internal/service/hls"
+)
+
+const (
+	hlsPlaylistContentType string = "application/vnd.apple.mpegurl"
+	hlsSegmentContentType  string = "video/mp2t"
+	hlsRenditionPlaylist   string = "index.m3u8"
+	subtitleContentType    string = "text/vtt"
+	subtitleExtension      string = ".vtt"
+)
+
+const (
+	ErrGetHlsPlaylist   ApiError = "could not create hls playlist"
+	ErrGetHlsSegment    ApiError = "could not create hls segment"
+	ErrHlsNotFound      ApiError = "hls rendition or segment not found"
+	ErrGetSubtitles     ApiError = "could not get subtitles"
+	ErrSubtitleNotFound ApiError = "subtitle track not found"
 )
 
 func (s *server) withVideoGet(r *gin.RouterGroup, route Route) *server {
@@ -14,6 +34,18 @@ func (s *server) withVideoGet(r *gin.RouterGroup, route Route) *server {
 	return s
 }
 
+func (s *server) withVideoHls(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/hls/master.m3u8", route, idKey), s.getVideoHlsMaster)
+	r.GET(fmt.Sprintf("%v/:%v/hls/:%v/:%v", route, idKey, renditionKey, segmentKey), s.getVideoHlsRendition)
+	return s
+}
+
+func (s *server) withVideoSubtitles(r *gin.RouterGroup, route Route) *server {
+	r.GET(fmt.Sprintf("%v/:%v/subtitles", route, idKey), s.getVideoSubtitles)
+	r.GET(fmt.Sprintf("%v/:%v/subtitles/:%v", route, idKey, trackKey), s.getVideoSubtitle)
+	return s
+}
+
 func (s *server) withVideoPut(r *gin.RouterGroup, route Route) *server {
 	r.PUT(fmt.Sprintf("%v/:%v", route, idKey), s.putVideoProgress)
 	return s
@@ -32,6 +64,10 @@ func (s *server) putVideoProgress(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
 	userId, err := s.getUserId(c)
 	if err != nil {
 		c.AbortWithStatus(http.StatusUnauthorized)
@@ -59,6 +95,10 @@ func (s *server) getVideoStream(c *gin.Context) {
 		return
 	}
 
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
 	med, err := s.repo.Video().GetByMediaId(id)
 	if err != nil {
 		s.logger.Errorf("Error getting video absolute path by id: %v", err.Error())
@@ -73,3 +113,137 @@ func (s *server) getVideoStream(c *gin.Context) {
 
 	c.File(med.Path)
 }
+
+func (s *server) getVideoHlsMaster(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
+	playlist, err := s.service.Hls().MasterPlaylist(id)
+	if err != nil {
+		s.hlsServiceError(c, err, ErrGetHlsPlaylist)
+		return
+	}
+
+	c.Data(http.StatusOK, hlsPlaylistContentType, []byte(playlist))
+}
+
+func (s *server) getVideoHlsRendition(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
+	rendition := c.Param(renditionKey)
+	segment := c.Param(segmentKey)
+
+	if segment == hlsRenditionPlaylist {
+		playlist, err := s.service.Hls().RenditionPlaylist(id, rendition)
+		if err != nil {
+			s.hlsServiceError(c, err, ErrGetHlsPlaylist)
+			return
+		}
+
+		c.Data(http.StatusOK, hlsPlaylistContentType, []byte(playlist))
+		return
+	}
+
+	index, err := strconv.Atoi(strings.TrimSuffix(segment, ".ts"))
+	if err != nil || !strings.HasSuffix(segment, ".ts") {
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrHlsNotFound})
+		return
+	}
+
+	segmentPath, err := s.service.Hls().Segment(id, rendition, index)
+	if err != nil {
+		s.hlsServiceError(c, err, ErrGetHlsSegment)
+		return
+	}
+
+	c.Header("Content-Type", hlsSegmentContentType)
+	c.File(segmentPath)
+}
+
+func (s *server) hlsServiceError(c *gin.Context, err error, fallback ApiError) {
+	switch {
+	case errors.Is(err, hlsService.ErrVideoNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrVideoNotFound})
+	case errors.Is(err, hlsService.ErrRenditionNotFound), errors.Is(err, hlsService.ErrSegmentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrHlsNotFound})
+	default:
+		s.logger.Errorf("%v: %v", fallback, err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
+
+func (s *server) getVideoSubtitles(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
+	relations, err := s.repo.Media().GetSubtitlesFor(id)
+	if err != nil {
+		s.logger.Errorf("could not get subtitles for %v: %v", id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetSubtitles})
+		return
+	}
+
+	tracks := []dto.SubtitleTrackDTO{}
+	for _, r := range relations {
+		tracks = append(tracks, *new(dto.SubtitleTrackDTO).FromModel(r))
+	}
+
+	c.JSON(http.StatusOK, tracks)
+}
+
+// getVideoSubtitle serves a track as webvtt. The track is requested as <trackId>.vtt
+func (s *server) getVideoSubtitle(c *gin.Context) {
+	id, err := uuid.Parse(c.Param(idKey))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrInvalidIdFormat})
+		return
+	}
+
+	track := c.Param(trackKey)
+	trackId, err := uuid.Parse(strings.TrimSuffix(track, subtitleExtension))
+	if err != nil || !strings.HasSuffix(track, subtitleExtension) {
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrSubtitleNotFound})
+		return
+	}
+
+	if !s.canAccessMedia(c, id) {
+		return
+	}
+
+	subtitle, err := s.repo.Media().GetSubtitleFor(id, trackId)
+	if err != nil {
+		s.logger.Errorf("could not get subtitle %v for %v: %v", trackId.String(), id.String(), err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"error": ErrGetSubtitles})
+		return
+	}
+
+	if subtitle == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": ErrSubtitleNotFound})
+		return
+	}
+
+	c.Header("Content-Type", subtitleContentType)
+	c.File(subtitle.Path)
+}
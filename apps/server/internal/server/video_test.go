@@ -0,0 +1,128 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	hlsService "github.com/slugger7/exorcist/apps/server/internal/service/hls"
+)
+
+func Test_VideoRoutes_DoNotConflict(t *testing.T) {
+	s := setupServer(t).
+		withAuth()
+
+	s.server.withVideoGet(s.authGroup, "/videos").
+		withVideoHls(s.authGroup, "/videos").
+		withVideoSubtitles(s.authGroup, "/videos").
+		withVideoPut(s.authGroup, "/videos")
+}
+
+func Test_GetVideoSubtitle_InvalidId(t *testing.T) {
+	s := setupServer(t).
+		withAuth()
+
+	s.server.withVideoSubtitles(s.authGroup, "/videos")
+
+	rr := s.withAuthGetRequest("videos/not-an-id/subtitles/" + uuid.NewString() + ".vtt").
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("expected status %v but got %v", http.StatusBadRequest, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrInvalidIdFormat) {
+		t.Errorf("expected body %v but got %v", errBody(ErrInvalidIdFormat), body)
+	}
+}
+
+func Test_GetVideoSubtitle_WithoutVttExtension(t *testing.T) {
+	s := setupServer(t).
+		withAuth()
+
+	s.server.withVideoSubtitles(s.authGroup, "/videos")
+
+	rr := s.withAuthGetRequest("videos/" + uuid.NewString() + "/subtitles/" + uuid.NewString() + ".srt").
+		withCookie(TestCookie{Value: uuid.New()}).
+		exec()
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %v but got %v", http.StatusNotFound, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrSubtitleNotFound) {
+		t.Errorf("expected body %v but got %v", errBody(ErrSubtitleNotFound), body)
+	}
+}
+
+func Test_GetVideoHlsMaster_WithImageMedia_ShouldReturnNotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo().
+		withHlsService()
+
+	s.server.withVideoHls(s.authGroup, "/videos")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockMediaRepo.EXPECT().HasAccess(id, userId).Return(true, nil).Times(1)
+	s.mockHlsService.EXPECT().MasterPlaylist(id).Return("", hlsService.ErrVideoNotFound).Times(1)
+
+	rr := s.withAuthGetRequest("videos/" + id.String() + "/hls/master.m3u8").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %v but got %v", http.StatusNotFound, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrVideoNotFound) {
+		t.Errorf("expected body %v but got %v", errBody(ErrVideoNotFound), body)
+	}
+}
+
+func Test_GetVideoHlsRendition_WithImageMedia_ShouldReturnNotFound(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo().
+		withHlsService()
+
+	s.server.withVideoHls(s.authGroup, "/videos")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockMediaRepo.EXPECT().HasAccess(id, userId).Return(true, nil).Times(2)
+	s.mockHlsService.EXPECT().RenditionPlaylist(id, "720p").Return("", hlsService.ErrVideoNotFound).Times(1)
+	s.mockHlsService.EXPECT().Segment(id, "720p", 0).Return("", hlsService.ErrVideoNotFound).Times(1)
+
+	for _, segment := range []string{"index.m3u8", "0.ts"} {
+		rr := s.withAuthGetRequest("videos/" + id.String() + "/hls/720p/" + segment).
+			withCookie(TestCookie{Value: userId}).
+			exec()
+
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("expected status %v for %v but got %v", http.StatusNotFound, segment, rr.Code)
+		}
+		if body := rr.Body.String(); body != errBody(ErrVideoNotFound) {
+			t.Errorf("expected body %v for %v but got %v", errBody(ErrVideoNotFound), segment, body)
+		}
+	}
+}
+
+func Test_PutVideoProgress_WithInaccessibleMedia(t *testing.T) {
+	s := setupServer(t).
+		withAuth().
+		withRepo()
+
+	s.server.withVideoPut(s.authGroup, "/videos")
+
+	id, userId := uuid.New(), uuid.New()
+	s.mockMediaRepo.EXPECT().HasAccess(id, userId).Return(false, nil).Times(1)
+
+	rr := s.withAuthPutRequest(nil, "videos/"+id.String()+"?progress=10").
+		withCookie(TestCookie{Value: userId}).
+		exec()
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("expected status %v but got %v", http.StatusNotFound, rr.Code)
+	}
+	if body := rr.Body.String(); body != errBody(ErrMediaNotFound) {
+		t.Errorf("expected body %v but got %v", errBody(ErrMediaNotFound), body)
+	}
+}
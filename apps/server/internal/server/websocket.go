@@ -7,7 +7,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
@@ -22,14 +21,8 @@ func (s *server) withWS(r *gin.RouterGroup, route Route) *server {
 }
 
 func (s *server) ws(c *gin.Context) {
-	session := sessions.Default(c)
-	if userRaw, ok := session.Get(userKey).(string); ok {
-		userId, err := uuid.Parse(userRaw)
-		if err != nil {
-			s.logger.Warningf("could not parse user id from string: %v", userRaw)
-			c.AbortWithStatus(http.StatusUnprocessableEntity)
-		}
-
+	// the user comes from the session or from the api token in the authorization header
+	if userId, err := s.getUserId(c); err == nil {
 		upgrader := s.wsUpgrader()
 		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 		if err != nil {
@@ -49,9 +42,9 @@ func (s *server) ws(c *gin.Context) {
 			Conn: conn, Mu: sync.Mutex{},
 		}
 
-		s.wsService.AddWs(userId, &wsConn)
+		s.wsService.AddWs(*userId, &wsConn)
 
-		go s.wsReader(conn, userId)
+		go s.wsReader(conn, *userId)
 	} else {
 		c.AbortWithStatus(http.StatusUnauthorized)
 	}
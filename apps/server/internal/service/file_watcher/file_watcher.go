@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/constants"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
@@ -139,58 +140,16 @@ func (s *watcherService) WithDirectoryWatcher() {
 					}
 					if d.IsDir() {
 						s.addPath(event.Name)
-						videos, err := media.GetFilesByExtensions(event.Name, constants.VideoExtensions[:])
-						if err != nil {
-							s.logger.Errorf("could not scan new paths contents (%v): %v", event.Name, err.Error())
-						} else {
-							for _, v := range videos {
-								if err := job.CreateNewMedia(libPath, nil, v, *s.env, s.repo, s.logger, s.wsService); err != nil {
-									s.logger.Errorf("failed to create new media in watcher (%v): %v", v.Path, err.Error())
-									continue
-								}
-							}
-
-							s.service.Job().StartJobRunner()
-						}
+						s.scanNewDirectory(libPath, event.Name)
 
 						continue
 					}
 
-					ext := strings.ToLower(filepath.Ext(event.Name))
-					if slices.Contains(constants.VideoExtensions[:], ext) {
-						s.logger.Infof("new file created: %v", event.Name)
-
-						m, err := s.repo.Media().GetByPath(event.Name)
-						if err != nil {
-							s.logger.Errorf("could not get media by path(%v): %v", event.Name, err.Error())
-							continue
-						}
-
-						if m != nil {
-							if !m.Deleted && m.Exists {
-								continue
-							}
-						}
-
-						f, err := media.GetFileInformation(event.Name)
-						if err != nil {
-							s.logger.Errorf("could not successfully get file information: %v", err.Error())
-							continue
-						}
-
-						if err := job.CreateNewMedia(libPath, nil, *f, *s.env, s.repo, s.logger, s.wsService); err != nil {
-							s.logger.Errorf("could not create new media from watcher: %v", err.Error())
-							continue
-						}
-
-						s.service.Job().StartJobRunner()
+					if create := mediaCreatorFor(event.Name); create != nil {
+						s.handleNewFile(libPath, event.Name, create)
 
 						continue
 					}
-
-					if slices.Contains(constants.ImageExtensions[:], ext) {
-						// TODO: handle images
-					}
 				}
 
 				if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
@@ -230,6 +189,90 @@ func (s *watcherService) WithDirectoryWatcher() {
 	}()
 }
 
+type createMediaFn func(
+	libPath *model.LibraryPath,
+	jobId *uuid.UUID,
+	f media.File,
+	env environment.EnvironmentVariables,
+	repo repository.Repository,
+	logger logger.Logger,
+	ws websockets.Websockets) error
+
+// mediaCreatorFor returns how a new file is added based on its extension, nil when it is not media
+func mediaCreatorFor(path string) createMediaFn {
+	ext := strings.ToLower(filepath.Ext(path))
+	if slices.Contains(constants.VideoExtensions[:], ext) {
+		return job.CreateNewMedia
+	}
+
+	if slices.Contains(constants.ImageExtensions[:], ext) {
+		return job.CreateNewImageMedia
+	}
+
+	return nil
+}
+
+func (s *watcherService) scanNewDirectory(libPath *model.LibraryPath, path string) {
+	videos, err := media.GetFilesByExtensions(path, constants.VideoExtensions[:])
+	if err != nil {
+		s.logger.Errorf("could not scan new paths videos (%v): %v", path, err.Error())
+	}
+
+	images, err := media.GetFilesByExtensions(path, constants.ImageExtensions[:])
+	if err != nil {
+		s.logger.Errorf("could not scan new paths images (%v): %v", path, err.Error())
+	}
+
+	if len(videos) == 0 && len(images) == 0 {
+		return
+	}
+
+	for _, v := range videos {
+		if err := job.CreateNewMedia(libPath, nil, v, *s.env, s.repo, s.logger, s.wsService); err != nil {
+			s.logger.Errorf("failed to create new media in watcher (%v): %v", v.Path, err.Error())
+			continue
+		}
+	}
+
+	for _, i := range images {
+		if err := job.CreateNewImageMedia(libPath, nil, i, *s.env, s.repo, s.logger, s.wsService); err != nil {
+			s.logger.Errorf("failed to create new image media in watcher (%v): %v", i.Path, err.Error())
+			continue
+		}
+	}
+
+	s.service.Job().StartJobRunner()
+}
+
+func (s *watcherService) handleNewFile(libPath *model.LibraryPath, path string, create createMediaFn) {
+	s.logger.Infof("new file created: %v", path)
+
+	m, err := s.repo.Media().GetByPath(path)
+	if err != nil {
+		s.logger.Errorf("could not get media by path(%v): %v", path, err.Error())
+		return
+	}
+
+	if m != nil {
+		if !m.Deleted && m.Exists {
+			return
+		}
+	}
+
+	f, err := media.GetFileInformation(path)
+	if err != nil {
+		s.logger.Errorf("could not successfully get file information: %v", err.Error())
+		return
+	}
+
+	if err := create(libPath, nil, *f, *s.env, s.repo, s.logger, s.wsService); err != nil {
+		s.logger.Errorf("could not create new media from watcher: %v", err.Error())
+		return
+	}
+
+	s.service.Job().StartJobRunner()
+}
+
 func (s *watcherService) markMediaRemoved(m model.Media) {
 	s.logger.Infof("file removed or renamed: %v", m.Path)
 
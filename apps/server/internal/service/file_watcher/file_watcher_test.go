@@ -0,0 +1,114 @@
+package filewatcher
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/job"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/slugger7/exorcist/apps/server/internal/media"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_mediaRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/media"
+	mock_service "github.com/slugger7/exorcist/apps/server/internal/mock/service"
+	mock_jobService "github.com/slugger7/exorcist/apps/server/internal/mock/service/job"
+	"github.com/slugger7/exorcist/apps/server/internal/repository"
+	mediaRepository "github.com/slugger7/exorcist/apps/server/internal/repository/media"
+	jobService "github.com/slugger7/exorcist/apps/server/internal/service/job"
+	"github.com/slugger7/exorcist/apps/server/internal/websockets"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+type testWatcher struct {
+	svc        *watcherService
+	mediaRepo  *mock_mediaRepository.MockMediaRepository
+	jobService *mock_jobService.MockJobService
+}
+
+func setup(t *testing.T) *testWatcher {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock_repository.NewMockRepository(ctrl)
+	mockMediaRepo := mock_mediaRepository.NewMockMediaRepository(ctrl)
+	mockService := mock_service.NewMockService(ctrl)
+	mockJobService := mock_jobService.NewMockJobService(ctrl)
+
+	mockRepo.EXPECT().
+		Media().DoAndReturn(func() mediaRepository.MediaRepository {
+		return mockMediaRepo
+	}).AnyTimes()
+
+	mockService.EXPECT().
+		Job().DoAndReturn(func() jobService.JobService {
+		return mockJobService
+	}).AnyTimes()
+
+	env := &environment.EnvironmentVariables{LogLevel: "none"}
+	svc := &watcherService{
+		env:     env,
+		logger:  logger.New(env),
+		repo:    mockRepo,
+		service: mockService,
+	}
+
+	return &testWatcher{svc: svc, mediaRepo: mockMediaRepo, jobService: mockJobService}
+}
+
+func samePointer(t *testing.T, expected, actual createMediaFn) {
+	if reflect.ValueOf(expected).Pointer() != reflect.ValueOf(actual).Pointer() {
+		t.Errorf("expected a different media creator to be returned")
+	}
+}
+
+func Test_MediaCreatorFor_Image(t *testing.T) {
+	samePointer(t, job.CreateNewImageMedia, mediaCreatorFor("/library/image.JPG"))
+}
+
+func Test_MediaCreatorFor_Video(t *testing.T) {
+	samePointer(t, job.CreateNewMedia, mediaCreatorFor("/library/movie.mkv"))
+}
+
+func Test_MediaCreatorFor_OtherFile(t *testing.T) {
+	assert.Nil(t, mediaCreatorFor("/library/notes.txt"))
+}
+
+func Test_HandleNewFile_WithNewImage_ShouldCreateMediaAndStartJobs(t *testing.T) {
+	s := setup(t)
+	path := filepath.Join(t.TempDir(), "image.png")
+	assert.Nil(t, os.WriteFile(path, []byte("image"), 0644))
+	libPath := &model.LibraryPath{ID: uuid.New()}
+
+	s.mediaRepo.EXPECT().GetByPath(path).Return(nil, nil).Times(1)
+	s.jobService.EXPECT().StartJobRunner().Times(1)
+
+	var created *media.File
+	s.svc.handleNewFile(libPath, path, func(lp *model.LibraryPath, jobId *uuid.UUID, f media.File, _ environment.EnvironmentVariables, _ repository.Repository, _ logger.Logger, _ websockets.Websockets) error {
+		assert.Equal(t, libPath, lp)
+		assert.Nil(t, jobId)
+		created = &f
+		return nil
+	})
+
+	if assert.NotNil(t, created) {
+		assert.Equal(t, path, created.Path)
+		assert.Equal(t, "image.png", created.FileName)
+		assert.Equal(t, int64(5), created.Size)
+	}
+}
+
+func Test_HandleNewFile_WithExistingImage_ShouldNotCreateMedia(t *testing.T) {
+	s := setup(t)
+	path := filepath.Join(t.TempDir(), "image.png")
+
+	s.mediaRepo.EXPECT().GetByPath(path).Return(&model.Media{Path: path, Exists: true}, nil).Times(1)
+
+	s.svc.handleNewFile(&model.LibraryPath{}, path, func(*model.LibraryPath, *uuid.UUID, media.File, environment.EnvironmentVariables, repository.Repository, logger.Logger, websockets.Websockets) error {
+		t.Error("media should not be created for an existing image")
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"errors"
+	"strings"
+
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+var ErrAliasEmpty = errors.New("alias can not be empty")
+
+// Lookup finds an entity by its name or by one of its aliases. It returns nil when nothing matches.
+type Lookup[T any] func(string) (*T, error)
+
+// PrepareAlias trims the alias and checks that it can be added to the entity that ensureExists looks up.
+// An alias can not be the name or alias of any entity so that it always resolves to a single entity,
+// taken is returned when it is.
+func PrepareAlias[T any](alias string, ensureExists func() error, taken error, getByName, getByAlias Lookup[T]) (string, error) {
+	alias = strings.TrimSpace(alias)
+	if alias == "" {
+		return "", ErrAliasEmpty
+	}
+
+	if err := ensureExists(); err != nil {
+		return "", err
+	}
+
+	existing, err := getByName(alias)
+	if err != nil {
+		return "", errs.BuildError(err, "could not get by name %v", alias)
+	}
+	if existing != nil {
+		return "", taken
+	}
+
+	existing, err = getByAlias(alias)
+	if err != nil {
+		return "", errs.BuildError(err, "could not get by alias %v", alias)
+	}
+	if existing != nil {
+		return "", taken
+	}
+
+	return alias, nil
+}
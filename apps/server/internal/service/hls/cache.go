@@ -0,0 +1,110 @@
+package hlsService
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const partialSuffix string = ".part"
+
+type cachedFile struct {
+	path     string
+	size     int64
+	modified time.Time
+}
+
+func (h *hlsService) evictPeriodically() {
+	ticker := time.NewTicker(evictionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+			h.evict()
+		}
+	}
+}
+
+// evict removes segments that are older than the max age and then the least recently used
+// segments until the cache is under the max size
+func (h *hlsService) evict() {
+	root := filepath.Join(h.env.Cache, HLS_FOLDER_NAME)
+	maxAge := time.Duration(h.env.HlsCacheMaxAge) * time.Minute
+	maxSize := int64(h.env.HlsCacheMaxSize) * 1024 * 1024
+
+	files := []cachedFile{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+
+		files = append(files, cachedFile{path: path, size: info.Size(), modified: info.ModTime()})
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		h.logger.Errorf("could not walk hls cache %v: %v", root, err.Error())
+		return
+	}
+
+	now := time.Now()
+	remaining := []cachedFile{}
+	var total int64
+	for _, f := range files {
+		if strings.HasSuffix(f.path, partialSuffix) {
+			continue // still being transcoded
+		}
+
+		if now.Sub(f.modified) > maxAge {
+			h.removeCachedFile(root, f.path)
+			continue
+		}
+
+		remaining = append(remaining, f)
+		total += f.size
+	}
+
+	if total <= maxSize {
+		return
+	}
+
+	slices.SortFunc(remaining, func(a, b cachedFile) int {
+		return a.modified.Compare(b.modified)
+	})
+
+	for _, f := range remaining {
+		if total <= maxSize {
+			break
+		}
+
+		h.removeCachedFile(root, f.path)
+		total -= f.size
+	}
+}
+
+func (h *hlsService) removeCachedFile(root, path string) {
+	if err := os.Remove(path); err != nil {
+		h.logger.Warningf("could not evict %v from hls cache: %v", path, err.Error())
+		return
+	}
+
+	// clean up rendition and media directories once they are empty
+	for dir := filepath.Dir(path); dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+}
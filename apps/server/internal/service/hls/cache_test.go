@@ -0,0 +1,32 @@
+package hlsService
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Evict_KeepsSegmentsThatAreStillBeingTranscoded(t *testing.T) {
+	env := &environment.EnvironmentVariables{LogLevel: "none", Cache: t.TempDir(), HlsCacheMaxAge: 1, HlsCacheMaxSize: 1}
+	s := &hlsService{env: env, logger: logger.New(env)}
+
+	dir := filepath.Join(env.Cache, HLS_FOLDER_NAME, "media", "720p")
+	assert.NoError(t, os.MkdirAll(dir, 0755))
+
+	old := time.Now().Add(-time.Hour)
+	partial, segment := filepath.Join(dir, "0.ts"+partialSuffix), filepath.Join(dir, "1.ts")
+	for _, p := range []string{partial, segment} {
+		assert.NoError(t, os.WriteFile(p, []byte("segment"), 0644))
+		assert.NoError(t, os.Chtimes(p, old, old))
+	}
+
+	s.evict()
+
+	assert.FileExists(t, partial)
+	assert.NoFileExists(t, segment)
+}
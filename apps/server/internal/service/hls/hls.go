@@ -0,0 +1,245 @@
+package hlsService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/slugger7/exorcist/apps/server/internal/repository"
+	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
+)
+
+const (
+	HLS_FOLDER_NAME  string  = "hls"
+	segmentDuration  float64 = 6
+	evictionInterval         = 5 * time.Minute
+)
+
+var (
+	ErrRenditionNotFound = errors.New("rendition not found")
+	ErrSegmentNotFound   = errors.New("segment not found")
+	ErrVideoNotFound     = errors.New("video not found")
+)
+
+type HlsService interface {
+	MasterPlaylist(mediaId uuid.UUID) (string, error)
+	RenditionPlaylist(mediaId uuid.UUID, rendition string) (string, error)
+	Segment(mediaId uuid.UUID, rendition string, index int) (string, error)
+}
+
+type hlsService struct {
+	env      *environment.EnvironmentVariables
+	repo     repository.Repository
+	logger   logger.Logger
+	ctx      context.Context
+	mu       sync.Mutex
+	inFlight map[string]chan struct{}
+}
+
+// video fetches the video of the media. Media that is not a video, like an image, has no hls stream
+func (h *hlsService) video(mediaId uuid.UUID) (*videoRepository.MediaVideoModel, error) {
+	video, err := h.repo.Video().GetByMediaId(mediaId)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrVideoNotFound
+		}
+		return nil, errs.BuildError(err, "could not get video by media id: %v", mediaId.String())
+	}
+
+	return video, nil
+}
+
+// MasterPlaylist implements HlsService.
+func (h *hlsService) MasterPlaylist(mediaId uuid.UUID) (string, error) {
+	video, err := h.video(mediaId)
+	if err != nil {
+		return "", err
+	}
+
+	return masterPlaylist(int(video.Height), int(video.Width)), nil
+}
+
+// RenditionPlaylist implements HlsService.
+func (h *hlsService) RenditionPlaylist(mediaId uuid.UUID, rendition string) (string, error) {
+	video, err := h.video(mediaId)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := findRendition(int(video.Height), rendition); err != nil {
+		return "", err
+	}
+
+	return renditionPlaylist(video.Runtime), nil
+}
+
+// Segment implements HlsService. It returns the path to the transcoded segment in the cache.
+func (h *hlsService) Segment(mediaId uuid.UUID, rendition string, index int) (string, error) {
+	video, err := h.video(mediaId)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := findRendition(int(video.Height), rendition)
+	if err != nil {
+		return "", err
+	}
+
+	if index < 0 || index >= segmentCount(video.Runtime) {
+		return "", ErrSegmentNotFound
+	}
+
+	segmentPath := filepath.Join(h.env.Cache, HLS_FOLDER_NAME, mediaId.String(), r.Name, fmt.Sprintf("%v.ts", index))
+
+	if err := h.transcodeOnce(segmentPath, video, *r, index); err != nil {
+		return "", err
+	}
+
+	return segmentPath, nil
+}
+
+// transcodeOnce makes sure that concurrent requests for the same segment only transcode it once
+func (h *hlsService) transcodeOnce(segmentPath string, video *videoRepository.MediaVideoModel, r ffmpeg.Rendition, index int) error {
+	for {
+		if _, err := os.Stat(segmentPath); err == nil {
+			now := time.Now()
+			_ = os.Chtimes(segmentPath, now, now) // keeps recently watched segments from being evicted first
+			return nil
+		}
+
+		h.mu.Lock()
+		done, ok := h.inFlight[segmentPath]
+		if !ok {
+			done = make(chan struct{})
+			h.inFlight[segmentPath] = done
+			h.mu.Unlock()
+			break
+		}
+		h.mu.Unlock()
+
+		select {
+		case <-done:
+			continue
+		case <-h.ctx.Done():
+			return fmt.Errorf("shutdown while waiting for segment: %v", segmentPath)
+		}
+	}
+
+	defer func() {
+		h.mu.Lock()
+		close(h.inFlight[segmentPath])
+		delete(h.inFlight, segmentPath)
+		h.mu.Unlock()
+	}()
+
+	if err := os.MkdirAll(filepath.Dir(segmentPath), os.ModePerm); err != nil {
+		return errs.BuildError(err, "could not create hls cache directory for %v", segmentPath)
+	}
+
+	start := float64(index) * segmentDuration
+	partialPath := segmentPath + partialSuffix
+
+	h.logger.Debugf("transcoding segment %v of %v at %v", index, video.Path, r.Name)
+	if err := ffmpeg.TranscodeSegment(ffmpeg.SegmentDto{
+		InputFilePath:  video.Path,
+		OutputFilePath: partialPath,
+		Start:          start,
+		Duration:       math.Min(segmentDuration, video.Runtime-start),
+		Rendition:      r,
+	}); err != nil {
+		return errs.BuildError(err, "could not transcode segment %v for %v", index, video.Path)
+	}
+
+	if err := os.Rename(partialPath, segmentPath); err != nil {
+		return errs.BuildError(err, "could not move transcoded segment into place: %v", segmentPath)
+	}
+
+	return nil
+}
+
+func findRendition(sourceHeight int, name string) (*ffmpeg.Rendition, error) {
+	for _, r := range ffmpeg.RenditionsFor(sourceHeight) {
+		if r.Name == name {
+			return &r, nil
+		}
+	}
+
+	return nil, ErrRenditionNotFound
+}
+
+func segmentCount(runtime float64) int {
+	return int(math.Ceil(runtime / segmentDuration))
+}
+
+func masterPlaylist(sourceHeight, sourceWidth int) string {
+	var sb strings.Builder
+	sb.WriteString("#EXTM3U\n")
+	sb.WriteString("#EXT-X-VERSION:3\n")
+
+	for _, r := range ffmpeg.RenditionsFor(sourceHeight) {
+		width := 0
+		if sourceHeight > 0 {
+			width = ffmpeg.ScaleWidthByHeight(sourceHeight, sourceWidth, r.Height)
+			width += width % 2 // scale=-2 rounds to an even width
+		}
+
+		fmt.Fprintf(&sb, "#EXT-X-STREAM-INF:BANDWIDTH=%v,RESOLUTION=%vx%v,NAME=\"%v\"\n", r.Bandwidth(), width, r.Height, r.Name)
+		fmt.Fprintf(&sb, "%v/index.m3u8\n", r.Name)
+	}
+
+	return sb.String()
+}
+
+func renditionPlaylist(runtime float64) string {
+	var sb strings.Builder
+	sb.WriteString("#EXTM3U\n")
+	sb.WriteString("#EXT-X-VERSION:3\n")
+	sb.WriteString("#EXT-X-PLAYLIST-TYPE:VOD\n")
+	fmt.Fprintf(&sb, "#EXT-X-TARGETDURATION:%v\n", int(segmentDuration))
+	sb.WriteString("#EXT-X-MEDIA-SEQUENCE:0\n")
+
+	count := segmentCount(runtime)
+	for i := range count {
+		duration := math.Min(segmentDuration, runtime-float64(i)*segmentDuration)
+		fmt.Fprintf(&sb, "#EXTINF:%.6f,\n", duration)
+		fmt.Fprintf(&sb, "%v.ts\n", i)
+	}
+
+	sb.WriteString("#EXT-X-ENDLIST\n")
+
+	return sb.String()
+}
+
+var hlsServiceInstance *hlsService
+
+func New(repo repository.Repository, env *environment.EnvironmentVariables, ctx context.Context) HlsService {
+	if hlsServiceInstance != nil {
+		return hlsServiceInstance
+	}
+
+	hlsServiceInstance = &hlsService{
+		env:      env,
+		repo:     repo,
+		logger:   logger.New(env),
+		ctx:      ctx,
+		inFlight: map[string]chan struct{}{},
+	}
+
+	go hlsServiceInstance.evictPeriodically()
+
+	hlsServiceInstance.logger.Info("HlsService instance created")
+
+	return hlsServiceInstance
+}
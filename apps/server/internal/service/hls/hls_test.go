@@ -0,0 +1,110 @@
+package hlsService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_videoRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/video"
+	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_MasterPlaylist_DoesNotUpscale(t *testing.T) {
+	actual := masterPlaylist(720, 1280)
+
+	expected := `#EXTM3U
+#EXT-X-VERSION:3
+#EXT-X-STREAM-INF:BANDWIDTH=2928000,RESOLUTION=1280x720,NAME="720p"
+720p/index.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=1528000,RESOLUTION=854x480,NAME="480p"
+480p/index.m3u8
+#EXT-X-STREAM-INF:BANDWIDTH=896000,RESOLUTION=640x360,NAME="360p"
+360p/index.m3u8
+`
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_MasterPlaylist_SmallSourceStillHasARendition(t *testing.T) {
+	actual := masterPlaylist(240, 320)
+
+	expected := `#EXTM3U
+#EXT-X-VERSION:3
+#EXT-X-STREAM-INF:BANDWIDTH=896000,RESOLUTION=480x360,NAME="360p"
+360p/index.m3u8
+`
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_RenditionPlaylist_LastSegmentIsShorter(t *testing.T) {
+	actual := renditionPlaylist(14.5)
+
+	expected := `#EXTM3U
+#EXT-X-VERSION:3
+#EXT-X-PLAYLIST-TYPE:VOD
+#EXT-X-TARGETDURATION:6
+#EXT-X-MEDIA-SEQUENCE:0
+#EXTINF:6.000000,
+0.ts
+#EXTINF:6.000000,
+1.ts
+#EXTINF:2.500000,
+2.ts
+#EXT-X-ENDLIST
+`
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_FindRendition_NotAvailableForSource(t *testing.T) {
+	_, err := findRendition(480, "1080p")
+
+	assert.ErrorIs(t, err, ErrRenditionNotFound)
+}
+
+func withVideoRepo(t *testing.T) (*hlsService, *mock_videoRepository.MockVideoRepository) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mock_repository.NewMockRepository(ctrl)
+	mockVideoRepo := mock_videoRepository.NewMockVideoRepository(ctrl)
+
+	mockRepo.EXPECT().
+		Video().DoAndReturn(func() videoRepository.VideoRepository {
+		return mockVideoRepo
+	}).AnyTimes()
+
+	return &hlsService{repo: mockRepo}, mockVideoRepo
+}
+
+func Test_HlsService_WithMediaThatIsNotAVideo_ShouldReturnVideoNotFound(t *testing.T) {
+	id := uuid.New()
+	noRows := errs.BuildError(qrm.ErrNoRows, "could not get video by media id: %v", id)
+
+	s, videoRepo := withVideoRepo(t)
+	videoRepo.EXPECT().GetByMediaId(id).Return(nil, noRows).Times(3)
+
+	_, err := s.MasterPlaylist(id)
+	assert.ErrorIs(t, err, ErrVideoNotFound)
+
+	_, err = s.RenditionPlaylist(id, "720p")
+	assert.ErrorIs(t, err, ErrVideoNotFound)
+
+	_, err = s.Segment(id, "720p", 0)
+	assert.ErrorIs(t, err, ErrVideoNotFound)
+}
+
+func Test_HlsService_WithRepositoryFailure_ShouldNotReturnVideoNotFound(t *testing.T) {
+	id := uuid.New()
+
+	s, videoRepo := withVideoRepo(t)
+	videoRepo.EXPECT().GetByMediaId(id).Return(nil, errors.New("connection refused")).Times(1)
+
+	_, err := s.MasterPlaylist(id)
+	assert.NotNil(t, err)
+	assert.NotErrorIs(t, err, ErrVideoNotFound)
+}
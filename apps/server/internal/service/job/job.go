@@ -3,6 +3,7 @@ package jobService
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -23,26 +24,41 @@ import (
 type JobService interface {
 	Create(dto.CreateJobDTO) (*model.Job, error)
 	StartJobRunner()
+	Cancel(id uuid.UUID) (*model.Job, error)
+	Retry(id uuid.UUID) (*model.Job, error)
+	Delete(dto.JobSearchDTO) ([]model.Job, error)
+	CreateSchedule(dto.CreateJobScheduleDTO) (*model.JobSchedule, error)
+	UpdateSchedule(id uuid.UUID, m dto.CreateJobScheduleDTO) (*model.JobSchedule, error)
+	DeleteSchedule(id uuid.UUID) error
+	RunSchedule(schedule model.JobSchedule, now time.Time) (*model.Job, error)
 }
 
+var (
+	ErrJobNotFound       = errors.New("job not found")
+	ErrJobNotCancellable = errors.New("job has already finished")
+	ErrJobNotRetryable   = errors.New("only failed or cancelled jobs can be retried")
+)
+
 type jobService struct {
 	env          *environment.EnvironmentVariables
 	repo         repository.Repository
 	logger       logger.Logger
 	jobCh        chan bool
+	cancelCh     chan uuid.UUID
 	ctx          context.Context
 	mediaService mediaService.MediaService
 }
 
 var jobServiceInstance *jobService
 
-func New(repo repository.Repository, env *environment.EnvironmentVariables, jobCh chan bool, ctx context.Context, mediaService mediaService.MediaService) JobService {
+func New(repo repository.Repository, env *environment.EnvironmentVariables, jobCh chan bool, cancelCh chan uuid.UUID, ctx context.Context, mediaService mediaService.MediaService) JobService {
 	if jobServiceInstance == nil {
 		jobServiceInstance = &jobService{
 			env:          env,
 			repo:         repo,
 			logger:       logger.New(env),
 			jobCh:        jobCh,
+			cancelCh:     cancelCh,
 			ctx:          ctx,
 			mediaService: mediaService,
 		}
@@ -53,6 +69,34 @@ func New(repo repository.Repository, env *environment.EnvironmentVariables, jobC
 }
 
 func (s *jobService) Create(m dto.CreateJobDTO) (*model.Job, error) {
+	j, err := s.jobFromDto(m)
+	if err != nil {
+		return nil, errs.BuildError(err, "error encountered while creating job")
+	}
+
+	job := model.Job{
+		JobType:  m.Type,
+		Status:   model.JobStatusEnum_NotStarted,
+		Data:     j.Data,
+		Priority: j.Priority,
+	}
+
+	jobs, err := s.repo.Job().CreateAll([]model.Job{job})
+	if err != nil {
+		return nil, errs.BuildError(err, "creating job")
+	}
+
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("no jobs were returned after creating a job")
+	}
+
+	go s.StartJobRunner()
+
+	return &jobs[0], nil
+}
+
+// jobFromDto validates the data of the job type and builds the data and priority of the job
+func (s *jobService) jobFromDto(m dto.CreateJobDTO) (*model.Job, error) {
 	defaultJobPriority := dto.JobPriority_Medium
 	if m.Priority == nil {
 		m.Priority = &(defaultJobPriority)
@@ -77,32 +121,20 @@ func (s *jobService) Create(m dto.CreateJobDTO) (*model.Job, error) {
 		j, e = s.generateChapters(strData, *m.Priority)
 	case model.JobTypeEnum_Convert:
 		j, e = s.convert(strData, *m.Priority)
+	case model.JobTypeEnum_ScanLibrary:
+		j, e = s.scanLibrary(strData, *m.Priority)
+	case model.JobTypeEnum_GenerateLibraryChapters:
+		j, e = s.generateLibraryChapters(strData, *m.Priority)
+	case model.JobTypeEnum_ExtractSubtitles:
+		j, e = s.extractSubtitles(strData, *m.Priority)
 	default:
 		return nil, fmt.Errorf("job type not implemented: %v", m.Type)
 	}
 	if e != nil {
-		return nil, errs.BuildError(err, "error encountered while creating job")
-	}
-
-	job := model.Job{
-		JobType:  m.Type,
-		Status:   model.JobStatusEnum_NotStarted,
-		Data:     j.Data,
-		Priority: j.Priority,
-	}
-
-	jobs, err := s.repo.Job().CreateAll([]model.Job{job})
-	if err != nil {
-		return nil, errs.BuildError(err, "creating job")
-	}
-
-	if len(jobs) == 0 {
-		return nil, fmt.Errorf("no jobs were returned after creating a job")
+		return nil, e
 	}
 
-	go s.StartJobRunner()
-
-	return &jobs[0], nil
+	return j, nil
 }
 
 func (i *jobService) convert(data string, priority int16) (*model.Job, error) {
@@ -202,6 +234,63 @@ func (i *jobService) generateChapters(data string, priority int16) (*model.Job,
 	}, nil
 }
 
+func (i *jobService) extractSubtitles(data string, priority int16) (*model.Job, error) {
+	var jobData dto.ExtractSubtitlesData
+	if err := json.Unmarshal([]byte(data), &jobData); err != nil {
+		return nil, errs.BuildError(err, "unmarshalling data for extract subtitles: %v", data)
+	}
+
+	media, err := i.repo.Media().GetById(jobData.MediaId)
+	if err != nil {
+		return nil, errs.BuildError(err, "getting media by id: %v", jobData.MediaId.String())
+	}
+
+	if media == nil {
+		return nil, fmt.Errorf("no media with id: %v", jobData.MediaId.String())
+	}
+
+	if media.Video == nil {
+		return nil, fmt.Errorf("media is not of type video: %v", jobData.MediaId.String())
+	}
+
+	return &model.Job{
+		Data:     &data,
+		Priority: priority,
+	}, nil
+}
+
+func (i *jobService) generateLibraryChapters(data string, priority int16) (*model.Job, error) {
+	var jobData dto.GenerateLibraryChaptersData
+	if err := json.Unmarshal([]byte(data), &jobData); err != nil {
+		return nil, errs.BuildError(err, "unmarshalling data for generate library chapters: %v", data)
+	}
+
+	if jobData.Interval == 0 {
+		jobData.Interval = float64(((time.Minute * 5).Seconds()))
+	}
+
+	library, err := i.repo.Library().GetById(jobData.LibraryId)
+	if err != nil {
+		return nil, errs.BuildError(err, "getting library by id: %v", jobData.LibraryId.String())
+	}
+
+	if library == nil {
+		return nil, fmt.Errorf("no library found with id: %v", jobData.LibraryId.String())
+	}
+
+	bytes, err := json.Marshal(jobData)
+	if err != nil {
+		return nil, errs.BuildError(err, "remarshalling generate library chapters")
+	}
+
+	data = string(bytes)
+
+	return &model.Job{
+		Data:     &data,
+		Priority: priority,
+	}, nil
+}
+
 func (i *jobService) refreshLibraryMetadata(data string, priority int16) (*model.Job, error) {
 	var jobData dto.RefreshLibraryMetadata
 	if err := json.Unmarshal([]byte(data), &jobData); err != nil {
@@ -210,8 +299,9 @@ func (i *jobService) refreshLibraryMetadata(data string, priority int16) (*model
 
 	if jobData.RefreshFields == nil {
 		jobData.RefreshFields = &dto.RefreshFields{
-			Size:     true,
-			Checksum: false,
+			Size:          true,
+			Checksum:      false,
+			VideoMetadata: true,
 		}
 	}
 
@@ -387,6 +477,27 @@ func (i *jobService) scanPath(data string, priority int16) (*model.Job, error) {
 	}, nil
 }
 
+func (i *jobService) scanLibrary(data string, priority int16) (*model.Job, error) {
+	var scanLibraryData dto.ScanLibraryData
+	if err := json.Unmarshal([]byte(data), &scanLibraryData); err != nil {
+		return nil, errs.BuildError(err, "could not unmarshall data for job %v", data)
+	}
+
+	library, err := i.repo.Library().GetById(scanLibraryData.LibraryId)
+	if err != nil {
+		return nil, errs.BuildError(err, "getting library by id: %v", scanLibraryData.LibraryId.String())
+	}
+
+	if library == nil {
+		return nil, fmt.Errorf("no library found with id: %v", scanLibraryData.LibraryId.String())
+	}
+
+	return &model.Job{
+		Data:     &data,
+		Priority: priority,
+	}, nil
+}
+
 // We do this at the moment to stack a signal to the job runner if it is already running
 func (i *jobService) StartJobRunner() {
 	i.logger.Debug("Starting a job runner")
@@ -402,3 +513,88 @@ func (i *jobService) StartJobRunner() {
 		}
 	}
 }
+
+// Cancel implements JobService. Jobs that have not started are cancelled immediately.
+// Jobs that are in progress are cancelled by the job runner which updates the job once it has stopped.
+// Jobs that are waiting for their children are cancelled by the job runner along with the children that have not started.
+func (i *jobService) Cancel(id uuid.UUID) (*model.Job, error) {
+	job, err := i.repo.Job().GetById(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get job by id: %v", id.String())
+	}
+
+	if job == nil {
+		return nil, ErrJobNotFound
+	}
+
+	if job.Status == model.JobStatusEnum_NotStarted {
+		cancelled, err := i.repo.Job().CancelNotStarted(id)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not cancel job: %v", id.String())
+		}
+
+		if cancelled != nil {
+			return cancelled, nil
+		}
+
+		// the job runner picked up the job in the mean time
+		job.Status = model.JobStatusEnum_InProgress
+	}
+
+	if job.Status != model.JobStatusEnum_InProgress {
+		return nil, ErrJobNotCancellable
+	}
+
+	if i.cancelCh == nil {
+		return nil, fmt.Errorf("job runner is not running in this instance. could not cancel job: %v", id.String())
+	}
+
+	select {
+	case <-i.ctx.Done():
+		return nil, fmt.Errorf("shutdown signal received while cancelling job: %v", id.String())
+	case i.cancelCh <- id:
+		i.logger.Debugf("Cancel signal sent for job %v", id.String())
+	}
+
+	return job, nil
+}
+
+// Retry implements JobService.
+func (i *jobService) Retry(id uuid.UUID) (*model.Job, error) {
+	job, err := i.repo.Job().GetById(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get job by id: %v", id.String())
+	}
+
+	if job == nil {
+		return nil, ErrJobNotFound
+	}
+
+	if job.Status != model.JobStatusEnum_Failed && job.Status != model.JobStatusEnum_Cancelled {
+		return nil, ErrJobNotRetryable
+	}
+
+	job.Status = model.JobStatusEnum_NotStarted
+	job.Outcome = nil
+	job.Progress = 0
+	job.Step = nil
+	if err := i.repo.Job().UpdateJobStatus(job); err != nil {
+		return nil, errs.BuildError(err, "could not requeue job: %v", id.String())
+	}
+
+	go i.StartJobRunner()
+
+	return job, nil
+}
+
+// Delete implements JobService.
+func (i *jobService) Delete(search dto.JobSearchDTO) ([]model.Job, error) {
+	jobs, err := i.repo.Job().DeleteAll(search)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not delete jobs")
+	}
+
+	i.logger.Infof("Deleted %v jobs", len(jobs))
+
+	return jobs, nil
+}
@@ -0,0 +1,180 @@
+package jobService
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/cron"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+var (
+	ErrJobScheduleNotFound = errors.New("job schedule not found")
+	ErrInvalidJobSchedule  = errors.New("invalid job schedule")
+)
+
+// CreateSchedule implements JobService.
+func (i *jobService) CreateSchedule(m dto.CreateJobScheduleDTO) (*model.JobSchedule, error) {
+	schedule, err := i.scheduleFromDto(m)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := i.repo.JobSchedule().Create(*schedule)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not create job schedule")
+	}
+
+	return created, nil
+}
+
+// UpdateSchedule implements JobService.
+func (i *jobService) UpdateSchedule(id uuid.UUID, m dto.CreateJobScheduleDTO) (*model.JobSchedule, error) {
+	existing, err := i.repo.JobSchedule().GetById(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get job schedule by id: %v", id.String())
+	}
+
+	if existing == nil {
+		return nil, ErrJobScheduleNotFound
+	}
+
+	schedule, err := i.scheduleFromDto(m)
+	if err != nil {
+		return nil, err
+	}
+	schedule.ID = id
+
+	updated, err := i.repo.JobSchedule().Update(*schedule)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not update job schedule: %v", id.String())
+	}
+
+	if updated == nil {
+		return nil, ErrJobScheduleNotFound
+	}
+
+	return updated, nil
+}
+
+// DeleteSchedule implements JobService.
+func (i *jobService) DeleteSchedule(id uuid.UUID) error {
+	existing, err := i.repo.JobSchedule().GetById(id)
+	if err != nil {
+		return errs.BuildError(err, "could not get job schedule by id: %v", id.String())
+	}
+
+	if existing == nil {
+		return ErrJobScheduleNotFound
+	}
+
+	if err := i.repo.JobSchedule().Delete(id); err != nil {
+		return errs.BuildError(err, "could not delete job schedule: %v", id.String())
+	}
+
+	return nil
+}
+
+// RunSchedule implements JobService. It enqueues the job of the schedule and moves the schedule on to its next run.
+// Nothing is enqueued when another scheduler has already run the schedule.
+func (i *jobService) RunSchedule(schedule model.JobSchedule, now time.Time) (*model.Job, error) {
+	c, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not parse cron of job schedule %v", schedule.ID.String())
+	}
+
+	var data map[string]interface{}
+	if schedule.Data != nil {
+		if err := json.Unmarshal([]byte(*schedule.Data), &data); err != nil {
+			return nil, errs.BuildError(err, "could not unmarshal data of job schedule %v", schedule.ID.String())
+		}
+	}
+
+	priority := schedule.Priority
+	j, err := i.jobFromDto(dto.CreateJobDTO{
+		Type:     schedule.JobType,
+		Data:     data,
+		Priority: &priority,
+	})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not create job for job schedule %v", schedule.ID.String())
+	}
+
+	previousNextRun := schedule.NextRun
+	schedule.LastRun = &now
+	schedule.NextRun = nextRun(c, now)
+
+	job, err := i.repo.JobSchedule().Run(schedule, previousNextRun, model.Job{
+		JobType:  schedule.JobType,
+		Status:   model.JobStatusEnum_NotStarted,
+		Data:     j.Data,
+		Priority: j.Priority,
+	})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not run job schedule %v", schedule.ID.String())
+	}
+
+	if job == nil {
+		i.logger.Debugf("Job schedule %v has already been run", schedule.ID.String())
+		return nil, nil
+	}
+
+	go i.StartJobRunner()
+
+	return job, nil
+}
+
+func (i *jobService) scheduleFromDto(m dto.CreateJobScheduleDTO) (*model.JobSchedule, error) {
+	c, err := cron.Parse(m.Cron)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidJobSchedule, err)
+	}
+
+	if _, err := i.jobFromDto(dto.CreateJobDTO{Type: m.Type, Data: m.Data, Priority: m.Priority}); err != nil {
+		return nil, errors.Join(ErrInvalidJobSchedule, err)
+	}
+
+	schedule := model.JobSchedule{
+		Name:     m.Name,
+		Cron:     m.Cron,
+		JobType:  m.Type,
+		Priority: dto.JobPriority_Medium,
+		Enabled:  true,
+	}
+
+	if m.Priority != nil {
+		schedule.Priority = *m.Priority
+	}
+
+	if m.Enabled != nil {
+		schedule.Enabled = *m.Enabled
+	}
+
+	if m.Data != nil {
+		data, err := json.Marshal(m.Data)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not marshal job schedule data")
+		}
+		strData := string(data)
+		schedule.Data = &strData
+	}
+
+	if schedule.Enabled {
+		schedule.NextRun = nextRun(c, time.Now())
+	}
+
+	return &schedule, nil
+}
+
+func nextRun(c *cron.Schedule, from time.Time) *time.Time {
+	next := c.Next(from)
+	if next.IsZero() {
+		return nil
+	}
+
+	return &next
+}
@@ -1,8 +1,10 @@
 package libraryService
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/go-jet/jet/v2/qrm"
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
@@ -13,10 +15,18 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/repository"
 )
 
+var (
+	ErrLibraryNotFound = errors.New("library not found")
+	ErrUserNotFound    = errors.New("user not found")
+)
+
 type LibraryService interface {
 	Create(newLibrary *model.Library) (*model.Library, error)
-	GetAll() ([]model.Library, error)
+	GetAll(userId uuid.UUID) ([]model.Library, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
+	GetUsers(id uuid.UUID) ([]model.LibraryUser, error)
+	GrantAccess(id, userId uuid.UUID) error
+	RevokeAccess(id, userId uuid.UUID) error
 }
 
 type libraryService struct {
@@ -44,6 +54,66 @@ func (i *libraryService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDT
 	return media, nil
 }
 
+// GetUsers implements LibraryService.
+func (i *libraryService) GetUsers(id uuid.UUID) ([]model.LibraryUser, error) {
+	if err := i.libraryExists(id); err != nil {
+		return nil, err
+	}
+
+	users, err := i.repo.Library().GetUsers(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get users of library %v", id.String())
+	}
+
+	return users, nil
+}
+
+// GrantAccess implements LibraryService.
+func (i *libraryService) GrantAccess(id, userId uuid.UUID) error {
+	if err := i.libraryExists(id); err != nil {
+		return err
+	}
+
+	user, err := i.repo.User().GetById(userId)
+	if err != nil {
+		return errs.BuildError(err, "could not get user by id: %v", userId.String())
+	}
+
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	if err := i.repo.Library().AddUser(id, userId); err != nil {
+		return errs.BuildError(err, "could not grant user %v access to library %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
+// RevokeAccess implements LibraryService.
+func (i *libraryService) RevokeAccess(id, userId uuid.UUID) error {
+	if err := i.libraryExists(id); err != nil {
+		return err
+	}
+
+	if err := i.repo.Library().RemoveUser(id, userId); err != nil {
+		return errs.BuildError(err, "could not revoke access of user %v to library %v", userId.String(), id.String())
+	}
+
+	return nil
+}
+
+func (i *libraryService) libraryExists(id uuid.UUID) error {
+	if _, err := i.repo.Library().GetById(id); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return ErrLibraryNotFound
+		}
+		return errs.BuildError(err, "could not get library by id: %v", id.String())
+	}
+
+	return nil
+}
+
 var libraryServiceInstance *libraryService
 
 func New(repo repository.Repository, env *environment.EnvironmentVariables) LibraryService {
@@ -85,8 +155,8 @@ func (i *libraryService) Create(newLibrary *model.Library) (*model.Library, erro
 
 const ErrGetLibraries = "could not getting libraries in repo"
 
-func (i *libraryService) GetAll() ([]model.Library, error) {
-	libraries, err := i.repo.Library().GetAll()
+func (i *libraryService) GetAll(userId uuid.UUID) ([]model.Library, error) {
+	libraries, err := i.repo.Library().GetAll(userId)
 	if err != nil {
 		return nil, errs.BuildError(err, ErrGetLibraries)
 	}
@@ -5,15 +5,21 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
 	mock_jobRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/job"
 	mock_libraryRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/library"
 	mock_libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/library_path"
+	mock_userRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/user"
 	jobRepository "github.com/slugger7/exorcist/apps/server/internal/repository/job"
 	libraryRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library"
 	libraryPathRepository "github.com/slugger7/exorcist/apps/server/internal/repository/library_path"
+	userRepository "github.com/slugger7/exorcist/apps/server/internal/repository/user"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
@@ -23,6 +29,7 @@ type testService struct {
 	libraryRepo     *mock_libraryRepository.MockLibraryRepository
 	libraryPathRepo *mock_libraryPathRepository.MockLibraryPathRepository
 	jobRepo         *mock_jobRepository.MockJobRepository
+	userRepo        *mock_userRepository.MockUserRepository
 }
 
 func setup(t *testing.T) *testService {
@@ -32,6 +39,7 @@ func setup(t *testing.T) *testService {
 	mockLibraryRepo := mock_libraryRepository.NewMockLibraryRepository(ctrl)
 	mockLibraryPathRepo := mock_libraryPathRepository.NewMockLibraryPathRepository(ctrl)
 	mockJobRepo := mock_jobRepository.NewMockJobRepository(ctrl)
+	mockUserRepo := mock_userRepository.NewMockUserRepository(ctrl)
 
 	mockRepo.EXPECT().
 		Library().
@@ -54,8 +62,15 @@ func setup(t *testing.T) *testService {
 		}).
 		AnyTimes()
 
+	mockRepo.EXPECT().
+		User().
+		DoAndReturn(func() userRepository.UserRepository {
+			return mockUserRepo
+		}).
+		AnyTimes()
+
 	ls := &libraryService{repo: mockRepo}
-	return &testService{ls, mockRepo, mockLibraryRepo, mockLibraryPathRepo, mockJobRepo}
+	return &testService{ls, mockRepo, mockLibraryRepo, mockLibraryPathRepo, mockJobRepo, mockUserRepo}
 }
 
 func Test_CreateLibrary_ProduceErrorWhileFetchingExistingLibraries(t *testing.T) {
@@ -120,12 +135,12 @@ func Test_GetAll_RepoReturnsError_ShouldReturnError(t *testing.T) {
 	s := setup(t)
 
 	s.libraryRepo.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.Library, error) {
+		GetAll(gomock.Any()).
+		DoAndReturn(func(uuid.UUID) ([]model.Library, error) {
 			return nil, fmt.Errorf("some error")
 		})
 
-	libs, err := s.svc.GetAll()
+	libs, err := s.svc.GetAll(uuid.New())
 	if err != nil {
 		var e errs.IError
 		if errors.As(err, &e) {
@@ -148,12 +163,12 @@ func Test_GetLibraries_ReturnsLibraries(t *testing.T) {
 	expectedName := "expected library name"
 
 	s.libraryRepo.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.Library, error) {
+		GetAll(gomock.Any()).
+		DoAndReturn(func(uuid.UUID) ([]model.Library, error) {
 			return []model.Library{{Name: expectedName}}, nil
 		})
 
-	actual, err := s.svc.GetAll()
+	actual, err := s.svc.GetAll(uuid.New())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -164,3 +179,54 @@ func Test_GetLibraries_ReturnsLibraries(t *testing.T) {
 		t.Errorf("Expected name: %v\nGot: %v", expectedName, actual[0].Name)
 	}
 }
+
+func Test_GrantAccess_LibraryNotFound(t *testing.T) {
+	s := setup(t)
+
+	id, userId := uuid.New(), uuid.New()
+	s.libraryRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(nil, errors.Join(qrm.ErrNoRows, fmt.Errorf("some error"))).
+		Times(1)
+
+	err := s.svc.GrantAccess(id, userId)
+	assert.ErrorIs(t, err, ErrLibraryNotFound)
+}
+
+func Test_GrantAccess_UserNotFound(t *testing.T) {
+	s := setup(t)
+
+	id, userId := uuid.New(), uuid.New()
+	s.libraryRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Library{ID: id}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(userId)).
+		Return(nil, nil).
+		Times(1)
+
+	err := s.svc.GrantAccess(id, userId)
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func Test_GrantAccess_AddsUserToLibrary(t *testing.T) {
+	s := setup(t)
+
+	id, userId := uuid.New(), uuid.New()
+	s.libraryRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Library{ID: id}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(userId)).
+		Return(&model.User{ID: userId}, nil).
+		Times(1)
+	s.libraryRepo.EXPECT().
+		AddUser(gomock.Eq(id), gomock.Eq(userId)).
+		Return(nil).
+		Times(1)
+
+	err := s.svc.GrantAccess(id, userId)
+	assert.NoError(t, err)
+}
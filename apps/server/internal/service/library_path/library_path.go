@@ -3,6 +3,7 @@ package libraryPathService
 import (
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
@@ -17,7 +18,7 @@ const (
 
 type LibraryPathService interface {
 	Create(m *model.LibraryPath) (*model.LibraryPath, error)
-	GetAll() ([]model.LibraryPath, error)
+	GetAll(userId uuid.UUID) ([]model.LibraryPath, error)
 }
 
 type libraryPathService struct {
@@ -80,8 +81,9 @@ func (lps *libraryPathService) Create(libPathModel *model.LibraryPath) (*model.L
 
 const ErrGetAllLibraryPaths = "could not get all library paths"
 
-func (lps *libraryPathService) GetAll() ([]model.LibraryPath, error) {
-	libPaths, err := lps.repo.LibraryPath().GetAll()
+// GetAll implements LibraryPathService. Only the library paths that the user has access to are returned.
+func (lps *libraryPathService) GetAll(userId uuid.UUID) ([]model.LibraryPath, error) {
+	libPaths, err := lps.repo.LibraryPath().GetAllForUser(userId)
 	if err != nil {
 		return nil, errs.BuildError(err, ErrGetAllLibraryPaths)
 	}
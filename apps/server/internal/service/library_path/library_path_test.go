@@ -225,15 +225,16 @@ func Test_Create_Success(t *testing.T) {
 
 func Test_GetAll_RepoReturnsError(t *testing.T) {
 	s := setup(t)
+	userId, _ := uuid.NewRandom()
 
 	s.libPathRepo.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.LibraryPath, error) {
+		GetAllForUser(userId).
+		DoAndReturn(func(uuid.UUID) ([]model.LibraryPath, error) {
 			return nil, fmt.Errorf("error")
 		}).
 		Times(1)
 
-	libPaths, err := s.svc.GetAll()
+	libPaths, err := s.svc.GetAll(userId)
 	if err == nil {
 		t.Error("expected error but was nil")
 	}
@@ -254,19 +255,20 @@ func Test_GetAll_RepoReturnsError(t *testing.T) {
 
 func Test_GetAll_Success(t *testing.T) {
 	s := setup(t)
+	userId, _ := uuid.NewRandom()
 
 	id, _ := uuid.NewRandom()
 	libPath := model.LibraryPath{ID: id}
 	libPaths := []model.LibraryPath{libPath}
 
 	s.libPathRepo.EXPECT().
-		GetAll().
-		DoAndReturn(func() ([]model.LibraryPath, error) {
+		GetAllForUser(userId).
+		DoAndReturn(func(uuid.UUID) ([]model.LibraryPath, error) {
 			return libPaths, nil
 		}).
 		Times(1)
 
-	libPaths, err := s.svc.GetAll()
+	libPaths, err := s.svc.GetAll(userId)
 	if err != nil {
 		t.Errorf("Expected no error but got: %v", err)
 	}
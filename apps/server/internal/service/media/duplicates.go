@@ -0,0 +1,126 @@
+package mediaService
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/ffmpeg"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+)
+
+var (
+	ErrMergeMediaNotFound = errors.New("media to merge could not be found")
+	ErrMergeIntoItself    = errors.New("media cannot be merged into itself")
+	ErrMergeIntoDeleted   = errors.New("media cannot be merged into deleted media")
+)
+
+// DuplicatesByChecksum implements MediaService.
+// Every group contains media with exactly the same content.
+func (s *mediaService) DuplicatesByChecksum(userId uuid.UUID) ([][]models.DuplicateMedia, error) {
+	duplicates, err := s.repo.Media().GetDuplicatesByChecksum(userId)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get duplicates by checksum")
+	}
+
+	groups := [][]models.DuplicateMedia{}
+	for _, m := range duplicates {
+		last := len(groups) - 1
+		if last >= 0 && *groups[last][0].Checksum == *m.Checksum {
+			groups[last] = append(groups[last], m)
+			continue
+		}
+
+		groups = append(groups, []models.DuplicateMedia{m})
+	}
+
+	return groups, nil
+}
+
+// DuplicatesByPerceptualHash implements MediaService.
+// Media end up in the same group when their perceptual hashes differ by at most threshold bits
+// from any other media in the group.
+func (s *mediaService) DuplicatesByPerceptualHash(userId uuid.UUID, threshold int) ([][]models.DuplicateMedia, error) {
+	withHash, err := s.repo.Media().GetWithPerceptualHash(userId)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get media with perceptual hashes")
+	}
+
+	return groupByPerceptualHash(withHash, threshold), nil
+}
+
+func groupByPerceptualHash(media []models.DuplicateMedia, threshold int) [][]models.DuplicateMedia {
+	parents := make([]int, len(media))
+	for i := range parents {
+		parents[i] = i
+	}
+
+	var root func(i int) int
+	root = func(i int) int {
+		if parents[i] != i {
+			parents[i] = root(parents[i])
+		}
+		return parents[i]
+	}
+
+	for i := range media {
+		for j := i + 1; j < len(media); j++ {
+			if ffmpeg.HammingDistance(uint64(*media[i].Phash), uint64(*media[j].Phash)) <= threshold {
+				parents[root(j)] = root(i)
+			}
+		}
+	}
+
+	indexes := map[int]int{}
+	groups := [][]models.DuplicateMedia{}
+	for i, m := range media {
+		r := root(i)
+		index, ok := indexes[r]
+		if !ok {
+			index = len(groups)
+			indexes[r] = index
+			groups = append(groups, []models.DuplicateMedia{})
+		}
+
+		groups[index] = append(groups[index], m)
+	}
+
+	return slices.DeleteFunc(groups, func(g []models.DuplicateMedia) bool {
+		return len(g) < 2
+	})
+}
+
+// Merge implements MediaService.
+func (s *mediaService) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) error {
+	if slices.Contains(mergeIds, keepId) {
+		return ErrMergeIntoItself
+	}
+
+	mergeIds = slices.Clone(mergeIds)
+	slices.SortFunc(mergeIds, func(a, b uuid.UUID) int {
+		return slices.Compare(a[:], b[:])
+	})
+	mergeIds = slices.Compact(mergeIds)
+
+	for _, id := range append([]uuid.UUID{keepId}, mergeIds...) {
+		m, err := s.repo.Media().GetById(id)
+		if err != nil {
+			if errors.Is(err, qrm.ErrNoRows) {
+				return errors.Join(ErrMergeMediaNotFound, errs.BuildError(err, "media not found: %v", id.String()))
+			}
+			return errs.BuildError(err, "could not get media to merge: %v", id.String())
+		}
+
+		if id == keepId && m.Media.Deleted {
+			return ErrMergeIntoDeleted
+		}
+	}
+
+	if err := s.repo.Media().Merge(keepId, mergeIds); err != nil {
+		return errs.BuildError(err, "could not merge media into %v", keepId.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package mediaService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func duplicateWithChecksum(checksum string) models.DuplicateMedia {
+	return models.DuplicateMedia{
+		Media: model.Media{ID: uuid.New(), Checksum: &checksum},
+	}
+}
+
+func duplicateWithHash(hash int64) models.DuplicateMedia {
+	return models.DuplicateMedia{
+		Media: model.Media{ID: uuid.New()},
+		Video: &model.Video{Phash: &hash},
+	}
+}
+
+func Test_DuplicatesByChecksum_GroupsMediaWithTheSameChecksum(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+
+	a1, a2, b1, b2 := duplicateWithChecksum("a"), duplicateWithChecksum("a"), duplicateWithChecksum("b"), duplicateWithChecksum("b")
+
+	userId := uuid.New()
+	s.mediaRepo.EXPECT().
+		GetDuplicatesByChecksum(userId).
+		Return([]models.DuplicateMedia{a1, a2, b1, b2}, nil).
+		Times(1)
+
+	groups, err := s.svc.DuplicatesByChecksum(userId)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]models.DuplicateMedia{{a1, a2}, {b1, b2}}, groups)
+}
+
+func Test_GroupByPerceptualHash_GroupsHashesWithinThreshold(t *testing.T) {
+	near1 := duplicateWithHash(0b0000)
+	other := duplicateWithHash(-1)
+	near2 := duplicateWithHash(0b0011)
+	// only close to near2 but still ends up in the same group
+	near3 := duplicateWithHash(0b1111)
+
+	groups := groupByPerceptualHash([]models.DuplicateMedia{near1, other, near2, near3}, 2)
+
+	assert.Equal(t, [][]models.DuplicateMedia{{near1, near2, near3}}, groups)
+}
+
+func Test_Merge_IntoItself(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+
+	err := s.svc.Merge(s.assetId, []uuid.UUID{uuid.New(), s.assetId})
+
+	assert.True(t, errors.Is(err, ErrMergeIntoItself))
+}
+
+func Test_Merge_IntoDeletedMedia(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+
+	s.mediaRepo.EXPECT().
+		GetById(s.assetId).
+		Return(&models.Media{Media: model.Media{ID: s.assetId, Deleted: true}}, nil).
+		Times(1)
+
+	err := s.svc.Merge(s.assetId, []uuid.UUID{uuid.New()})
+
+	assert.True(t, errors.Is(err, ErrMergeIntoDeleted))
+}
+
+func Test_Merge_RemovesDuplicateIds(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+
+	mergeId := uuid.New()
+
+	s.mediaRepo.EXPECT().
+		GetById(gomock.Any()).
+		DoAndReturn(func(id uuid.UUID) (*models.Media, error) {
+			return &models.Media{Media: model.Media{ID: id}}, nil
+		}).
+		Times(2)
+
+	s.mediaRepo.EXPECT().
+		Merge(s.assetId, []uuid.UUID{mergeId}).
+		Return(nil).
+		Times(1)
+
+	assert.NoError(t, s.svc.Merge(s.assetId, []uuid.UUID{mergeId, mergeId}))
+}
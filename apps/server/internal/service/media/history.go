@@ -0,0 +1,118 @@
+package mediaService
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+)
+
+// watchSessionGap is how long progress can stop coming in before watching the media again counts as another viewing
+const watchSessionGap = 30 * time.Minute
+
+// ContinueWatching implements MediaService. Media that the user has started but not finished with what was watched last first.
+func (m *mediaService) ContinueWatching(userId uuid.UUID, page dto.PageRequestDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
+	deleted, exists := false, true
+	search := dto.MediaSearchDTO{
+		PageRequestDTO: page,
+		WatchStatuses:  []dto.WatchStatus{dto.WatchStatus_InProgress},
+		OrderBy:        dto.MediaOrdinal_LastWatched,
+		Deleted:        &deleted,
+		Exists:         &exists,
+	}
+	search.Asc = false
+
+	result, err := m.repo.Media().GetAll(userId, search)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get media for user %v to continue watching", userId.String())
+	}
+
+	return result, nil
+}
+
+// MarkWatched implements MediaService. The progress is reset so that watching it again starts from the beginning.
+func (m *mediaService) MarkWatched(id, userId uuid.UUID) (*model.MediaProgress, error) {
+	runtime, err := m.runtime(id)
+	if err != nil {
+		return nil, err
+	}
+
+	history := model.WatchHistory{
+		UserID:    userId,
+		MediaID:   id,
+		Timestamp: runtime,
+		Completed: true,
+	}
+	if err := m.repo.Media().CreateHistory(history); err != nil {
+		return nil, errs.BuildError(err, "could not add media %v to the watch history of user %v", id.String(), userId.String())
+	}
+
+	prog, err := m.repo.Media().UpsertWatched(model.MediaProgress{UserID: userId, MediaID: id})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not mark media %v as watched for user %v", id.String(), userId.String())
+	}
+
+	return prog, nil
+}
+
+// MarkUnwatched implements MediaService. The watch history and play count are kept.
+func (m *mediaService) MarkUnwatched(id, userId uuid.UUID) error {
+	if err := m.repo.Media().ResetProgress(id, userId); err != nil {
+		return errs.BuildError(err, "could not mark media %v as unwatched for user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+// logHistory continues the latest viewing of the media or starts another one.
+// It is true when the viewing has been completed by this progress.
+func (m *mediaService) logHistory(id, userId uuid.UUID, timestamp, runtime float64) (bool, error) {
+	reached := runtime > 0 && timestamp >= runtime*float64(m.env.WatchedThreshold)/100
+
+	latest, err := m.repo.Media().GetLatestHistory(id, userId)
+	if err != nil {
+		return false, errs.BuildError(err, "could not get watch history of user %v for media %v", userId.String(), id.String())
+	}
+
+	if latest == nil || time.Since(latest.Modified) > watchSessionGap {
+		history := model.WatchHistory{
+			UserID:    userId,
+			MediaID:   id,
+			Timestamp: timestamp,
+			Completed: reached,
+		}
+		if err := m.repo.Media().CreateHistory(history); err != nil {
+			return false, errs.BuildError(err, "could not add media %v to the watch history of user %v", id.String(), userId.String())
+		}
+
+		return reached, nil
+	}
+
+	completed := reached && !latest.Completed
+
+	latest.Timestamp = timestamp
+	latest.Completed = latest.Completed || reached
+	if err := m.repo.Media().UpdateHistory(*latest); err != nil {
+		return false, errs.BuildError(err, "could not update watch history %v", latest.ID.String())
+	}
+
+	return completed, nil
+}
+
+// runtime of the media in seconds. Media that is not a video has no runtime.
+func (m *mediaService) runtime(id uuid.UUID) (float64, error) {
+	video, err := m.repo.Video().GetByMediaId(id)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errs.BuildError(err, "could not get video of media %v", id.String())
+	}
+
+	return video.Runtime, nil
+}
@@ -0,0 +1,169 @@
+package mediaService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	mock_videoRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/video"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	videoRepository "github.com/slugger7/exorcist/apps/server/internal/repository/video"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// withVideo makes the media a video with the runtime and counts it as watched from 90%
+func (s *testService) withVideo(t *testing.T, runtime float64) {
+	videoRepo := mock_videoRepository.NewMockVideoRepository(gomock.NewController(t))
+	videoRepo.EXPECT().
+		GetByMediaId(gomock.Any()).
+		Return(&videoRepository.MediaVideoModel{Video: model.Video{Runtime: runtime}}, nil).
+		AnyTimes()
+
+	s.repo.EXPECT().
+		Video().
+		DoAndReturn(func() videoRepository.VideoRepository {
+			return videoRepo
+		}).
+		AnyTimes()
+
+	s.svc.env = &environment.EnvironmentVariables{WatchedThreshold: 90}
+}
+
+func Test_LogProgress_PastThreshold_MarksWatched(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+	s.withVideo(t, 100)
+
+	id, userId := uuid.New(), uuid.New()
+	latest := model.WatchHistory{ID: uuid.New(), Timestamp: 80, Modified: time.Now().Add(-time.Minute)}
+
+	s.mediaRepo.EXPECT().
+		GetProgressForUser(id, userId).
+		Return(&model.MediaProgress{Timestamp: 80}, nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		GetLatestHistory(id, userId).
+		Return(&latest, nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		UpdateHistory(gomock.Any()).
+		DoAndReturn(func(h model.WatchHistory) error {
+			assert.Equal(t, latest.ID, h.ID)
+			assert.Equal(t, float64(95), h.Timestamp)
+			assert.True(t, h.Completed)
+			return nil
+		}).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		UpsertWatched(model.MediaProgress{UserID: userId, MediaID: id, Timestamp: 95}).
+		Return(&model.MediaProgress{Timestamp: 95, PlayCount: 1}, nil).
+		Times(1)
+
+	prog, err := s.svc.LogProgress(id, userId, dto.ProgressUpdateDTO{Progress: 95})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), prog.PlayCount)
+}
+
+func Test_LogProgress_AlreadyCompletedViewing_DoesNotCountAgain(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+	s.withVideo(t, 100)
+
+	id, userId := uuid.New(), uuid.New()
+	latest := model.WatchHistory{ID: uuid.New(), Timestamp: 95, Completed: true, Modified: time.Now().Add(-time.Minute)}
+
+	s.mediaRepo.EXPECT().
+		GetProgressForUser(id, userId).
+		Return(&model.MediaProgress{Timestamp: 95}, nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		GetLatestHistory(id, userId).
+		Return(&latest, nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		UpdateHistory(gomock.Any()).
+		Return(nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		UpsertProgress(model.MediaProgress{UserID: userId, MediaID: id, Timestamp: 98}).
+		Return(&model.MediaProgress{Timestamp: 98}, nil).
+		Times(1)
+
+	_, err := s.svc.LogProgress(id, userId, dto.ProgressUpdateDTO{Progress: 98})
+	assert.Nil(t, err)
+}
+
+func Test_LogProgress_AfterGap_StartsAnotherViewing(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+	s.withVideo(t, 100)
+
+	id, userId := uuid.New(), uuid.New()
+	latest := model.WatchHistory{ID: uuid.New(), Timestamp: 95, Completed: true, Modified: time.Now().Add(-24 * time.Hour)}
+
+	s.mediaRepo.EXPECT().
+		GetProgressForUser(id, userId).
+		Return(nil, nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		GetLatestHistory(id, userId).
+		Return(&latest, nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		CreateHistory(model.WatchHistory{UserID: userId, MediaID: id, Timestamp: 10}).
+		Return(nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		UpsertProgress(gomock.Any()).
+		Return(&model.MediaProgress{Timestamp: 10}, nil).
+		Times(1)
+
+	_, err := s.svc.LogProgress(id, userId, dto.ProgressUpdateDTO{Progress: 10})
+	assert.Nil(t, err)
+}
+
+func Test_MarkWatched_ResetsProgress(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+	s.withVideo(t, 100)
+
+	id, userId := uuid.New(), uuid.New()
+
+	s.mediaRepo.EXPECT().
+		CreateHistory(model.WatchHistory{UserID: userId, MediaID: id, Timestamp: 100, Completed: true}).
+		Return(nil).
+		Times(1)
+	s.mediaRepo.EXPECT().
+		UpsertWatched(model.MediaProgress{UserID: userId, MediaID: id}).
+		Return(&model.MediaProgress{PlayCount: 2}, nil).
+		Times(1)
+
+	_, err := s.svc.MarkWatched(id, userId)
+	assert.Nil(t, err)
+}
+
+func Test_ContinueWatching_InProgressLastWatchedFirst(t *testing.T) {
+	s := setup(t)
+	defer s.cleanup()
+
+	userId := uuid.New()
+	var actual dto.MediaSearchDTO
+	s.mediaRepo.EXPECT().
+		GetAll(userId, gomock.Any()).
+		DoAndReturn(func(_ uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
+			actual = search
+			return &dto.PageDTO[models.MediaOverviewModel]{}, nil
+		}).
+		Times(1)
+
+	_, err := s.svc.ContinueWatching(userId, dto.PageRequestDTO{Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, []dto.WatchStatus{dto.WatchStatus_InProgress}, actual.WatchStatuses)
+	assert.Equal(t, dto.MediaOrdinal_LastWatched, actual.OrderBy)
+	assert.False(t, actual.Asc)
+	assert.Equal(t, 10, actual.Limit)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
 	"github.com/slugger7/exorcist/apps/server/internal/repository"
 	personService "github.com/slugger7/exorcist/apps/server/internal/service/person"
 	tagService "github.com/slugger7/exorcist/apps/server/internal/service/tag"
@@ -30,10 +31,18 @@ type MediaService interface {
 	AddPerson(id uuid.UUID, personId uuid.UUID) (*model.MediaPerson, error)
 	Delete(id uuid.UUID, physical bool) error
 	LogProgress(id, userId uuid.UUID, progress dto.ProgressUpdateDTO) (*model.MediaProgress, error)
+	MarkWatched(id, userId uuid.UUID) (*model.MediaProgress, error)
+	MarkUnwatched(id, userId uuid.UUID) error
+	ContinueWatching(userId uuid.UUID, page dto.PageRequestDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
+	Rate(id, userId uuid.UUID, rating int16) (*model.MediaRating, error)
+	Unrate(id, userId uuid.UUID) error
 	Relate(id uuid.UUID, relateDto dto.PutMediaRelationDto) ([]model.MediaRelation, error)
 	CopyTags(toId, fromId uuid.UUID) error
 	CopyPeople(toId, fromId uuid.UUID) error
 	DeleteRelations(id uuid.UUID, deleteDto dto.DeleteMediaRelationsDto) error
+	DuplicatesByChecksum(userId uuid.UUID) ([][]models.DuplicateMedia, error)
+	DuplicatesByPerceptualHash(userId uuid.UUID, threshold int) ([][]models.DuplicateMedia, error)
+	Merge(keepId uuid.UUID, mergeIds []uuid.UUID) error
 }
 
 func createRelations(id uuid.UUID, relationDto dto.PutMediaRelationDto) []model.MediaRelation {
@@ -163,7 +172,8 @@ func (s *mediaService) CopyTags(toId uuid.UUID, fromId uuid.UUID) error {
 	return nil
 }
 
-// LogProgress implements MediaService.
+// LogProgress implements MediaService. The progress is also written to the watch history and media that is watched past
+// the threshold is marked as watched.
 func (m *mediaService) LogProgress(id, userId uuid.UUID, progress dto.ProgressUpdateDTO) (*model.MediaProgress, error) {
 	current, err := m.repo.Media().GetProgressForUser(id, userId)
 	if err != nil {
@@ -172,13 +182,33 @@ func (m *mediaService) LogProgress(id, userId uuid.UUID, progress dto.ProgressUp
 		}
 	}
 
+	runtime, err := m.runtime(id)
+	if err != nil {
+		return nil, err
+	}
+
 	if progress.Progress < 0 {
-		m, err := m.repo.Media().GetById(id)
+		progress.Progress = runtime + progress.Progress
+	}
+
+	completed, err := m.logHistory(id, userId, progress.Progress, runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	prog := &model.MediaProgress{
+		UserID:    userId,
+		MediaID:   id,
+		Timestamp: progress.Progress,
+	}
+
+	if completed {
+		newProg, err := m.repo.Media().UpsertWatched(*prog)
 		if err != nil {
-			return nil, errs.BuildError(err, "could not get media by id")
+			return nil, errs.BuildError(err, "could not mark media as watched in repo")
 		}
 
-		progress.Progress = m.Runtime + progress.Progress
+		return newProg, nil
 	}
 
 	if current != nil && !progress.Overwrite {
@@ -187,12 +217,6 @@ func (m *mediaService) LogProgress(id, userId uuid.UUID, progress dto.ProgressUp
 		}
 	}
 
-	prog := &model.MediaProgress{
-		UserID:    userId,
-		MediaID:   id,
-		Timestamp: progress.Progress,
-	}
-
 	newProg, err := m.repo.Media().UpsertProgress(*prog)
 	if err != nil {
 		return nil, errs.BuildError(err, "could not upsert progress for in repo")
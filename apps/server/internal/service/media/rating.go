@@ -0,0 +1,26 @@
+package mediaService
+
+import (
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+// Rate implements MediaService.
+func (m *mediaService) Rate(id, userId uuid.UUID, rating int16) (*model.MediaRating, error) {
+	r, err := m.repo.Media().UpsertRating(model.MediaRating{MediaID: id, UserID: userId, Rating: rating})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not rate media %v for user %v", id.String(), userId.String())
+	}
+
+	return r, nil
+}
+
+// Unrate implements MediaService.
+func (m *mediaService) Unrate(id, userId uuid.UUID) error {
+	if err := m.repo.Media().DeleteRating(id, userId); err != nil {
+		return errs.BuildError(err, "could not remove rating of media %v for user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package personService
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/service/helpers"
+)
+
+var (
+	ErrPersonNotFound = errors.New("person not found")
+	ErrAliasNotFound  = errors.New("alias not found")
+	ErrAliasEmpty     = helpers.ErrAliasEmpty
+	ErrAliasTaken     = errors.New("alias is already the name or alias of a person")
+)
+
+// GetAliases implements PersonService.
+func (p *personService) GetAliases(id uuid.UUID) ([]model.PersonAlias, error) {
+	if err := p.ensureExists(id); err != nil {
+		return nil, err
+	}
+
+	aliases, err := p.repo.Person().GetAliases(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get aliases of person %v", id.String())
+	}
+
+	return aliases, nil
+}
+
+// AddAlias implements PersonService. An alias can not be the name or alias of any person so that it always resolves to a single person.
+func (p *personService) AddAlias(id uuid.UUID, alias string) (*model.PersonAlias, error) {
+	alias, err := helpers.PrepareAlias(alias, func() error { return p.ensureExists(id) }, ErrAliasTaken, p.repo.Person().GetByName, p.repo.Person().GetByAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := p.repo.Person().CreateAlias(model.PersonAlias{PersonID: id, Alias: alias})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not create alias %v for person %v", alias, id.String())
+	}
+
+	return created, nil
+}
+
+// RemoveAlias implements PersonService.
+func (p *personService) RemoveAlias(id, aliasId uuid.UUID) error {
+	deleted, err := p.repo.Person().DeleteAlias(id, aliasId)
+	if err != nil {
+		return errs.BuildError(err, "could not delete alias %v of person %v", aliasId.String(), id.String())
+	}
+
+	if !deleted {
+		return ErrAliasNotFound
+	}
+
+	return nil
+}
+
+func (p *personService) ensureExists(id uuid.UUID) error {
+	person, err := p.repo.Person().GetById(id)
+	if err != nil {
+		return errs.BuildError(err, "could not get person by id %v", id.String())
+	}
+
+	if person == nil {
+		return ErrPersonNotFound
+	}
+
+	return nil
+}
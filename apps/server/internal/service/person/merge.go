@@ -0,0 +1,45 @@
+package personService
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+var ErrMergeIntoItself = errors.New("person cannot be merged into itself")
+
+// Merge implements PersonService. Returns the ids of the media whose people changed.
+func (p *personService) Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error) {
+	mergeIds = unique(mergeIds)
+	if slices.Contains(mergeIds, keepId) {
+		return nil, ErrMergeIntoItself
+	}
+
+	for _, id := range append([]uuid.UUID{keepId}, mergeIds...) {
+		if err := p.ensureExists(id); err != nil {
+			return nil, err
+		}
+	}
+
+	mediaIds, err := p.repo.Person().Merge(keepId, mergeIds)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not merge people into %v", keepId.String())
+	}
+
+	return mediaIds, nil
+}
+
+func unique(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	uniqueIds := []uuid.UUID{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIds = append(uniqueIds, id)
+		}
+	}
+
+	return uniqueIds
+}
@@ -17,6 +17,14 @@ type PersonService interface {
 	Upsert(name string) (*model.Person, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
 	Delete(id uuid.UUID) error
+	GetAliases(id uuid.UUID) ([]model.PersonAlias, error)
+	AddAlias(id uuid.UUID, alias string) (*model.PersonAlias, error)
+	RemoveAlias(id, aliasId uuid.UUID) error
+	Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error)
+	AddFavourite(id, userId uuid.UUID) error
+	RemoveFavourite(id, userId uuid.UUID) error
+	Rate(id, userId uuid.UUID, rating int16) (*model.PersonRating, error)
+	Unrate(id, userId uuid.UUID) error
 }
 
 type personService struct {
@@ -59,13 +67,20 @@ func (p *personService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO
 	return media, nil
 }
 
-// Upsert implements IPersonService.
+// Upsert implements IPersonService. Names that are an alias resolve to the person they belong to.
 func (p *personService) Upsert(name string) (*model.Person, error) {
 	person, err := p.repo.Person().GetByName(name)
 	if err != nil {
 		return nil, errs.BuildError(err, "could not get person by name from repo")
 	}
 
+	if person == nil {
+		person, err = p.repo.Person().GetByAlias(name)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not get person by alias from repo")
+		}
+	}
+
 	if person == nil {
 		people, err := p.repo.Person().Create([]string{name})
 		if err != nil {
@@ -0,0 +1,149 @@
+package personService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_personRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/person"
+	personRepository "github.com/slugger7/exorcist/apps/server/internal/repository/person"
+	"go.uber.org/mock/gomock"
+)
+
+type testService struct {
+	svc        *personService
+	repo       *mock_repository.MockRepository
+	personRepo *mock_personRepository.MockPersonRepository
+}
+
+func setup(t *testing.T) *testService {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock_repository.NewMockRepository(ctrl)
+	mockPersonRepo := mock_personRepository.NewMockPersonRepository(ctrl)
+
+	mockRepo.EXPECT().
+		Person().
+		DoAndReturn(func() personRepository.PersonRepository {
+			return mockPersonRepo
+		}).
+		AnyTimes()
+
+	env := environment.EnvironmentVariables{LogLevel: "none"}
+	ps := &personService{repo: mockRepo, env: &env, logger: logger.New(&env)}
+	return &testService{ps, mockRepo, mockPersonRepo}
+}
+
+func Test_AddAlias_BlankAlias(t *testing.T) {
+	s := setup(t)
+
+	_, err := s.svc.AddAlias(uuid.New(), "   ")
+	if !errors.Is(err, ErrAliasEmpty) {
+		t.Fatalf("expected alias to be empty but got: %v", err)
+	}
+}
+
+func Test_AddAlias_PersonNotFound(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.personRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(nil, nil).
+		Times(1)
+
+	_, err := s.svc.AddAlias(id, "bob")
+	if !errors.Is(err, ErrPersonNotFound) {
+		t.Fatalf("expected person not found but got: %v", err)
+	}
+}
+
+func Test_AddAlias_NameOfAPerson(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.personRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Person{ID: id}, nil).
+		Times(1)
+	s.personRepo.EXPECT().
+		GetByName(gomock.Eq("robert")).
+		Return(&model.Person{ID: uuid.New(), Name: "robert"}, nil).
+		Times(1)
+
+	_, err := s.svc.AddAlias(id, " robert ")
+	if !errors.Is(err, ErrAliasTaken) {
+		t.Fatalf("expected alias to be taken but got: %v", err)
+	}
+}
+
+func Test_AddAlias_AliasOfAPerson(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.personRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Person{ID: id}, nil).
+		Times(1)
+	s.personRepo.EXPECT().
+		GetByName(gomock.Eq("bob")).
+		Return(nil, nil).
+		Times(1)
+	s.personRepo.EXPECT().
+		GetByAlias(gomock.Eq("bob")).
+		Return(&model.Person{ID: uuid.New()}, nil).
+		Times(1)
+
+	_, err := s.svc.AddAlias(id, "bob")
+	if !errors.Is(err, ErrAliasTaken) {
+		t.Fatalf("expected alias to be taken but got: %v", err)
+	}
+}
+
+func Test_AddAlias_Success(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.personRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Person{ID: id}, nil).
+		Times(1)
+	s.personRepo.EXPECT().
+		GetByName(gomock.Eq("bob")).
+		Return(nil, nil).
+		Times(1)
+	s.personRepo.EXPECT().
+		GetByAlias(gomock.Eq("bob")).
+		Return(nil, nil).
+		Times(1)
+	s.personRepo.EXPECT().
+		CreateAlias(gomock.Eq(model.PersonAlias{PersonID: id, Alias: "bob"})).
+		Return(&model.PersonAlias{ID: uuid.New(), PersonID: id, Alias: "bob"}, nil).
+		Times(1)
+
+	alias, err := s.svc.AddAlias(id, " bob ")
+	if err != nil {
+		t.Fatalf("encountered an error while adding alias: %v", err)
+	}
+
+	assert.Eq(t, "bob", alias.Alias)
+}
+
+func Test_RemoveAlias_NotFound(t *testing.T) {
+	s := setup(t)
+
+	id, aliasId := uuid.New(), uuid.New()
+	s.personRepo.EXPECT().
+		DeleteAlias(gomock.Eq(id), gomock.Eq(aliasId)).
+		Return(false, nil).
+		Times(1)
+
+	if err := s.svc.RemoveAlias(id, aliasId); !errors.Is(err, ErrAliasNotFound) {
+		t.Fatalf("expected alias not found but got: %v", err)
+	}
+}
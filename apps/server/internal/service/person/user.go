@@ -0,0 +1,52 @@
+package personService
+
+import (
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+// AddFavourite implements PersonService.
+func (p *personService) AddFavourite(id, userId uuid.UUID) error {
+	if err := p.ensureExists(id); err != nil {
+		return err
+	}
+
+	if err := p.repo.Person().AddFavourite(id, userId); err != nil {
+		return errs.BuildError(err, "could not add person %v to favourites of user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+// RemoveFavourite implements PersonService.
+func (p *personService) RemoveFavourite(id, userId uuid.UUID) error {
+	if err := p.repo.Person().RemoveFavourite(id, userId); err != nil {
+		return errs.BuildError(err, "could not remove person %v from favourites of user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
+
+// Rate implements PersonService.
+func (p *personService) Rate(id, userId uuid.UUID, rating int16) (*model.PersonRating, error) {
+	if err := p.ensureExists(id); err != nil {
+		return nil, err
+	}
+
+	r, err := p.repo.Person().UpsertRating(model.PersonRating{PersonID: id, UserID: userId, Rating: rating})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not rate person %v for user %v", id.String(), userId.String())
+	}
+
+	return r, nil
+}
+
+// Unrate implements PersonService.
+func (p *personService) Unrate(id, userId uuid.UUID) error {
+	if err := p.repo.Person().DeleteRating(id, userId); err != nil {
+		return errs.BuildError(err, "could not remove rating of person %v for user %v", id.String(), userId.String())
+	}
+
+	return nil
+}
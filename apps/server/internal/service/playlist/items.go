@@ -0,0 +1,64 @@
+package playlistService
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+var (
+	ErrPlaylistNotFound   = errors.New("playlist not found")
+	ErrMediaNotInPlaylist = errors.New("media is not in the playlist")
+	ErrSmartPlaylist      = errors.New("the media of a smart playlist comes from its search")
+)
+
+// RemoveMedia implements PlaylistService.
+func (p *playlistService) RemoveMedia(id, userId, mediaId uuid.UUID) error {
+	if err := p.ensureEditable(id, userId); err != nil {
+		return err
+	}
+
+	removed, err := p.repo.Playlist().RemoveMedia(id, mediaId)
+	if err != nil {
+		return errs.BuildError(err, "could not remove media %v from playlist %v", mediaId.String(), id.String())
+	}
+
+	if !removed {
+		return ErrMediaNotInPlaylist
+	}
+
+	return nil
+}
+
+// MoveMedia implements PlaylistService.
+func (p *playlistService) MoveMedia(id, userId, mediaId uuid.UUID, position int) error {
+	if err := p.ensureEditable(id, userId); err != nil {
+		return err
+	}
+
+	moved, err := p.repo.Playlist().MoveMedia(id, mediaId, position)
+	if err != nil {
+		return errs.BuildError(err, "could not move media %v in playlist %v", mediaId.String(), id.String())
+	}
+
+	if !moved {
+		return ErrMediaNotInPlaylist
+	}
+
+	return nil
+}
+
+// ensureEditable makes sure that the user may change the media of the playlist and that it is not resolved from a search
+func (p *playlistService) ensureEditable(id, userId uuid.UUID) error {
+	playlist, err := p.ensureAccess(id, userId, accessCollaborate)
+	if err != nil {
+		return err
+	}
+
+	if playlist.Search != nil {
+		return ErrSmartPlaylist
+	}
+
+	return nil
+}
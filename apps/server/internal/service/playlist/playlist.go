@@ -1,8 +1,6 @@
 package playlistService
 
 import (
-	"fmt"
-
 	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
@@ -14,10 +12,18 @@ import (
 )
 
 type PlaylistService interface {
+	GetAll(userId uuid.UUID) ([]model.Playlist, error)
+	GetShared(userId uuid.UUID) ([]models.SharedPlaylist, error)
 	CreateAll(userId uuid.UUID, playlists []dto.CreatePlaylistDTO) ([]model.Playlist, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
-	AddMedia(id uuid.UUID, playlistMedia []dto.CreatePlaylistMediaDTO) ([]model.PlaylistMedia, error)
-	Delete(id uuid.UUID) error
+	AddMedia(id, userId uuid.UUID, playlistMedia []dto.CreatePlaylistMediaDTO) ([]model.PlaylistMedia, error)
+	RemoveMedia(id, userId, mediaId uuid.UUID) error
+	MoveMedia(id, userId, mediaId uuid.UUID, position int) error
+	Update(id, userId uuid.UUID, update dto.PlaylistUpdateDTO) (*model.Playlist, error)
+	Delete(id, userId uuid.UUID) error
+	GetUsers(id, userId uuid.UUID) ([]model.PlaylistUser, error)
+	GrantAccess(id, userId, sharedWith uuid.UUID, access model.PlaylistAccessEnum) error
+	RevokeAccess(id, userId, sharedWith uuid.UUID) error
 }
 
 type playlistService struct {
@@ -26,13 +32,10 @@ type playlistService struct {
 	logger logger.Logger
 }
 
-func (p *playlistService) Delete(id uuid.UUID) error {
-	playlist, err := p.repo.Playlist().GetById(id)
-	if err != nil {
-		return errs.BuildError(err, "could not get playlist by id")
-	}
-	if playlist == nil {
-		return fmt.Errorf("playlist with id %v does not exist", id.String())
+// Delete implements PlaylistService. Only the owner can delete a playlist.
+func (p *playlistService) Delete(id, userId uuid.UUID) error {
+	if _, err := p.ensureAccess(id, userId, accessOwner); err != nil {
+		return err
 	}
 
 	if err := p.repo.Playlist().Delete(id); err != nil {
@@ -42,37 +45,58 @@ func (p *playlistService) Delete(id uuid.UUID) error {
 	return nil
 }
 
-// AddMedia implements PlaylistService.
-func (p *playlistService) AddMedia(id uuid.UUID, playlistMedia []dto.CreatePlaylistMediaDTO) ([]model.PlaylistMedia, error) {
-	playlist, err := p.repo.Playlist().GetById(id)
+// Update implements PlaylistService. Only the owner can rename a playlist.
+func (p *playlistService) Update(id, userId uuid.UUID, update dto.PlaylistUpdateDTO) (*model.Playlist, error) {
+	if _, err := p.ensureAccess(id, userId, accessOwner); err != nil {
+		return nil, err
+	}
+
+	playlist, err := p.repo.Playlist().Update(model.Playlist{ID: id, Name: update.Name})
 	if err != nil {
-		return nil, errs.BuildError(err, "could not get playlist by id: %v", id.String())
+		return nil, errs.BuildError(err, "could not update playlist %v", id.String())
 	}
 
-	if playlist == nil {
-		return nil, fmt.Errorf("playlist was not found by id %v", id.String())
+	return playlist, nil
+}
+
+// GetAll implements PlaylistService. Playlists that are shared with the user are not included.
+func (p *playlistService) GetAll(userId uuid.UUID) ([]model.Playlist, error) {
+	playlists, err := p.repo.Playlist().GetAll(userId)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get playlists of user %v", userId.String())
 	}
 
-	playlistMediaEntities := make([]model.PlaylistMedia, len(playlistMedia))
+	return playlists, nil
+}
+
+// AddMedia implements PlaylistService. Media that is already in the playlist is not added again.
+func (p *playlistService) AddMedia(id, userId uuid.UUID, playlistMedia []dto.CreatePlaylistMediaDTO) ([]model.PlaylistMedia, error) {
+	if err := p.ensureEditable(id, userId); err != nil {
+		return nil, err
+	}
+
+	mediaIds := make([]uuid.UUID, len(playlistMedia))
 	for i, d := range playlistMedia {
-		playlistMediaEntities[i] = model.PlaylistMedia{
-			PlaylistID: id,
-			MediaID:    d.MediaID,
-		}
+		mediaIds[i] = d.MediaID
 	}
 
-	return p.repo.Playlist().AddMedia(playlistMediaEntities)
+	return p.repo.Playlist().AddMedia(id, mediaIds)
 }
 
-// GetMedia implements PlaylistService.
+// GetMedia implements PlaylistService. The media of a smart playlist is whatever matches its search at the time.
 func (p *playlistService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
-	playlist, err := p.repo.Playlist().GetById(id)
+	playlist, err := p.ensureAccess(id, userId, accessRead)
 	if err != nil {
-		return nil, errs.BuildError(err, "could not get playlist by id: %v", id.String())
+		return nil, err
 	}
 
-	if playlist == nil {
-		return nil, fmt.Errorf("no playlist with id: %v", id.String())
+	if playlist.Search != nil {
+		smart, err := smartSearch(*playlist.Search, search)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not read search of playlist %v", id.String())
+		}
+
+		return p.repo.Media().GetAll(userId, *smart)
 	}
 
 	return p.repo.Playlist().GetMedia(id, userId, search)
@@ -86,9 +110,15 @@ func (p *playlistService) CreateAll(userId uuid.UUID, playlists []dto.CreatePlay
 
 	playlistModels := make([]model.Playlist, len(playlists))
 	for i, p := range playlists {
+		search, err := saveSearch(p.Search)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not save search of playlist %v", p.Name)
+		}
+
 		playlistModels[i] = model.Playlist{
 			UserID: userId,
 			Name:   p.Name,
+			Search: search,
 		}
 	}
 
@@ -0,0 +1,163 @@
+package playlistService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_mediaRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/media"
+	mock_playlistRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/playlist"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+	mediaRepository "github.com/slugger7/exorcist/apps/server/internal/repository/media"
+	playlistRepository "github.com/slugger7/exorcist/apps/server/internal/repository/playlist"
+	"go.uber.org/mock/gomock"
+)
+
+type testService struct {
+	svc          *playlistService
+	repo         *mock_repository.MockRepository
+	playlistRepo *mock_playlistRepository.MockPlaylistRepository
+	mediaRepo    *mock_mediaRepository.MockMediaRepository
+}
+
+func setup(t *testing.T) *testService {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock_repository.NewMockRepository(ctrl)
+	mockPlaylistRepo := mock_playlistRepository.NewMockPlaylistRepository(ctrl)
+	mockMediaRepo := mock_mediaRepository.NewMockMediaRepository(ctrl)
+
+	mockRepo.EXPECT().
+		Playlist().
+		DoAndReturn(func() playlistRepository.PlaylistRepository {
+			return mockPlaylistRepo
+		}).
+		AnyTimes()
+
+	mockRepo.EXPECT().
+		Media().
+		DoAndReturn(func() mediaRepository.MediaRepository {
+			return mockMediaRepo
+		}).
+		AnyTimes()
+
+	env := environment.EnvironmentVariables{LogLevel: "none"}
+	ps := &playlistService{repo: mockRepo, env: &env, logger: logger.New(&env)}
+	return &testService{ps, mockRepo, mockPlaylistRepo, mockMediaRepo}
+}
+
+func Test_GetMedia_SmartPlaylistResolvesSavedSearch(t *testing.T) {
+	s := setup(t)
+
+	saved := `{"orderBy":"title","asc":true,"tags":["comedy"],"watchStatus":["unwatched"],"skip":0,"limit":0}`
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: userId, Search: &saved}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+
+	var actual dto.MediaSearchDTO
+	s.mediaRepo.EXPECT().
+		GetAll(gomock.Eq(userId), gomock.Any()).
+		DoAndReturn(func(_ uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error) {
+			actual = search
+			return &dto.PageDTO[models.MediaOverviewModel]{}, nil
+		}).
+		Times(1)
+
+	request := dto.MediaSearchDTO{Tags: []string{"ignored"}}
+	request.Skip = 10
+	request.Limit = 5
+
+	if _, err := s.svc.GetMedia(playlist.ID, userId, request); err != nil {
+		t.Fatalf("encountered an error while getting media: %v", err)
+	}
+
+	assert.Eq(t, 10, actual.Skip)
+	assert.Eq(t, 5, actual.Limit)
+	assert.Eq(t, dto.MediaOrdinal_Title, actual.OrderBy)
+	assert.Eq(t, true, actual.Asc)
+	assert.Eq(t, 1, len(actual.Tags))
+	assert.Eq(t, "comedy", actual.Tags[0])
+	assert.Eq(t, dto.WatchStatus_Unwatched, actual.WatchStatuses[0])
+	assert.Eq(t, false, *actual.Deleted)
+	assert.Eq(t, true, *actual.Exists)
+}
+
+func Test_GetMedia_RegularPlaylist(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: userId}
+	search := dto.MediaSearchDTO{}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		GetMedia(gomock.Eq(playlist.ID), gomock.Eq(userId), gomock.Any()).
+		Return(&dto.PageDTO[models.MediaOverviewModel]{}, nil).
+		Times(1)
+
+	if _, err := s.svc.GetMedia(playlist.ID, userId, search); err != nil {
+		t.Fatalf("encountered an error while getting media: %v", err)
+	}
+}
+
+func Test_AddMedia_SmartPlaylist(t *testing.T) {
+	s := setup(t)
+
+	saved := `{}`
+	playlist := model.Playlist{ID: uuid.New(), UserID: uuid.New(), Search: &saved}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+
+	_, err := s.svc.AddMedia(playlist.ID, playlist.UserID, []dto.CreatePlaylistMediaDTO{{MediaID: uuid.New()}})
+	if !errors.Is(err, ErrSmartPlaylist) {
+		t.Fatalf("expected %v but got %v", ErrSmartPlaylist, err)
+	}
+}
+
+func Test_SmartSearch_RequestOrderTakesPrecedence(t *testing.T) {
+	request := dto.MediaSearchDTO{OrderBy: dto.MediaOrdinal_Runtime}
+
+	actual, err := smartSearch(`{"orderBy":"title","asc":true}`, request)
+	if err != nil {
+		t.Fatalf("encountered an error while reading search: %v", err)
+	}
+
+	assert.Eq(t, dto.MediaOrdinal_Runtime, actual.OrderBy)
+	assert.Eq(t, false, actual.Asc)
+}
+
+func Test_SaveSearch_LeavesOutPaging(t *testing.T) {
+	search := dto.MediaSearchDTO{Search: "holiday"}
+	search.Skip = 20
+	search.Limit = 50
+
+	saved, err := saveSearch(&search)
+	if err != nil {
+		t.Fatalf("encountered an error while saving search: %v", err)
+	}
+
+	actual, err := smartSearch(*saved, dto.MediaSearchDTO{})
+	if err != nil {
+		t.Fatalf("encountered an error while reading search: %v", err)
+	}
+
+	assert.Eq(t, "holiday", actual.Search)
+	assert.Eq(t, 0, actual.Skip)
+	assert.Eq(t, 0, actual.Limit)
+}
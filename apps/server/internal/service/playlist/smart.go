@@ -0,0 +1,47 @@
+package playlistService
+
+import (
+	"encoding/json"
+
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+)
+
+// saveSearch serializes the search of a smart playlist without paging as that comes from the request for its media
+func saveSearch(search *dto.MediaSearchDTO) (*string, error) {
+	if search == nil {
+		return nil, nil
+	}
+
+	s := *search
+	s.Skip = 0
+	s.Limit = 0
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := string(b)
+	return &saved, nil
+}
+
+// smartSearch is the saved search of a smart playlist paged like the request.
+// Ordering the request takes precedence over the saved order.
+func smartSearch(saved string, request dto.MediaSearchDTO) (*dto.MediaSearchDTO, error) {
+	var search dto.MediaSearchDTO
+	if err := json.Unmarshal([]byte(saved), &search); err != nil {
+		return nil, err
+	}
+
+	search.Skip = request.Skip
+	search.Limit = request.Limit
+
+	if request.OrderBy != "" {
+		search.OrderBy = request.OrderBy
+		search.Asc = request.Asc
+	}
+
+	search.Defaults(request)
+
+	return &search, nil
+}
@@ -0,0 +1,137 @@
+package playlistService
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/models"
+)
+
+var (
+	ErrPlaylistAccessDenied = errors.New("user does not have the access to do that to the playlist")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrShareWithOwner       = errors.New("a playlist can not be shared with its owner")
+)
+
+// access is what a user may do with a playlist. Every level can do what the ones before it can.
+type access int
+
+const (
+	accessRead access = iota + 1
+	// accessCollaborate can change the media in the playlist
+	accessCollaborate
+	// accessOwner can also rename, delete and share the playlist
+	accessOwner
+)
+
+func accessOf(a model.PlaylistAccessEnum) access {
+	if a == model.PlaylistAccessEnum_Collaborate {
+		return accessCollaborate
+	}
+
+	return accessRead
+}
+
+// GetShared implements PlaylistService.
+func (p *playlistService) GetShared(userId uuid.UUID) ([]models.SharedPlaylist, error) {
+	playlists, err := p.repo.Playlist().GetShared(userId)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get playlists shared with user %v", userId.String())
+	}
+
+	return playlists, nil
+}
+
+// GetUsers implements PlaylistService.
+func (p *playlistService) GetUsers(id, userId uuid.UUID) ([]model.PlaylistUser, error) {
+	if _, err := p.ensureAccess(id, userId, accessOwner); err != nil {
+		return nil, err
+	}
+
+	users, err := p.repo.Playlist().GetUsers(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get users of playlist %v", id.String())
+	}
+
+	return users, nil
+}
+
+// GrantAccess implements PlaylistService. Granting access to a user that already has it changes their access.
+func (p *playlistService) GrantAccess(id, userId, sharedWith uuid.UUID, access model.PlaylistAccessEnum) error {
+	playlist, err := p.ensureAccess(id, userId, accessOwner)
+	if err != nil {
+		return err
+	}
+
+	if playlist.UserID == sharedWith {
+		return ErrShareWithOwner
+	}
+
+	user, err := p.repo.User().GetById(sharedWith)
+	if err != nil {
+		return errs.BuildError(err, "could not get user by id: %v", sharedWith.String())
+	}
+
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	if err := p.repo.Playlist().AddUser(id, sharedWith, access); err != nil {
+		return errs.BuildError(err, "could not grant user %v access to playlist %v", sharedWith.String(), id.String())
+	}
+
+	return nil
+}
+
+// RevokeAccess implements PlaylistService. Users can revoke their own access to leave a playlist that was shared with them.
+func (p *playlistService) RevokeAccess(id, userId, sharedWith uuid.UUID) error {
+	required := accessOwner
+	if userId == sharedWith {
+		required = accessRead
+	}
+
+	if _, err := p.ensureAccess(id, userId, required); err != nil {
+		return err
+	}
+
+	if err := p.repo.Playlist().RemoveUser(id, sharedWith); err != nil {
+		return errs.BuildError(err, "could not revoke access of user %v to playlist %v", sharedWith.String(), id.String())
+	}
+
+	return nil
+}
+
+// ensureAccess makes sure that the user has at least the required access to the playlist.
+// Playlists that have not been shared with the user are not found so that their existence is not given away.
+func (p *playlistService) ensureAccess(id, userId uuid.UUID, required access) (*model.Playlist, error) {
+	playlist, err := p.repo.Playlist().GetById(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get playlist by id: %v", id.String())
+	}
+
+	if playlist == nil {
+		return nil, ErrPlaylistNotFound
+	}
+
+	granted := accessOwner
+	if playlist.UserID != userId {
+		user, err := p.repo.Playlist().GetUser(id, userId)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not get access of user %v to playlist %v", userId.String(), id.String())
+		}
+
+		if user == nil {
+			return nil, ErrPlaylistNotFound
+		}
+
+		granted = accessOf(user.Access)
+	}
+
+	if granted < required {
+		return nil, ErrPlaylistAccessDenied
+	}
+
+	return playlist, nil
+}
@@ -0,0 +1,137 @@
+package playlistService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AddMedia_ReadAccess(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: uuid.New()}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		GetUser(gomock.Eq(playlist.ID), gomock.Eq(userId)).
+		Return(&model.PlaylistUser{Access: model.PlaylistAccessEnum_Read}, nil).
+		Times(1)
+
+	_, err := s.svc.AddMedia(playlist.ID, userId, []dto.CreatePlaylistMediaDTO{{MediaID: uuid.New()}})
+	if !errors.Is(err, ErrPlaylistAccessDenied) {
+		t.Fatalf("expected %v but got %v", ErrPlaylistAccessDenied, err)
+	}
+}
+
+func Test_RemoveMedia_Collaborator(t *testing.T) {
+	s := setup(t)
+
+	userId, mediaId := uuid.New(), uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: uuid.New()}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		GetUser(gomock.Eq(playlist.ID), gomock.Eq(userId)).
+		Return(&model.PlaylistUser{Access: model.PlaylistAccessEnum_Collaborate}, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		RemoveMedia(gomock.Eq(playlist.ID), gomock.Eq(mediaId)).
+		Return(true, nil).
+		Times(1)
+
+	if err := s.svc.RemoveMedia(playlist.ID, userId, mediaId); err != nil {
+		t.Fatalf("encountered an error while removing media: %v", err)
+	}
+}
+
+func Test_Delete_Collaborator(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: uuid.New()}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		GetUser(gomock.Eq(playlist.ID), gomock.Eq(userId)).
+		Return(&model.PlaylistUser{Access: model.PlaylistAccessEnum_Collaborate}, nil).
+		Times(1)
+
+	if err := s.svc.Delete(playlist.ID, userId); !errors.Is(err, ErrPlaylistAccessDenied) {
+		t.Fatalf("expected %v but got %v", ErrPlaylistAccessDenied, err)
+	}
+}
+
+func Test_GetMedia_NotShared(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: uuid.New()}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		GetUser(gomock.Eq(playlist.ID), gomock.Eq(userId)).
+		Return(nil, nil).
+		Times(1)
+
+	if _, err := s.svc.GetMedia(playlist.ID, userId, dto.MediaSearchDTO{}); !errors.Is(err, ErrPlaylistNotFound) {
+		t.Fatalf("expected %v but got %v", ErrPlaylistNotFound, err)
+	}
+}
+
+func Test_GrantAccess_Owner(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: userId}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+
+	err := s.svc.GrantAccess(playlist.ID, userId, userId, model.PlaylistAccessEnum_Read)
+	if !errors.Is(err, ErrShareWithOwner) {
+		t.Fatalf("expected %v but got %v", ErrShareWithOwner, err)
+	}
+}
+
+func Test_RevokeAccess_LeaveSharedPlaylist(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	playlist := model.Playlist{ID: uuid.New(), UserID: uuid.New()}
+
+	s.playlistRepo.EXPECT().
+		GetById(gomock.Eq(playlist.ID)).
+		Return(&playlist, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		GetUser(gomock.Eq(playlist.ID), gomock.Eq(userId)).
+		Return(&model.PlaylistUser{Access: model.PlaylistAccessEnum_Read}, nil).
+		Times(1)
+	s.playlistRepo.EXPECT().
+		RemoveUser(gomock.Eq(playlist.ID), gomock.Eq(userId)).
+		Return(nil).
+		Times(1)
+
+	if err := s.svc.RevokeAccess(playlist.ID, userId, userId); err != nil {
+		t.Fatalf("encountered an error while leaving playlist: %v", err)
+	}
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
 	"github.com/slugger7/exorcist/apps/server/internal/repository"
+	hlsService "github.com/slugger7/exorcist/apps/server/internal/service/hls"
 	jobService "github.com/slugger7/exorcist/apps/server/internal/service/job"
 	libraryService "github.com/slugger7/exorcist/apps/server/internal/service/library"
 	libraryPathService "github.com/slugger7/exorcist/apps/server/internal/service/library_path"
@@ -25,6 +27,7 @@ type Service interface {
 	Tag() tagService.TagService
 	Media() mediaService.MediaService
 	Playlist() playlistService.PlaylistService
+	Hls() hlsService.HlsService
 }
 
 type service struct {
@@ -38,12 +41,13 @@ type service struct {
 	tag         tagService.TagService
 	media       mediaService.MediaService
 	playlist    playlistService.PlaylistService
+	hls         hlsService.HlsService
 	ctx         context.Context
 }
 
 var serviceInstance *service
 
-func New(repo repository.Repository, env *environment.EnvironmentVariables, jobCh chan bool, ctx context.Context) Service {
+func New(repo repository.Repository, env *environment.EnvironmentVariables, jobCh chan bool, cancelJobCh chan uuid.UUID, ctx context.Context) Service {
 	if serviceInstance == nil {
 		personService := personService.New(repo, env)
 		tagService := tagService.New(repo, env)
@@ -54,11 +58,12 @@ func New(repo repository.Repository, env *environment.EnvironmentVariables, jobC
 			user:        userService.New(repo, env),
 			library:     libraryService.New(repo, env),
 			libraryPath: libraryPathService.New(repo, env),
-			job:         jobService.New(repo, env, jobCh, ctx, mediaService),
+			job:         jobService.New(repo, env, jobCh, cancelJobCh, ctx, mediaService),
 			person:      personService,
 			tag:         tagService,
 			media:       mediaService,
 			playlist:    playlistService.New(env, repo),
+			hls:         hlsService.New(repo, env, ctx),
 			ctx:         ctx,
 		}
 
@@ -106,3 +111,8 @@ func (s *service) Playlist() playlistService.PlaylistService {
 	s.logger.Debug("Getting playlistService")
 	return s.playlist
 }
+
+func (s *service) Hls() hlsService.HlsService {
+	s.logger.Debug("Getting hlsService")
+	return s.hls
+}
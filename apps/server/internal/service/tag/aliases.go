@@ -0,0 +1,73 @@
+package tagService
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/service/helpers"
+)
+
+var (
+	ErrTagNotFound   = errors.New("tag not found")
+	ErrAliasNotFound = errors.New("alias not found")
+	ErrAliasEmpty    = helpers.ErrAliasEmpty
+	ErrAliasTaken    = errors.New("alias is already the name or alias of a tag")
+)
+
+// GetAliases implements TagService.
+func (p *tagService) GetAliases(id uuid.UUID) ([]model.TagAlias, error) {
+	if err := p.ensureExists(id); err != nil {
+		return nil, err
+	}
+
+	aliases, err := p.repo.Tag().GetAliases(id)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get aliases of tag %v", id.String())
+	}
+
+	return aliases, nil
+}
+
+// AddAlias implements TagService. An alias can not be the name or alias of any tag so that it always resolves to a single tag.
+func (p *tagService) AddAlias(id uuid.UUID, alias string) (*model.TagAlias, error) {
+	alias, err := helpers.PrepareAlias(alias, func() error { return p.ensureExists(id) }, ErrAliasTaken, p.repo.Tag().GetByName, p.repo.Tag().GetByAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := p.repo.Tag().CreateAlias(model.TagAlias{TagID: id, Alias: alias})
+	if err != nil {
+		return nil, errs.BuildError(err, "could not create alias %v for tag %v", alias, id.String())
+	}
+
+	return created, nil
+}
+
+// RemoveAlias implements TagService.
+func (p *tagService) RemoveAlias(id, aliasId uuid.UUID) error {
+	deleted, err := p.repo.Tag().DeleteAlias(id, aliasId)
+	if err != nil {
+		return errs.BuildError(err, "could not delete alias %v of tag %v", aliasId.String(), id.String())
+	}
+
+	if !deleted {
+		return ErrAliasNotFound
+	}
+
+	return nil
+}
+
+func (p *tagService) ensureExists(id uuid.UUID) error {
+	tag, err := p.repo.Tag().GetById(id)
+	if err != nil {
+		return errs.BuildError(err, "could not get tag by id %v", id.String())
+	}
+
+	if tag == nil {
+		return ErrTagNotFound
+	}
+
+	return nil
+}
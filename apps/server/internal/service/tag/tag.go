@@ -17,6 +17,10 @@ type TagService interface {
 	Upsert(name string) (*model.Tag, error)
 	GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (*dto.PageDTO[models.MediaOverviewModel], error)
 	Delete(id uuid.UUID) error
+	GetAliases(id uuid.UUID) ([]model.TagAlias, error)
+	AddAlias(id uuid.UUID, alias string) (*model.TagAlias, error)
+	RemoveAlias(id, aliasId uuid.UUID) error
+	Merge(keepId uuid.UUID, mergeIds []uuid.UUID) ([]uuid.UUID, error)
 }
 
 type tagService struct {
@@ -60,13 +64,20 @@ func (p *tagService) GetMedia(id, userId uuid.UUID, search dto.MediaSearchDTO) (
 	return media, nil
 }
 
-// Upsert implements TagService.
+// Upsert implements TagService. Names that are an alias resolve to the tag they belong to.
 func (p *tagService) Upsert(name string) (*model.Tag, error) {
 	tag, err := p.repo.Tag().GetByName(name)
 	if err != nil {
 		return nil, errs.BuildError(err, "could not get tag by name from repo")
 	}
 
+	if tag == nil {
+		tag, err = p.repo.Tag().GetByAlias(name)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not get tag by alias from repo")
+		}
+	}
+
 	if tag == nil {
 		tags, err := p.repo.Tag().Create([]string{name})
 		if err != nil {
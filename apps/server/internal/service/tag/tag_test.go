@@ -0,0 +1,246 @@
+package tagService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
+	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_tagRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/tag"
+	tagRepository "github.com/slugger7/exorcist/apps/server/internal/repository/tag"
+	"go.uber.org/mock/gomock"
+)
+
+type testService struct {
+	svc     *tagService
+	repo    *mock_repository.MockRepository
+	tagRepo *mock_tagRepository.MockTagRepository
+}
+
+func setup(t *testing.T) *testService {
+	ctrl := gomock.NewController(t)
+
+	mockRepo := mock_repository.NewMockRepository(ctrl)
+	mockTagRepo := mock_tagRepository.NewMockTagRepository(ctrl)
+
+	mockRepo.EXPECT().
+		Tag().
+		DoAndReturn(func() tagRepository.TagRepository {
+			return mockTagRepo
+		}).
+		AnyTimes()
+
+	env := environment.EnvironmentVariables{LogLevel: "none"}
+	ts := &tagService{repo: mockRepo, env: &env, logger: logger.New(&env)}
+	return &testService{ts, mockRepo, mockTagRepo}
+}
+
+func Test_Upsert_ResolvesAlias(t *testing.T) {
+	s := setup(t)
+
+	tag := model.Tag{ID: uuid.New(), Name: "science fiction"}
+	s.tagRepo.EXPECT().
+		GetByName(gomock.Eq("sci-fi")).
+		Return(nil, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByAlias(gomock.Eq("sci-fi")).
+		Return(&tag, nil).
+		Times(1)
+
+	actual, err := s.svc.Upsert("sci-fi")
+	if err != nil {
+		t.Fatalf("encountered an error while upserting tag: %v", err)
+	}
+
+	assert.Eq(t, tag.ID, actual.ID)
+}
+
+func Test_Upsert_CreatesUnknownName(t *testing.T) {
+	s := setup(t)
+
+	tag := model.Tag{ID: uuid.New(), Name: "drama"}
+	s.tagRepo.EXPECT().
+		GetByName(gomock.Eq("drama")).
+		Return(nil, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByAlias(gomock.Eq("drama")).
+		Return(nil, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		Create(gomock.Eq([]string{"drama"})).
+		Return([]model.Tag{tag}, nil).
+		Times(1)
+
+	actual, err := s.svc.Upsert("drama")
+	if err != nil {
+		t.Fatalf("encountered an error while upserting tag: %v", err)
+	}
+
+	assert.Eq(t, tag.ID, actual.ID)
+}
+
+func Test_AddAlias_TagNotFound(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.tagRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(nil, nil).
+		Times(1)
+
+	_, err := s.svc.AddAlias(id, "sci-fi")
+	if !errors.Is(err, ErrTagNotFound) {
+		t.Fatalf("expected tag not found but got: %v", err)
+	}
+}
+
+func Test_AddAlias_BlankAlias(t *testing.T) {
+	s := setup(t)
+
+	_, err := s.svc.AddAlias(uuid.New(), "   ")
+	if !errors.Is(err, ErrAliasEmpty) {
+		t.Fatalf("expected alias to be empty but got: %v", err)
+	}
+}
+
+func Test_AddAlias_NameOfATag(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.tagRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Tag{ID: id}, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByName(gomock.Eq("drama")).
+		Return(&model.Tag{ID: uuid.New(), Name: "drama"}, nil).
+		Times(1)
+
+	_, err := s.svc.AddAlias(id, " drama ")
+	if !errors.Is(err, ErrAliasTaken) {
+		t.Fatalf("expected alias to be taken but got: %v", err)
+	}
+}
+
+func Test_AddAlias_AliasOfATag(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.tagRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Tag{ID: id}, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByName(gomock.Eq("sci-fi")).
+		Return(nil, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByAlias(gomock.Eq("sci-fi")).
+		Return(&model.Tag{ID: uuid.New()}, nil).
+		Times(1)
+
+	_, err := s.svc.AddAlias(id, "sci-fi")
+	if !errors.Is(err, ErrAliasTaken) {
+		t.Fatalf("expected alias to be taken but got: %v", err)
+	}
+}
+
+func Test_AddAlias_Success(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.tagRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Tag{ID: id}, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByName(gomock.Eq("sci-fi")).
+		Return(nil, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetByAlias(gomock.Eq("sci-fi")).
+		Return(nil, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		CreateAlias(gomock.Eq(model.TagAlias{TagID: id, Alias: "sci-fi"})).
+		Return(&model.TagAlias{ID: uuid.New(), TagID: id, Alias: "sci-fi"}, nil).
+		Times(1)
+
+	alias, err := s.svc.AddAlias(id, "sci-fi")
+	if err != nil {
+		t.Fatalf("encountered an error while adding alias: %v", err)
+	}
+
+	assert.Eq(t, "sci-fi", alias.Alias)
+}
+
+func Test_RemoveAlias_NotFound(t *testing.T) {
+	s := setup(t)
+
+	id, aliasId := uuid.New(), uuid.New()
+	s.tagRepo.EXPECT().
+		DeleteAlias(gomock.Eq(id), gomock.Eq(aliasId)).
+		Return(false, nil).
+		Times(1)
+
+	if err := s.svc.RemoveAlias(id, aliasId); !errors.Is(err, ErrAliasNotFound) {
+		t.Fatalf("expected alias not found but got: %v", err)
+	}
+}
+
+func Test_Merge_IntoItself(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	if _, err := s.svc.Merge(id, []uuid.UUID{uuid.New(), id}); !errors.Is(err, ErrMergeIntoItself) {
+		t.Fatalf("expected merge into itself but got: %v", err)
+	}
+}
+
+func Test_Merge_MergedTagNotFound(t *testing.T) {
+	s := setup(t)
+
+	id, mergeId := uuid.New(), uuid.New()
+	s.tagRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.Tag{ID: id}, nil).
+		Times(1)
+	s.tagRepo.EXPECT().
+		GetById(gomock.Eq(mergeId)).
+		Return(nil, nil).
+		Times(1)
+
+	if _, err := s.svc.Merge(id, []uuid.UUID{mergeId}); !errors.Is(err, ErrTagNotFound) {
+		t.Fatalf("expected tag not found but got: %v", err)
+	}
+}
+
+func Test_Merge_IgnoresRepeatedIds(t *testing.T) {
+	s := setup(t)
+
+	id, mergeId, mediaId := uuid.New(), uuid.New(), uuid.New()
+	s.tagRepo.EXPECT().
+		GetById(gomock.Any()).
+		DoAndReturn(func(id uuid.UUID) (*model.Tag, error) {
+			return &model.Tag{ID: id}, nil
+		}).
+		Times(2)
+	s.tagRepo.EXPECT().
+		Merge(gomock.Eq(id), gomock.Eq([]uuid.UUID{mergeId})).
+		Return([]uuid.UUID{mediaId}, nil).
+		Times(1)
+
+	mediaIds, err := s.svc.Merge(id, []uuid.UUID{mergeId, mergeId})
+	if err != nil {
+		t.Fatalf("encountered an error while merging tags: %v", err)
+	}
+
+	assert.Eq(t, 1, len(mediaIds))
+	assert.Eq(t, mediaId, mediaIds[0])
+}
@@ -0,0 +1,114 @@
+package userService
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+var (
+	ErrUserNotFound     = errors.New("user not found")
+	ErrUsernameTaken    = errors.New("username is already taken")
+	ErrCannotManageSelf = errors.New("users can not deactivate, demote or delete themselves")
+)
+
+// GetAll implements UserService.
+func (us *userService) GetAll() ([]model.User, error) {
+	users, err := us.repo.User().GetAll()
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get users")
+	}
+
+	return users, nil
+}
+
+// Update implements UserService. Deactivated users are signed out everywhere.
+func (us *userService) Update(actorId, id uuid.UUID, m dto.UpdateUserDTO) (*model.User, error) {
+	user, err := us.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if actorId == id && ((m.Active != nil && !*m.Active) || (m.Role != nil && *m.Role != user.Role)) {
+		return nil, ErrCannotManageSelf
+	}
+
+	if m.Username != nil && *m.Username != user.Username {
+		taken, err := us.repo.User().IsUsernameTaken(*m.Username, id)
+		if err != nil {
+			return nil, errs.BuildError(err, "could not check username of user %v", id.String())
+		}
+
+		if taken {
+			return nil, ErrUsernameTaken
+		}
+
+		user.Username = *m.Username
+	}
+
+	if m.Role != nil {
+		user.Role = *m.Role
+	}
+
+	deactivated := m.Active != nil && !*m.Active && user.Active
+	if m.Active != nil {
+		user.Active = *m.Active
+	}
+
+	if err := us.repo.User().Update(*user); err != nil {
+		return nil, errs.BuildError(err, "could not update user %v", id.String())
+	}
+
+	if deactivated {
+		if err := us.RevokeSessions(id, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// SetPassword implements UserService. The user has to pick a new password when they log in next.
+func (us *userService) SetPassword(id uuid.UUID, password string) error {
+	user, err := us.repo.User().GetById(id)
+	if err != nil {
+		return errs.BuildError(err, ErrGetById, id)
+	}
+
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	user.Password = hashPassword(password)
+	user.MustChangePassword = true
+	if err := us.repo.User().UpdatePassword(user); err != nil {
+		return errs.BuildError(err, ErrUpdatingPassword, id)
+	}
+
+	return us.RevokeSessions(id, nil)
+}
+
+// Delete implements UserService.
+func (us *userService) Delete(actorId, id uuid.UUID) error {
+	if actorId == id {
+		return ErrCannotManageSelf
+	}
+
+	deleted, err := us.repo.User().Delete(id)
+	if err != nil {
+		return errs.BuildError(err, "could not delete user %v", id.String())
+	}
+
+	if !deleted {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package userService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Update_UserNotFound(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(nil, nil).
+		Times(1)
+
+	_, err := s.svc.Update(uuid.New(), id, dto.UpdateUserDTO{})
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected %v but got %v", ErrUserNotFound, err)
+	}
+}
+
+func Test_Update_CannotDeactivateSelf(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.User{ID: id, Active: true, Role: model.UserRoleEnum_Admin}, nil).
+		Times(1)
+
+	active := false
+	_, err := s.svc.Update(id, id, dto.UpdateUserDTO{Active: &active})
+	if !errors.Is(err, ErrCannotManageSelf) {
+		t.Errorf("expected %v but got %v", ErrCannotManageSelf, err)
+	}
+}
+
+func Test_Update_UsernameTaken(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	username := "taken"
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.User{ID: id, Username: "someone", Active: true}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		IsUsernameTaken(gomock.Eq(username), gomock.Eq(id)).
+		Return(true, nil).
+		Times(1)
+
+	_, err := s.svc.Update(uuid.New(), id, dto.UpdateUserDTO{Username: &username})
+	if !errors.Is(err, ErrUsernameTaken) {
+		t.Errorf("expected %v but got %v", ErrUsernameTaken, err)
+	}
+}
+
+func Test_Update_DeactivateRevokesSessions(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.User{ID: id, Username: "someone", Active: true}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		Update(gomock.Any()).
+		DoAndReturn(func(m model.User) error {
+			assert.Eq(t, false, m.Active)
+			return nil
+		}).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		DeleteAllForUser(gomock.Eq(id), gomock.Nil()).
+		Return(nil).
+		Times(1)
+
+	active := false
+	user, err := s.svc.Update(uuid.New(), id, dto.UpdateUserDTO{Active: &active})
+	assert.ErrorNil(t, err)
+	assert.Eq(t, false, user.Active)
+}
+
+func Test_SetPassword_RequiresChangeAndRevokesSessions(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(id)).
+		Return(&model.User{ID: id}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		UpdatePassword(gomock.Any()).
+		DoAndReturn(func(m *model.User) error {
+			assert.Eq(t, true, m.MustChangePassword)
+			assert.Eq(t, true, compareHashedPassword(m.Password, "temporary"))
+			return nil
+		}).
+		Times(1)
+	s.sessionRepo.EXPECT().
+		DeleteAllForUser(gomock.Eq(id), gomock.Nil()).
+		Return(nil).
+		Times(1)
+
+	err := s.svc.SetPassword(id, "temporary")
+	assert.ErrorNil(t, err)
+}
+
+func Test_Delete_Self(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	err := s.svc.Delete(id, id)
+	if !errors.Is(err, ErrCannotManageSelf) {
+		t.Errorf("expected %v but got %v", ErrCannotManageSelf, err)
+	}
+}
+
+func Test_Delete_NotFound(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.userRepo.EXPECT().
+		Delete(gomock.Eq(id)).
+		Return(false, nil).
+		Times(1)
+
+	err := s.svc.Delete(uuid.New(), id)
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected %v but got %v", ErrUserNotFound, err)
+	}
+}
@@ -0,0 +1,119 @@
+package userService
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+// apiTokenPrefix makes tokens recognisable when they end up in logs or config files
+const apiTokenPrefix = "exo_"
+
+var (
+	ErrApiTokenNotFound   = errors.New("api token not found")
+	ErrInvalidApiToken    = errors.New("invalid api token")
+	ErrApiTokenExpiryPast = errors.New("api token expiry is in the past")
+)
+
+// CreateApiToken implements UserService. The returned string is the token itself, only its hash is stored.
+func (us *userService) CreateApiToken(userId uuid.UUID, m dto.CreateApiTokenDTO) (*model.APIToken, string, error) {
+	if m.Expires != nil && m.Expires.Before(time.Now()) {
+		return nil, "", ErrApiTokenExpiryPast
+	}
+
+	token, err := generateApiToken()
+	if err != nil {
+		return nil, "", errs.BuildError(err, "could not generate api token")
+	}
+
+	created, err := us.repo.ApiToken().Create(model.APIToken{
+		UserID:    userId,
+		Name:      m.Name,
+		TokenHash: hashApiToken(token),
+		Scope:     m.Scope,
+		Expires:   m.Expires,
+	})
+	if err != nil {
+		return nil, "", errs.BuildError(err, "could not create api token for user %v", userId.String())
+	}
+
+	return created, token, nil
+}
+
+// GetApiTokens implements UserService.
+func (us *userService) GetApiTokens(userId uuid.UUID) ([]model.APIToken, error) {
+	tokens, err := us.repo.ApiToken().GetByUserId(userId)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get api tokens of user %v", userId.String())
+	}
+
+	return tokens, nil
+}
+
+// RevokeApiToken implements UserService.
+func (us *userService) RevokeApiToken(userId, id uuid.UUID) error {
+	deleted, err := us.repo.ApiToken().Delete(id, userId)
+	if err != nil {
+		return errs.BuildError(err, "could not revoke api token %v", id.String())
+	}
+
+	if !deleted {
+		return ErrApiTokenNotFound
+	}
+
+	return nil
+}
+
+// ValidateApiToken implements UserService. Tokens are only valid until they expire and while their user is active.
+func (us *userService) ValidateApiToken(token string) (*model.APIToken, error) {
+	apiToken, err := us.repo.ApiToken().GetByHash(hashApiToken(token))
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get api token")
+	}
+
+	if apiToken == nil {
+		return nil, ErrInvalidApiToken
+	}
+
+	now := time.Now()
+	if apiToken.Expires != nil && apiToken.Expires.Before(now) {
+		return nil, ErrInvalidApiToken
+	}
+
+	user, err := us.repo.User().GetById(apiToken.UserID)
+	if err != nil {
+		return nil, errs.BuildError(err, ErrGetById, apiToken.UserID)
+	}
+
+	if user == nil || !user.Active {
+		return nil, ErrInvalidApiToken
+	}
+
+	if err := us.repo.ApiToken().UpdateLastUsed(apiToken.ID, now); err != nil {
+		us.logger.Warningf("could not update last used of api token %v: %v", apiToken.ID.String(), err.Error())
+	}
+
+	return apiToken, nil
+}
+
+func generateApiToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return apiTokenPrefix + hex.EncodeToString(b), nil
+}
+
+// hashApiToken does not need a slow hash like passwords do as tokens are long and random
+func hashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,133 @@
+package userService
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CreateApiToken_StoresHashOfToken(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	var stored model.APIToken
+	s.tokenRepo.EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(m model.APIToken) (*model.APIToken, error) {
+			stored = m
+			return &m, nil
+		}).
+		Times(1)
+
+	created, token, err := s.svc.CreateApiToken(userId, dto.CreateApiTokenDTO{Name: "script"})
+	assert.ErrorNil(t, err)
+
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		t.Errorf("expected token to start with %v: %v", apiTokenPrefix, token)
+	}
+	assert.Eq(t, hashApiToken(token), stored.TokenHash)
+	assert.Eq(t, userId, created.UserID)
+	assert.Eq(t, "script", created.Name)
+}
+
+func Test_CreateApiToken_ExpiryInThePast(t *testing.T) {
+	s := setup(t)
+
+	expires := time.Now().Add(-time.Hour)
+	_, _, err := s.svc.CreateApiToken(uuid.New(), dto.CreateApiTokenDTO{Name: "script", Expires: &expires})
+	if !errors.Is(err, ErrApiTokenExpiryPast) {
+		t.Errorf("expected %v but got %v", ErrApiTokenExpiryPast, err)
+	}
+}
+
+func Test_ValidateApiToken_UnknownToken(t *testing.T) {
+	s := setup(t)
+
+	s.tokenRepo.EXPECT().
+		GetByHash(gomock.Eq(hashApiToken("exo_unknown"))).
+		Return(nil, nil).
+		Times(1)
+
+	_, err := s.svc.ValidateApiToken("exo_unknown")
+	if !errors.Is(err, ErrInvalidApiToken) {
+		t.Errorf("expected %v but got %v", ErrInvalidApiToken, err)
+	}
+}
+
+func Test_ValidateApiToken_Expired(t *testing.T) {
+	s := setup(t)
+
+	expires := time.Now().Add(-time.Minute)
+	s.tokenRepo.EXPECT().
+		GetByHash(gomock.Any()).
+		Return(&model.APIToken{ID: uuid.New(), UserID: uuid.New(), Expires: &expires}, nil).
+		Times(1)
+
+	_, err := s.svc.ValidateApiToken("exo_expired")
+	if !errors.Is(err, ErrInvalidApiToken) {
+		t.Errorf("expected %v but got %v", ErrInvalidApiToken, err)
+	}
+}
+
+func Test_ValidateApiToken_InactiveUser(t *testing.T) {
+	s := setup(t)
+
+	userId := uuid.New()
+	s.tokenRepo.EXPECT().
+		GetByHash(gomock.Any()).
+		Return(&model.APIToken{ID: uuid.New(), UserID: userId}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(userId)).
+		Return(&model.User{ID: userId, Active: false}, nil).
+		Times(1)
+
+	_, err := s.svc.ValidateApiToken("exo_token")
+	if !errors.Is(err, ErrInvalidApiToken) {
+		t.Errorf("expected %v but got %v", ErrInvalidApiToken, err)
+	}
+}
+
+func Test_ValidateApiToken_UpdatesLastUsed(t *testing.T) {
+	s := setup(t)
+
+	token := model.APIToken{ID: uuid.New(), UserID: uuid.New()}
+	s.tokenRepo.EXPECT().
+		GetByHash(gomock.Any()).
+		Return(&token, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		GetById(gomock.Eq(token.UserID)).
+		Return(&model.User{ID: token.UserID, Active: true}, nil).
+		Times(1)
+	s.tokenRepo.EXPECT().
+		UpdateLastUsed(gomock.Eq(token.ID), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	actual, err := s.svc.ValidateApiToken("exo_token")
+	assert.ErrorNil(t, err)
+	assert.Eq(t, token.ID, actual.ID)
+}
+
+func Test_RevokeApiToken_NotFound(t *testing.T) {
+	s := setup(t)
+
+	userId, id := uuid.New(), uuid.New()
+	s.tokenRepo.EXPECT().
+		Delete(gomock.Eq(id), gomock.Eq(userId)).
+		Return(false, nil).
+		Times(1)
+
+	err := s.svc.RevokeApiToken(userId, id)
+	if !errors.Is(err, ErrApiTokenNotFound) {
+		t.Errorf("expected %v but got %v", ErrApiTokenNotFound, err)
+	}
+}
@@ -0,0 +1,164 @@
+package userService
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/table"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+const (
+	// usernameFreeAttempts is how many times a username may fail to log in before it gets locked
+	usernameFreeAttempts = 3
+	// ipFreeAttempts is higher than for usernames as a single address can be shared by many users
+	ipFreeAttempts = 10
+	// loginBaseLockout doubles with every failure after the free attempts
+	loginBaseLockout = time.Second
+	loginMaxLockout  = 15 * time.Minute
+	// loginFailureWindow is how long it takes for failures to be forgotten
+	loginFailureWindow = time.Hour
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// LoginLockedError is returned while a username or address is locked out after too many failures
+type LoginLockedError struct {
+	Until time.Time
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("login is locked until %v", e.Until.Format(time.RFC3339))
+}
+
+// LoginAttempt describes who is trying to log in
+type LoginAttempt struct {
+	Username  string
+	Password  string
+	IpAddress string
+	UserAgent string
+}
+
+// Authenticate implements UserService. Failures are audited and lock out the username and address with an increasing delay.
+func (us *userService) Authenticate(attempt LoginAttempt) (*model.User, error) {
+	keys := throttleKeys(attempt)
+	throttles, err := us.repo.Login().GetThrottles(keys)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get login throttles")
+	}
+
+	now := time.Now()
+	for _, t := range throttles {
+		if t.LockedUntil != nil && t.LockedUntil.After(now) {
+			us.auditLoginFailure(attempt, model.LoginFailureReasonEnum_Locked)
+			return nil, &LoginLockedError{Until: *t.LockedUntil}
+		}
+	}
+
+	user, err := us.repo.User().GetUserByUsername(attempt.Username,
+		table.User.ID,
+		table.User.Username,
+		table.User.Password,
+		table.User.Role,
+		table.User.MustChangePassword)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get user by username %v", attempt.Username)
+	}
+
+	if user == nil || !compareHashedPassword(user.Password, attempt.Password) {
+		us.auditLoginFailure(attempt, model.LoginFailureReasonEnum_InvalidCredentials)
+		if err := us.recordLoginFailure(keys, throttles, now); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrInvalidCredentials
+	}
+
+	if len(throttles) != 0 {
+		if err := us.repo.Login().DeleteThrottles(keys); err != nil {
+			us.logger.Errorf("could not clear login throttles of %v: %v", attempt.Username, err.Error())
+		}
+	}
+
+	user.Password = ""
+
+	return user, nil
+}
+
+func (us *userService) recordLoginFailure(keys []string, throttles []model.LoginThrottle, now time.Time) error {
+	for i, key := range keys {
+		throttle := model.LoginThrottle{Key: key}
+		for _, t := range throttles {
+			if t.Key == key {
+				throttle = t
+			}
+		}
+
+		free := usernameFreeAttempts
+		if i == 1 {
+			free = ipFreeAttempts
+		}
+
+		if err := us.repo.Login().SaveThrottle(nextThrottle(throttle, free, now)); err != nil {
+			return errs.BuildError(err, "could not record login failure for %v", key)
+		}
+	}
+
+	return nil
+}
+
+func (us *userService) auditLoginFailure(attempt LoginAttempt, reason model.LoginFailureReasonEnum) {
+	us.logger.Warningf("failed login for %v from %v: %v", attempt.Username, attempt.IpAddress, reason)
+
+	failure := model.LoginFailure{
+		Username:  attempt.Username,
+		IPAddress: nilIfEmpty(attempt.IpAddress),
+		UserAgent: nilIfEmpty(attempt.UserAgent),
+		Reason:    reason,
+	}
+	if err := us.repo.Login().CreateFailure(failure); err != nil {
+		us.logger.Errorf("could not audit failed login for %v: %v", attempt.Username, err.Error())
+	}
+}
+
+// throttleKeys are the username key followed by the address key
+func throttleKeys(attempt LoginAttempt) []string {
+	return []string{
+		"user:" + strings.ToLower(attempt.Username),
+		"ip:" + attempt.IpAddress,
+	}
+}
+
+// nextThrottle counts the failure and locks for twice as long as the previous lock once the free attempts are used up
+func nextThrottle(t model.LoginThrottle, free int, now time.Time) model.LoginThrottle {
+	if now.Sub(t.Modified) > loginFailureWindow {
+		t.Failures = 0
+	}
+
+	t.Failures++
+	t.Modified = now
+	t.LockedUntil = nil
+
+	if over := int(t.Failures) - free; over > 0 {
+		lockout := loginMaxLockout
+		if over <= 20 {
+			lockout = min(loginBaseLockout<<(over-1), loginMaxLockout)
+		}
+
+		until := now.Add(lockout)
+		t.LockedUntil = &until
+	}
+
+	return t
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
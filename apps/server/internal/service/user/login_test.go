@@ -0,0 +1,132 @@
+package userService
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	"go.uber.org/mock/gomock"
+)
+
+var attempt = LoginAttempt{
+	Username:  "Admin",
+	Password:  "admin",
+	IpAddress: "10.0.0.1",
+	UserAgent: "curl",
+}
+
+func Test_Authenticate_Locked(t *testing.T) {
+	s := setup(t)
+
+	until := time.Now().Add(time.Minute)
+	s.loginRepo.EXPECT().
+		GetThrottles(gomock.Eq([]string{"user:admin", "ip:10.0.0.1"})).
+		Return([]model.LoginThrottle{{Key: "user:admin", Failures: 5, LockedUntil: &until}}, nil).
+		Times(1)
+	s.loginRepo.EXPECT().
+		CreateFailure(gomock.Any()).
+		DoAndReturn(func(m model.LoginFailure) error {
+			assert.Eq(t, model.LoginFailureReasonEnum_Locked, m.Reason)
+			return nil
+		}).
+		Times(1)
+
+	_, err := s.svc.Authenticate(attempt)
+
+	var locked *LoginLockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("expected a locked error but got %v", err)
+	}
+	assert.Eq(t, until, locked.Until)
+}
+
+func Test_Authenticate_WrongPassword_RecordsFailure(t *testing.T) {
+	s := setup(t)
+
+	s.loginRepo.EXPECT().
+		GetThrottles(gomock.Any()).
+		Return([]model.LoginThrottle{}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		GetUserByUsername(gomock.Eq(attempt.Username), gomock.Any()).
+		Return(&model.User{ID: uuid.New(), Password: hashPassword("something else")}, nil).
+		Times(1)
+	s.loginRepo.EXPECT().
+		CreateFailure(gomock.Any()).
+		DoAndReturn(func(m model.LoginFailure) error {
+			assert.Eq(t, model.LoginFailureReasonEnum_InvalidCredentials, m.Reason)
+			assert.Eq(t, attempt.IpAddress, *m.IPAddress)
+			return nil
+		}).
+		Times(1)
+	s.loginRepo.EXPECT().
+		SaveThrottle(gomock.Any()).
+		DoAndReturn(func(m model.LoginThrottle) error {
+			assert.Eq(t, int32(1), m.Failures)
+			return nil
+		}).
+		Times(2)
+
+	_, err := s.svc.Authenticate(attempt)
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected %v but got %v", ErrInvalidCredentials, err)
+	}
+}
+
+func Test_Authenticate_Success_ClearsThrottles(t *testing.T) {
+	s := setup(t)
+
+	id := uuid.New()
+	s.loginRepo.EXPECT().
+		GetThrottles(gomock.Any()).
+		Return([]model.LoginThrottle{{Key: "user:admin", Failures: 2, Modified: time.Now()}}, nil).
+		Times(1)
+	s.userRepo.EXPECT().
+		GetUserByUsername(gomock.Eq(attempt.Username), gomock.Any()).
+		Return(&model.User{ID: id, Password: hashPassword(attempt.Password), MustChangePassword: true}, nil).
+		Times(1)
+	s.loginRepo.EXPECT().
+		DeleteThrottles(gomock.Eq([]string{"user:admin", "ip:10.0.0.1"})).
+		Return(nil).
+		Times(1)
+
+	user, err := s.svc.Authenticate(attempt)
+	assert.ErrorNil(t, err)
+	assert.Eq(t, id, user.ID)
+	assert.Eq(t, "", user.Password)
+	assert.Eq(t, true, user.MustChangePassword)
+}
+
+func Test_NextThrottle_FreeAttempts(t *testing.T) {
+	now := time.Now()
+	throttle := nextThrottle(model.LoginThrottle{Failures: 1, Modified: now}, 3, now)
+
+	assert.Eq(t, int32(2), throttle.Failures)
+	if throttle.LockedUntil != nil {
+		t.Errorf("expected no lock but got %v", throttle.LockedUntil)
+	}
+}
+
+func Test_NextThrottle_DoublesLockout(t *testing.T) {
+	now := time.Now()
+	throttle := nextThrottle(model.LoginThrottle{Failures: 5, Modified: now}, 3, now)
+
+	assert.Eq(t, now.Add(4*time.Second), *throttle.LockedUntil)
+}
+
+func Test_NextThrottle_CapsLockout(t *testing.T) {
+	now := time.Now()
+	throttle := nextThrottle(model.LoginThrottle{Failures: 100, Modified: now}, 3, now)
+
+	assert.Eq(t, now.Add(loginMaxLockout), *throttle.LockedUntil)
+}
+
+func Test_NextThrottle_ForgetsOldFailures(t *testing.T) {
+	now := time.Now()
+	throttle := nextThrottle(model.LoginThrottle{Failures: 100, Modified: now.Add(-2 * loginFailureWindow)}, 3, now)
+
+	assert.Eq(t, int32(1), throttle.Failures)
+}
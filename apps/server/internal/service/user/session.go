@@ -0,0 +1,44 @@
+package userService
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
+	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// GetSessions implements UserService. Only sessions that have not expired are returned.
+func (us *userService) GetSessions(userId uuid.UUID) ([]model.UserSession, error) {
+	sessions, err := us.repo.Session().GetByUserId(userId)
+	if err != nil {
+		return nil, errs.BuildError(err, "could not get sessions of user %v", userId.String())
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession implements UserService.
+func (us *userService) RevokeSession(userId, id uuid.UUID) error {
+	deleted, err := us.repo.Session().DeleteForUser(id, userId)
+	if err != nil {
+		return errs.BuildError(err, "could not revoke session %v", id.String())
+	}
+
+	if !deleted {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeSessions implements UserService. The session in keep is left intact when it is provided.
+func (us *userService) RevokeSessions(userId uuid.UUID, keep *uuid.UUID) error {
+	if err := us.repo.Session().DeleteAllForUser(userId, keep); err != nil {
+		return errs.BuildError(err, "could not revoke sessions of user %v", userId.String())
+	}
+
+	return nil
+}
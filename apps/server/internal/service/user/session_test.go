@@ -0,0 +1,51 @@
+package userService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/slugger7/exorcist/apps/server/internal/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_RevokeSession_NotFound(t *testing.T) {
+	s := setup(t)
+
+	userId, id := uuid.New(), uuid.New()
+	s.sessionRepo.EXPECT().
+		DeleteForUser(gomock.Eq(id), gomock.Eq(userId)).
+		Return(false, nil).
+		Times(1)
+
+	err := s.svc.RevokeSession(userId, id)
+	if !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("expected %v but got %v", ErrSessionNotFound, err)
+	}
+}
+
+func Test_RevokeSession_Success(t *testing.T) {
+	s := setup(t)
+
+	userId, id := uuid.New(), uuid.New()
+	s.sessionRepo.EXPECT().
+		DeleteForUser(gomock.Eq(id), gomock.Eq(userId)).
+		Return(true, nil).
+		Times(1)
+
+	err := s.svc.RevokeSession(userId, id)
+	assert.ErrorNil(t, err)
+}
+
+func Test_RevokeSessions_KeepsCurrentSession(t *testing.T) {
+	s := setup(t)
+
+	userId, current := uuid.New(), uuid.New()
+	s.sessionRepo.EXPECT().
+		DeleteAllForUser(gomock.Eq(userId), gomock.Eq(&current)).
+		Return(nil).
+		Times(1)
+
+	err := s.svc.RevokeSessions(userId, &current)
+	assert.ErrorNil(t, err)
+}
@@ -15,10 +15,23 @@ import (
 )
 
 type UserService interface {
-	Create(username, password string) (*model.User, error)
+	Create(username, password string, role model.UserRoleEnum) (*model.User, error)
+	GetById(id uuid.UUID) (*model.User, error)
 	Validate(username, password string) (*model.User, error)
-	UpdatePassword(id uuid.UUID, model dto.ResetPasswordDTO) error
+	Authenticate(attempt LoginAttempt) (*model.User, error)
+	UpdatePassword(id uuid.UUID, model dto.ResetPasswordDTO, keepSession *uuid.UUID) error
 	AddMediaToFavourites(id, mediaId uuid.UUID) error
+	CreateApiToken(userId uuid.UUID, m dto.CreateApiTokenDTO) (*model.APIToken, string, error)
+	GetApiTokens(userId uuid.UUID) ([]model.APIToken, error)
+	RevokeApiToken(userId, id uuid.UUID) error
+	ValidateApiToken(token string) (*model.APIToken, error)
+	GetSessions(userId uuid.UUID) ([]model.UserSession, error)
+	RevokeSession(userId, id uuid.UUID) error
+	RevokeSessions(userId uuid.UUID, keep *uuid.UUID) error
+	GetAll() ([]model.User, error)
+	Update(actorId, id uuid.UUID, m dto.UpdateUserDTO) (*model.User, error)
+	SetPassword(id uuid.UUID, password string) error
+	Delete(actorId, id uuid.UUID) error
 }
 
 func (u *userService) AddMediaToFavourites(userId uuid.UUID, mediaId uuid.UUID) error {
@@ -82,7 +95,7 @@ const ErrDeterminingUserExists = "could not determine if user '%v' exists"
 const ErrUserExists = "user already exists"
 const ErrCreatingUser = "could not create a new user"
 
-func (us *userService) Create(username, password string) (*model.User, error) {
+func (us *userService) Create(username, password string, role model.UserRoleEnum) (*model.User, error) {
 	userExists, err := us.UserExists(username)
 	if err != nil {
 		return nil, errs.BuildError(err, ErrDeterminingUserExists, username)
@@ -95,6 +108,7 @@ func (us *userService) Create(username, password string) (*model.User, error) {
 	user := model.User{
 		Username: username,
 		Password: hashPassword(password),
+		Role:     role,
 	}
 
 	newUser, err := us.repo.User().CreateUser(user)
@@ -107,6 +121,20 @@ func (us *userService) Create(username, password string) (*model.User, error) {
 	return newUser, nil
 }
 
+// GetById implements UserService. The password hash is never returned.
+func (us *userService) GetById(id uuid.UUID) (*model.User, error) {
+	user, err := us.repo.User().GetById(id)
+	if err != nil {
+		return nil, errs.BuildError(err, ErrGetById, id)
+	}
+
+	if user != nil {
+		user.Password = ""
+	}
+
+	return user, nil
+}
+
 const (
 	ErrUserDoesNotExist         string = "user with username %v does not exist"
 	ErrUsersPasswordDidNotMatch string = "password for user %v did not match"
@@ -138,7 +166,8 @@ const (
 	ErrUpdatingPassword     string = "could not update password for user %v"
 )
 
-func (us *userService) UpdatePassword(id uuid.UUID, m dto.ResetPasswordDTO) error {
+// UpdatePassword implements UserService. Every other session of the user is revoked once the password has changed.
+func (us *userService) UpdatePassword(id uuid.UUID, m dto.ResetPasswordDTO, keepSession *uuid.UUID) error {
 	user, err := us.repo.User().GetById(id)
 	if err != nil {
 		return errs.BuildError(err, ErrGetById, id)
@@ -153,9 +182,10 @@ func (us *userService) UpdatePassword(id uuid.UUID, m dto.ResetPasswordDTO) erro
 	}
 
 	user.Password = hashPassword(m.NewPassword)
+	user.MustChangePassword = false
 	if err := us.repo.User().UpdatePassword(user); err != nil {
 		return errs.BuildError(err, ErrUpdatingPassword, id)
 	}
 
-	return nil
+	return us.RevokeSessions(id, keepSession)
 }
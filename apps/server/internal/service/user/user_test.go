@@ -10,17 +10,28 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/assert"
 	"github.com/slugger7/exorcist/apps/server/internal/db/exorcist/public/model"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
+	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	errs "github.com/slugger7/exorcist/apps/server/internal/errors"
+	"github.com/slugger7/exorcist/apps/server/internal/logger"
 	mock_repository "github.com/slugger7/exorcist/apps/server/internal/mock/repository"
+	mock_apiTokenRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/api_token"
+	mock_loginRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/login"
+	mock_sessionRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/session"
 	mock_userRepository "github.com/slugger7/exorcist/apps/server/internal/mock/repository/user"
+	apiTokenRepository "github.com/slugger7/exorcist/apps/server/internal/repository/api_token"
+	loginRepository "github.com/slugger7/exorcist/apps/server/internal/repository/login"
+	sessionRepository "github.com/slugger7/exorcist/apps/server/internal/repository/session"
 	userRepository "github.com/slugger7/exorcist/apps/server/internal/repository/user"
 	"go.uber.org/mock/gomock"
 )
 
 type testService struct {
-	svc      *userService
-	repo     *mock_repository.MockRepository
-	userRepo *mock_userRepository.MockUserRepository
+	svc         *userService
+	repo        *mock_repository.MockRepository
+	userRepo    *mock_userRepository.MockUserRepository
+	tokenRepo   *mock_apiTokenRepository.MockApiTokenRepository
+	sessionRepo *mock_sessionRepository.MockSessionRepository
+	loginRepo   *mock_loginRepository.MockLoginRepository
 }
 
 func setup(t *testing.T) *testService {
@@ -28,6 +39,9 @@ func setup(t *testing.T) *testService {
 
 	mockRepo := mock_repository.NewMockRepository(ctrl)
 	mockUserRepo := mock_userRepository.NewMockUserRepository(ctrl)
+	mockTokenRepo := mock_apiTokenRepository.NewMockApiTokenRepository(ctrl)
+	mockSessionRepo := mock_sessionRepository.NewMockSessionRepository(ctrl)
+	mockLoginRepo := mock_loginRepository.NewMockLoginRepository(ctrl)
 
 	mockRepo.EXPECT().
 		User().
@@ -36,8 +50,30 @@ func setup(t *testing.T) *testService {
 		}).
 		AnyTimes()
 
-	us := &userService{repo: mockRepo}
-	return &testService{us, mockRepo, mockUserRepo}
+	mockRepo.EXPECT().
+		ApiToken().
+		DoAndReturn(func() apiTokenRepository.ApiTokenRepository {
+			return mockTokenRepo
+		}).
+		AnyTimes()
+
+	mockRepo.EXPECT().
+		Session().
+		DoAndReturn(func() sessionRepository.SessionRepository {
+			return mockSessionRepo
+		}).
+		AnyTimes()
+
+	mockRepo.EXPECT().
+		Login().
+		DoAndReturn(func() loginRepository.LoginRepository {
+			return mockLoginRepo
+		}).
+		AnyTimes()
+
+	env := environment.EnvironmentVariables{LogLevel: "none"}
+	us := &userService{repo: mockRepo, logger: logger.New(&env)}
+	return &testService{us, mockRepo, mockUserRepo, mockTokenRepo, mockSessionRepo, mockLoginRepo}
 }
 
 func Test_UserExists_ErrorFromRepo(t *testing.T) {
@@ -109,7 +145,7 @@ func Test_CreateUser_UserExistsRaisesError_ShouldReturnError(t *testing.T) {
 
 	username := "someUsername"
 
-	user, err := s.svc.Create(username, "")
+	user, err := s.svc.Create(username, "", model.UserRoleEnum_Viewer)
 	if err == nil {
 		t.Error("Expected an error but it was nil")
 	}
@@ -139,7 +175,7 @@ func Test_CreateUser_UserExistsTrue_ShouldReturnError(t *testing.T) {
 		Times(1)
 	username := "someUsername"
 
-	user, err := s.svc.Create(username, "")
+	user, err := s.svc.Create(username, "", model.UserRoleEnum_Viewer)
 	if err == nil {
 		t.Error("Expected error but was nil")
 	}
@@ -171,7 +207,7 @@ func Test_CreateUser_UserExistsFalse_RepoCreateReturnsError_ShouldReturnError(t
 
 	username := "someUsername"
 
-	user, err := s.svc.Create(username, "")
+	user, err := s.svc.Create(username, "", model.UserRoleEnum_Viewer)
 	if err == nil {
 		t.Error("Expected an error but was nil")
 	}
@@ -208,7 +244,7 @@ func Test_CreateUser_UserExistsFalse_RepoCreatesUser_ShouldReturnUser(t *testing
 		}).
 		Times(1)
 
-	user, err := s.svc.Create(username, "")
+	user, err := s.svc.Create(username, "", model.UserRoleEnum_Viewer)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -319,7 +355,7 @@ func Test_UpdatePassword_GetUserReturnsError(t *testing.T) {
 		}).
 		Times(1)
 
-	err := s.svc.UpdatePassword(id, dto.ResetPasswordDTO{})
+	err := s.svc.UpdatePassword(id, dto.ResetPasswordDTO{}, nil)
 
 	assert.ErrorNotNil(t, err)
 	assert.ErrorMessage(t, fmt.Sprintf(ErrGetById, id), err)
@@ -337,7 +373,7 @@ func Test_UpdatePassword_GetUserReturnsNilUser(t *testing.T) {
 		}).
 		Times(1)
 
-	err := s.svc.UpdatePassword(id, dto.ResetPasswordDTO{})
+	err := s.svc.UpdatePassword(id, dto.ResetPasswordDTO{}, nil)
 
 	assert.ErrorNotNil(t, err)
 	assert.Error(t, fmt.Errorf(ErrUserNil, id), err)
@@ -358,7 +394,7 @@ func Test_UpdatePassword_PasswordsDoNotMatch(t *testing.T) {
 		}).
 		Times(1)
 
-	err := s.svc.UpdatePassword(id, m)
+	err := s.svc.UpdatePassword(id, m, nil)
 
 	assert.ErrorNotNil(t, err)
 	assert.Error(t, fmt.Errorf(ErrNonMatchingPasswords, id), err)
@@ -385,7 +421,7 @@ func Test_UpdatePassword_RepoUpdateReturnsErr(t *testing.T) {
 			return fmt.Errorf("some error")
 		})
 
-	err := s.svc.UpdatePassword(id, m)
+	err := s.svc.UpdatePassword(id, m, nil)
 
 	assert.ErrorNotNil(t, err)
 	assert.ErrorMessage(t, fmt.Sprintf(ErrUpdatingPassword, id), err)
@@ -412,7 +448,13 @@ func Test_UpdatePassword_Success(t *testing.T) {
 			return nil
 		})
 
-	err := s.svc.UpdatePassword(id, m)
+	keep := uuid.New()
+	s.sessionRepo.EXPECT().
+		DeleteAllForUser(gomock.Eq(id), gomock.Eq(&keep)).
+		Return(nil).
+		Times(1)
+
+	err := s.svc.UpdatePassword(id, m, &keep)
 
 	assert.ErrorNil(t, err)
 }
@@ -28,3 +28,25 @@ func (w *websockets) JobCreate(job model.Job) {
 
 	message.SendToAll(w.wss)
 }
+
+// JobDelete implements Websockets.
+func (w *websockets) JobDelete(job model.Job) {
+	w.logger.Debug("ws - deleting job")
+
+	message := dto.WSMessage[dto.JobDTO]{
+		Topic: dto.WSTopic_JobDelete,
+		Data:  *(&dto.JobDTO{}).FromModel(job),
+	}
+
+	message.SendToAll(w.wss)
+}
+
+// JobProgress implements Websockets.
+func (w *websockets) JobProgress(job model.Job) {
+	message := dto.WSMessage[dto.JobProgressDTO]{
+		Topic: dto.WSTopic_JobProgress,
+		Data:  *(&dto.JobProgressDTO{}).FromModel(job),
+	}
+
+	message.SendToAll(w.wss)
+}
@@ -1,9 +1,23 @@
 package websockets
 
 import (
+	"github.com/google/uuid"
 	"github.com/slugger7/exorcist/apps/server/internal/dto"
 )
 
+// hasMediaAccess only lets media messages through to users that have access to the library of the media
+func (w *websockets) hasMediaAccess(mediaId uuid.UUID) func(userId uuid.UUID) bool {
+	return func(userId uuid.UUID) bool {
+		hasAccess, err := w.repo.Media().HasAccess(mediaId, userId)
+		if err != nil {
+			w.logger.Errorf("could not determine if user %v has access to media %v: %v", userId.String(), mediaId.String(), err.Error())
+			return false
+		}
+
+		return hasAccess
+	}
+}
+
 // MediaCreate implements Websockets.
 func (w *websockets) MediaCreate(media dto.MediaOverviewDTO) {
 	w.logger.Debug("ws - creating video")
@@ -12,8 +26,7 @@ func (w *websockets) MediaCreate(media dto.MediaOverviewDTO) {
 		Topic: dto.WSTopic_MediaCreate,
 		Data:  media,
 	}
-	mediaDelete.SendToAll(w.wss)
-
+	mediaDelete.SendTo(w.wss, w.hasMediaAccess(media.Id))
 }
 
 // MediaDelete implements Websockets.
@@ -24,7 +37,7 @@ func (w *websockets) MediaDelete(media dto.MediaOverviewDTO) {
 		Topic: dto.WSTopic_MediaDelete,
 		Data:  media,
 	}
-	videoDelete.SendToAll(w.wss)
+	videoDelete.SendTo(w.wss, w.hasMediaAccess(media.Id))
 }
 
 // MediaUpdate implements Websockets.
@@ -36,7 +49,7 @@ func (w *websockets) MediaUpdate(media dto.MediaDTO) {
 		Data:  media,
 	}
 
-	mediaUpdate.SendToAll(w.wss)
+	mediaUpdate.SendTo(w.wss, w.hasMediaAccess(media.ID))
 }
 
 // MediaOverviewUpdate implements Websockets.
@@ -47,5 +60,5 @@ func (w *websockets) MediaOverviewUpdate(media dto.MediaOverviewDTO) {
 		Topic: dto.WSTopic_MediaOverviewUpdate,
 		Data:  media,
 	}
-	mediaUpdate.SendToAll(w.wss)
+	mediaUpdate.SendTo(w.wss, w.hasMediaAccess(media.Id))
 }
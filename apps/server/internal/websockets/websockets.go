@@ -11,6 +11,7 @@ import (
 	"github.com/slugger7/exorcist/apps/server/internal/environment"
 	"github.com/slugger7/exorcist/apps/server/internal/logger"
 	"github.com/slugger7/exorcist/apps/server/internal/models"
+	"github.com/slugger7/exorcist/apps/server/internal/repository"
 )
 
 type Websockets interface {
@@ -26,10 +27,13 @@ type Websockets interface {
 	MediaCreate(media dto.MediaOverviewDTO)
 	JobUpdate(job model.Job)
 	JobCreate(job model.Job)
+	JobDelete(job model.Job)
+	JobProgress(job model.Job)
 }
 
 type websockets struct {
 	env     *environment.EnvironmentVariables
+	repo    repository.Repository
 	wss     models.WebSocketMap
 	logger  logger.Logger
 	wsMutex sync.Mutex
@@ -96,10 +100,11 @@ func (s *websockets) WebSocketHeartbeat() {
 
 var websocketsInterface *websockets
 
-func New(env *environment.EnvironmentVariables) Websockets {
+func New(env *environment.EnvironmentVariables, repo repository.Repository) Websockets {
 	if websocketsInterface == nil {
 		websocketsInterface = &websockets{
 			env:    env,
+			repo:   repo,
 			wss:    make(models.WebSocketMap),
 			logger: logger.New(env),
 		}
@@ -0,0 +1,9 @@
+alter table video drop column subtitle_streams;
+alter table video drop column audio_streams;
+alter table video drop column pixel_format;
+alter table video drop column frame_rate;
+alter table video drop column video_bitrate;
+alter table video drop column bitrate;
+alter table video drop column container;
+alter table video drop column audio_codec;
+alter table video drop column video_codec;
@@ -0,0 +1,9 @@
+alter table video add column video_codec varchar;
+alter table video add column audio_codec varchar;
+alter table video add column container varchar;
+alter table video add column bitrate bigint;
+alter table video add column video_bitrate bigint;
+alter table video add column frame_rate double precision;
+alter table video add column pixel_format varchar;
+alter table video add column audio_streams jsonb;
+alter table video add column subtitle_streams jsonb;
@@ -0,0 +1,2 @@
+alter table job drop column step;
+alter table job drop column progress;
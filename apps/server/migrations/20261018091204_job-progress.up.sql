@@ -0,0 +1,2 @@
+alter table job add column progress double precision not null default 0;
+alter table job add column step varchar;
@@ -0,0 +1,14 @@
+create table job_schedule
+(
+  id uuid primary key default gen_random_uuid(),
+  name varchar not null,
+  cron varchar not null,
+  job_type job_type_enum not null,
+  data jsonb,
+  priority smallint default 3 not null,
+  enabled boolean default true not null,
+  last_run timestamp,
+  next_run timestamp,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null
+);
@@ -0,0 +1 @@
+alter table video drop column phash;
@@ -0,0 +1 @@
+alter table video add column phash bigint;
@@ -0,0 +1,3 @@
+alter table "user" drop column role;
+
+drop type user_role_enum;
@@ -0,0 +1,6 @@
+create type user_role_enum as enum ('admin', 'editor', 'viewer');
+
+alter table "user" add column role user_role_enum not null default 'viewer';
+
+-- every user had full access before roles were introduced
+update "user" set role = 'admin';
@@ -0,0 +1 @@
+drop table library_user;
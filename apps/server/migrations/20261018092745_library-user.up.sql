@@ -0,0 +1,20 @@
+create table library_user
+(
+  id uuid primary key default gen_random_uuid(),
+  library_id uuid not null,
+  user_id uuid not null,
+  created timestamp default current_timestamp not null,
+  constraint fk_library_user_library
+    foreign key(library_id)
+    references library(id)
+    on delete cascade,
+  constraint fk_library_user_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade,
+  constraint uq_library_user unique (library_id, user_id)
+);
+
+-- every user could see every library before access was granted per user
+insert into library_user (library_id, user_id)
+select library.id, "user".id from library cross join "user";
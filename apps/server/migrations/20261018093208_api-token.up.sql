@@ -0,0 +1,16 @@
+create table api_token
+(
+  id uuid primary key default gen_random_uuid(),
+  user_id uuid not null,
+  name varchar not null,
+  token_hash char(64) not null unique,
+  scope user_role_enum,
+  expires timestamp,
+  last_used timestamp,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null,
+  constraint fk_api_token_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade
+);
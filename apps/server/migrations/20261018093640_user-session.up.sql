@@ -0,0 +1,18 @@
+create table user_session
+(
+  id uuid primary key default gen_random_uuid(),
+  user_id uuid not null,
+  data bytea not null,
+  user_agent varchar,
+  ip_address varchar,
+  last_seen timestamp default current_timestamp not null,
+  expires timestamp not null,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null,
+  constraint fk_user_session_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade
+);
+
+create index idx_user_session_user_id on user_session (user_id);
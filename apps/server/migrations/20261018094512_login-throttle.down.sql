@@ -0,0 +1,7 @@
+alter table "user" drop column must_change_password;
+
+drop table login_throttle;
+
+drop table login_failure;
+
+drop type login_failure_reason_enum;
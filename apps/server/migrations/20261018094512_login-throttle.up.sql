@@ -0,0 +1,33 @@
+create type login_failure_reason_enum as enum ('invalid_credentials', 'locked');
+
+-- audit trail of every failed login
+create table login_failure
+(
+  id uuid primary key default gen_random_uuid(),
+  username varchar not null,
+  ip_address varchar,
+  user_agent varchar,
+  reason login_failure_reason_enum not null,
+  created timestamp default current_timestamp not null
+);
+
+create index idx_login_failure_created on login_failure (created);
+
+-- consecutive failures per username and per client ip
+create table login_throttle
+(
+  id uuid primary key default gen_random_uuid(),
+  key varchar not null unique,
+  failures int not null default 0,
+  locked_until timestamp,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null
+);
+
+alter table "user" add column must_change_password boolean not null default false;
+
+-- the seeded admin has to pick a new password while it still has the default one
+update "user"
+set must_change_password = true
+where username = 'admin'
+  and password = '$2a$10$5nmh/cOu.dzk05V7lfBqQua9FO6nG.aQTGTJQFB26DGMSMwp5FWxu';
@@ -0,0 +1,10 @@
+drop index idx_media_person_media_id;
+drop index idx_media_tag_media_id;
+drop index idx_person_alias_alias_trgm;
+drop index idx_person_name_trgm;
+drop index idx_tag_alias_alias_trgm;
+drop index idx_tag_name_trgm;
+drop index idx_media_path_trgm;
+drop index idx_media_title_trgm;
+
+drop extension if exists pg_trgm;
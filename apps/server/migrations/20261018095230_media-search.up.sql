@@ -0,0 +1,13 @@
+create extension if not exists pg_trgm;
+
+-- trigram indexes let searches match anywhere within the text
+create index idx_media_title_trgm on media using gin (title gin_trgm_ops);
+create index idx_media_path_trgm on media using gin (path gin_trgm_ops);
+create index idx_tag_name_trgm on tag using gin (name gin_trgm_ops);
+create index idx_tag_alias_alias_trgm on tag_alias using gin (alias gin_trgm_ops);
+create index idx_person_name_trgm on person using gin (name gin_trgm_ops);
+create index idx_person_alias_alias_trgm on person_alias using gin (alias gin_trgm_ops);
+
+-- tags and people are looked up per media when ranking results
+create index idx_media_tag_media_id on media_tag (media_id);
+create index idx_media_person_media_id on media_person (media_id);
@@ -0,0 +1,5 @@
+alter table playlist_media drop constraint playlist_media_unique_position;
+
+alter table playlist_media drop constraint playlist_media_unique_media;
+
+alter table playlist_media drop column position;
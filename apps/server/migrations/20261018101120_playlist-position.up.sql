@@ -0,0 +1,23 @@
+-- media can only be in a playlist once, the earliest entry is kept
+delete from playlist_media pm
+using playlist_media other
+where pm.playlist_id = other.playlist_id
+  and pm.media_id = other.media_id
+  and (pm.created, pm.id) > (other.created, other.id);
+
+alter table playlist_media add column position integer;
+
+update playlist_media pm
+set position = ordered.position
+from (
+  select id, row_number() over (partition by playlist_id order by created, id) - 1 as position
+  from playlist_media
+) ordered
+where pm.id = ordered.id;
+
+alter table playlist_media alter column position set not null;
+
+alter table playlist_media add constraint playlist_media_unique_media unique (playlist_id, media_id);
+
+-- deferred so that the items of a playlist can be renumbered one at a time in a transaction
+alter table playlist_media add constraint playlist_media_unique_position unique (playlist_id, position) deferrable initially deferred;
@@ -0,0 +1,3 @@
+delete from playlist where search is not null;
+
+alter table playlist drop column search;
@@ -0,0 +1,2 @@
+-- smart playlists store a media search and resolve their media when they are queried
+alter table playlist add column search jsonb;
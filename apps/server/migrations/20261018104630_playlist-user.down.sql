@@ -0,0 +1,3 @@
+drop table playlist_user;
+
+drop type playlist_access_enum;
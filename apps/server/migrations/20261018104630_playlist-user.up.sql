@@ -0,0 +1,23 @@
+create type playlist_access_enum as enum ('read', 'collaborate');
+
+-- users other than the owner that a playlist is shared with
+create table playlist_user
+(
+  id uuid primary key default gen_random_uuid(),
+  playlist_id uuid not null,
+  user_id uuid not null,
+  access playlist_access_enum not null,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null,
+  constraint fk_playlist_user_playlist
+    foreign key(playlist_id)
+    references playlist(id)
+    on delete cascade,
+  constraint fk_playlist_user_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade,
+  constraint uq_playlist_user unique (playlist_id, user_id)
+);
+
+create index idx_playlist_user_user_id on playlist_user (user_id);
@@ -0,0 +1,5 @@
+drop table watch_history;
+
+alter table media_progress drop column watched;
+
+alter table media_progress drop column play_count;
@@ -0,0 +1,35 @@
+alter table media_progress add column play_count integer not null default 0;
+
+-- when the media was last watched to the end, null when it has not been or was marked as unwatched
+alter table media_progress add column watched timestamp;
+
+-- progress past the old fixed threshold of 90% counts as watched once
+update media_progress mp
+set watched = mp.modified,
+  play_count = 1
+from video v
+where v.media_id = mp.media_id
+  and v.runtime > 0
+  and mp."timestamp" > v.runtime * 0.9;
+
+-- every time that a user watched media. Progress logged shortly after the previous progress continues the same entry.
+create table watch_history
+(
+  id uuid primary key default gen_random_uuid(),
+  user_id uuid not null,
+  media_id uuid not null,
+  "timestamp" double precision not null,
+  completed boolean not null default false,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null,
+  constraint fk_watch_history_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade,
+  constraint fk_watch_history_media
+    foreign key(media_id)
+    references media(id)
+    on delete cascade
+);
+
+create index idx_watch_history_user_media on watch_history (user_id, media_id, modified desc);
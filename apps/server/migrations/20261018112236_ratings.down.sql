@@ -0,0 +1,5 @@
+alter table favourite_person drop constraint uq_favourite_person;
+
+drop table person_rating;
+
+drop table media_rating;
@@ -0,0 +1,50 @@
+-- a user's rating of media from one to five stars
+create table media_rating
+(
+  id uuid primary key default gen_random_uuid(),
+  user_id uuid not null,
+  media_id uuid not null,
+  rating smallint not null,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null,
+  constraint fk_media_rating_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade,
+  constraint fk_media_rating_media
+    foreign key(media_id)
+    references media(id)
+    on delete cascade,
+  constraint chk_media_rating_rating check (rating between 1 and 5),
+  constraint uq_media_rating unique (user_id, media_id)
+);
+
+-- a user's rating of a person from one to five stars
+create table person_rating
+(
+  id uuid primary key default gen_random_uuid(),
+  user_id uuid not null,
+  person_id uuid not null,
+  rating smallint not null,
+  created timestamp default current_timestamp not null,
+  modified timestamp default current_timestamp not null,
+  constraint fk_person_rating_user
+    foreign key(user_id)
+    references "user"(id)
+    on delete cascade,
+  constraint fk_person_rating_person
+    foreign key(person_id)
+    references person(id)
+    on delete cascade,
+  constraint chk_person_rating_rating check (rating between 1 and 5),
+  constraint uq_person_rating unique (user_id, person_id)
+);
+
+-- a person can only be a favourite of a user once
+delete from favourite_person fp
+using favourite_person other
+where fp.user_id = other.user_id
+  and fp.person_id = other.person_id
+  and (fp.modified, fp.id) < (other.modified, other.id);
+
+alter table favourite_person add constraint uq_favourite_person unique (user_id, person_id);
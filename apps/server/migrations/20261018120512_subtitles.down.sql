@@ -0,0 +1,28 @@
+delete from media where id in (select related_to from media_relation where relation_type = 'subtitle');
+delete from media_relation where relation_type = 'subtitle';
+alter type media_relation_type_enum rename to old_media_relation_type_enum;
+create type media_relation_type_enum as enum
+  ('thumbnail', 'chapter', 'media');
+alter table media_relation alter column relation_type type media_relation_type_enum using relation_type::text::media_relation_type_enum;
+drop type old_media_relation_type_enum;
+
+delete from job where job_type = 'extract_subtitles';
+delete from job_schedule where job_type = 'extract_subtitles';
+alter type job_type_enum rename to old_job_type_enum;
+create type job_type_enum as enum
+  ('update_existing_videos', 
+  'scan_path',
+  'generate_checksum', 
+  'generate_thumbnail', 
+  'scan_library',
+  'refresh_metadata',
+  'refresh_library_metadata',
+  'generate_chapters',
+  'generate_library_chapters',
+  'convert');
+alter table job rename column job_type to old_job_type;
+alter table job add job_type job_type_enum not null default 'scan_path';
+update job set job_type = old_job_type::text::job_type_enum;
+alter table job drop column old_job_type;
+alter table job_schedule alter column job_type type job_type_enum using job_type::text::job_type_enum;
+drop type old_job_type_enum;
@@ -0,0 +1,2 @@
+alter type media_relation_type_enum add value 'subtitle'; -- webvtt tracks converted from sidecar files or embedded streams
+alter type job_type_enum add value 'extract_subtitles'; -- converts the subtitles of a video to webvtt assets
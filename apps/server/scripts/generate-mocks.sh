@@ -14,9 +14,15 @@ echo "Generating repository mocks"
 mkdir -p ${MOCK_REPO_DIR}
 mockgen -source=${REPO_DIR}/repository.go > ${MOCK_REPO_DIR}/repository.go
 
+mkdir -p ${MOCK_REPO_DIR}/api_token
+mockgen -source=${REPO_DIR}/api_token/api_token.go >  ${MOCK_REPO_DIR}/api_token/api_token.go
+
 mkdir -p ${MOCK_REPO_DIR}/job
 mockgen -source=${REPO_DIR}/job/job.go >  ${MOCK_REPO_DIR}/job/job.go
 
+mkdir -p ${MOCK_REPO_DIR}/job_schedule
+mockgen -source=${REPO_DIR}/job_schedule/job_schedule.go >  ${MOCK_REPO_DIR}/job_schedule/job_schedule.go
+
 mkdir -p ${MOCK_REPO_DIR}/library
 mockgen -source=${REPO_DIR}/library/library.go >  ${MOCK_REPO_DIR}/library/library.go
 
@@ -41,6 +47,15 @@ mockgen -source=${REPO_DIR}/person/person.go > ${MOCK_REPO_DIR}/person/person.go
 mkdir -p ${MOCK_REPO_DIR}/tag
 mockgen -source=${REPO_DIR}/tag/tag.go > ${MOCK_REPO_DIR}/tag/tag.go
 
+mkdir -p ${MOCK_REPO_DIR}/playlist
+mockgen -source=${REPO_DIR}/playlist/playlist.go > ${MOCK_REPO_DIR}/playlist/playlist.go
+
+mkdir -p ${MOCK_REPO_DIR}/login
+mockgen -source=${REPO_DIR}/login/login.go > ${MOCK_REPO_DIR}/login/login.go
+
+mkdir -p ${MOCK_REPO_DIR}/session
+mockgen -source=${REPO_DIR}/session/session.go > ${MOCK_REPO_DIR}/session/session.go
+
 echo "Generate service mocks"
 mkdir -p ${MOCK_SERVICE_DIR}
 mockgen -source=${SERVICE_DIR}/service.go > ${MOCK_SERVICE_DIR}/service.go
@@ -63,7 +78,20 @@ mockgen -source=${SERVICE_DIR}/person/person.go > ${MOCK_SERVICE_DIR}/person/per
 mkdir -p ${MOCK_SERVICE_DIR}/tag
 mockgen -source=${SERVICE_DIR}/tag/tag.go > ${MOCK_SERVICE_DIR}/tag/tag.go
 
+mkdir -p ${MOCK_SERVICE_DIR}/playlist
+mockgen -source=${SERVICE_DIR}/playlist/playlist.go > ${MOCK_SERVICE_DIR}/playlist/playlist.go
+
 mkdir -p ${MOCK_SERVICE_DIR}/file_watcher
 mockgen -source=${SERVICE_DIR}/file_watcher/file_watcher.go > ${MOCK_SERVICE_DIR}/file_watcher/file_watcher.go
 
+mkdir -p ${MOCK_SERVICE_DIR}/hls
+mockgen -source=${SERVICE_DIR}/hls/hls.go > ${MOCK_SERVICE_DIR}/hls/hls.go
+
+mkdir -p ${MOCK_SERVICE_DIR}/job
+mockgen -source=${SERVICE_DIR}/job/job.go > ${MOCK_SERVICE_DIR}/job/job.go
+
+echo "Generate websocket mocks"
+mkdir -p ${MOCK_DIR}/websockets
+mockgen -source=./apps/server/internal/websockets/websockets.go > ${MOCK_DIR}/websockets/websockets.go
+
 echo "Mocks generated"